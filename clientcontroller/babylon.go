@@ -2,7 +2,10 @@ package clientcontroller
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"sync"
 	"time"
 
 	sdkErr "cosmossdk.io/errors"
@@ -19,9 +22,15 @@ import (
 	finalitytypes "github.com/babylonchain/babylon/x/finality/types"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/chaincfg"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	sttypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/relayer/v2/relayer/provider"
 	"go.uber.org/zap"
@@ -31,14 +40,104 @@ import (
 )
 
 var _ ClientController = &BabylonController{}
+var _ BlockSubscriber = &BabylonController{}
+var _ GasPriceUpdater = &BabylonController{}
+var _ FinalitySigsSubmitter = &BabylonController{}
+var _ VoteQuerier = &BabylonController{}
+
+// newBlockSubscriber is the name of the subscriber to the new block events
+const newBlockSubscriber = "finality-provider"
+
+// the ABCI query paths of the queries to Babylon, which are run through abciQuery
+// rather than the Babylon query client so that they are canceled once their
+// context is done
+const (
+	bankBalancePath              = "/cosmos.bank.v1beta1.Query/Balance"
+	finalityProviderPath         = "/babylon.btcstaking.v1.Query/FinalityProvider"
+	finalityProviderPowerPath    = "/babylon.btcstaking.v1.Query/FinalityProviderPowerAtHeight"
+	activatedHeightPath          = "/babylon.btcstaking.v1.Query/ActivatedHeight"
+	votesAtHeightPath            = "/babylon.finality.v1.Query/VotesAtHeight"
+	listBlocksPath               = "/babylon.finality.v1.Query/ListBlocks"
+	blockPath                    = "/babylon.finality.v1.Query/Block"
+	lastCheckpointWithStatusPath = "/babylon.checkpointing.v1.Query/LastCheckpointWithStatus"
+)
 
 var emptyErrs = []*sdkErr.Error{}
 
+// babylonErrCategories maps the errors of the Babylon transactions to the error categories
+var babylonErrCategories = []struct {
+	category ErrorCategory
+	errs     []*sdkErr.Error
+}{
+	{CategoryExpected, []*sdkErr.Error{
+		finalitytypes.ErrDuplicatedFinalitySig,
+		sdkerrors.ErrTxInMempoolCache,
+	}},
+	{CategoryUnrecoverable, []*sdkErr.Error{
+		finalitytypes.ErrBlockNotFound,
+		finalitytypes.ErrInvalidFinalitySig,
+		finalitytypes.ErrNoPubRandYet,
+		finalitytypes.ErrPubRandNotFound,
+		finalitytypes.ErrTooFewPubRand,
+		authz.ErrNoAuthorizationFound,
+		authz.ErrAuthorizationExpired,
+		btcstakingtypes.ErrFpRegistered,
+	}},
+	{CategorySlashed, []*sdkErr.Error{
+		btcstakingtypes.ErrFpAlreadySlashed,
+	}},
+	{CategoryInsufficientFunds, []*sdkErr.Error{
+		sdkerrors.ErrInsufficientFunds,
+		sdkerrors.ErrInsufficientFee,
+	}},
+	{CategorySequenceMismatch, []*sdkErr.Error{
+		sdkerrors.ErrWrongSequence,
+	}},
+}
+
+// categorizeBabylonErr maps the error of a Babylon transaction to its category
+// by the registered error of its codespace and code, the errors which are not
+// registered, e.g., the ones only keeping their messages, are transient
+func categorizeBabylonErr(err error) error {
+	for _, c := range babylonErrCategories {
+		for _, e := range c.errs {
+			if errors.Is(err, e) {
+				return NewError(c.category, err)
+			}
+		}
+	}
+
+	return NewError(CategoryTransient, err)
+}
+
 type BabylonController struct {
 	bbnClient *bbnclient.Client
+	// querier sends the ABCI queries with the context of the caller
+	querier abciQuerier
+	// txClient broadcasts the transactions sent without the Babylon
+	// client and queries their results
+	txClient txRPCClient
+	// kr holds the key signing the transactions
+	kr keyring.Keyring
+
 	cfg       *fpcfg.BBNConfig
 	btcParams *chaincfg.Params
 	logger    *zap.Logger
+
+	// gasPrices are the current gas prices of the transactions sent
+	// without the Babylon client, which are updated dynamically
+	gasMu     sync.RWMutex
+	gasPrices string
+	// feeDenom is the denom of the gas prices, in which the fees are paid
+	feeDenom string
+
+	// txSender sends the transactions without the Babylon client
+	txSender *txSender
+	// sendAllWithTxSender is set if some transactions of the key are broadcast
+	// without waiting for the previous ones, e.g., the aggregated finality
+	// signatures, in which case the account sequence is only tracked locally and
+	// all the other transactions of the key are sent with it too
+	sendAllWithTxSender bool
 }
 
 func NewBabylonController(
@@ -61,11 +160,27 @@ func NewBabylonController(
 		return nil, fmt.Errorf("failed to create Babylon client: %w", err)
 	}
 
+	gasPrice, err := sdk.ParseDecCoin(cfg.GasPrices)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gas prices %s: %w", cfg.GasPrices, err)
+	}
+
+	sender, err := newTxSender(cfg.AccountPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the transaction sender: %w", err)
+	}
+
 	return &BabylonController{
-		bc,
-		cfg,
-		btcParams,
-		logger,
+		bbnClient: bc,
+		querier:   bc.RPCClient,
+		txClient:  bc.RPCClient,
+		kr:        bc.GetKeyring(),
+		cfg:       cfg,
+		btcParams: btcParams,
+		logger:    logger,
+		gasPrices: cfg.GasPrices,
+		feeDenom:  gasPrice.Denom,
+		txSender:  sender,
 	}, nil
 }
 
@@ -75,13 +190,43 @@ func (bc *BabylonController) mustGetTxSigner() string {
 	return sdk.MustBech32ifyAddressBytes(prefix, signer)
 }
 
+// voteSigner returns the address on whose behalf the finality signatures are submitted,
+// which is the granter if the key is granted the permission through x/authz
+func (bc *BabylonController) voteSigner() string {
+	if bc.cfg.Granter != "" {
+		return bc.cfg.Granter
+	}
+	return bc.mustGetTxSigner()
+}
+
+// wrapVoteMsgs wraps the finality signature messages in a MsgExec of x/authz
+// if they are submitted on behalf of the granter
+func (bc *BabylonController) wrapVoteMsgs(msgs []sdk.Msg) []sdk.Msg {
+	if bc.cfg.Granter == "" {
+		return msgs
+	}
+	msgExec := authz.NewMsgExec(bc.GetKeyAddress(), msgs)
+	// the grantee is encoded with the account prefix of Babylon rather than
+	// the global one of the SDK config, which fpd leaves as the default
+	msgExec.Grantee = bc.mustGetTxSigner()
+	return []sdk.Msg{&msgExec}
+}
+
+// feePayer returns the address of the account paying for the transactions
+func (bc *BabylonController) feePayer() string {
+	if bc.cfg.FeeGranter != "" {
+		return bc.cfg.FeeGranter
+	}
+	return bc.mustGetTxSigner()
+}
+
 func (bc *BabylonController) GetKeyAddress() sdk.AccAddress {
 	// get key address, retrieves address based on key name which is configured in
 	// cfg *stakercfg.BBNConfig. If this fails, it means we have misconfiguration problem
 	// and we should panic.
 	// This is checked at the start of BabylonController, so if it fails something is really wrong
 
-	keyRec, err := bc.bbnClient.GetKeyring().Key(bc.cfg.Key)
+	keyRec, err := bc.kr.Key(bc.cfg.Key)
 
 	if err != nil {
 		panic(fmt.Sprintf("Failed to get key address: %s", err))
@@ -96,22 +241,36 @@ func (bc *BabylonController) GetKeyAddress() sdk.AccAddress {
 	return addr
 }
 
-func (bc *BabylonController) reliablySendMsg(msg sdk.Msg, expectedErrs []*sdkErr.Error, unrecoverableErrs []*sdkErr.Error) (*provider.RelayerTxResponse, error) {
-	return bc.reliablySendMsgs([]sdk.Msg{msg}, expectedErrs, unrecoverableErrs)
+func (bc *BabylonController) reliablySendMsg(ctx context.Context, msg sdk.Msg, expectedErrs []*sdkErr.Error, unrecoverableErrs []*sdkErr.Error) (*provider.RelayerTxResponse, error) {
+	return bc.reliablySendMsgs(ctx, []sdk.Msg{msg}, expectedErrs, unrecoverableErrs)
 }
 
-func (bc *BabylonController) reliablySendMsgs(msgs []sdk.Msg, expectedErrs []*sdkErr.Error, unrecoverableErrs []*sdkErr.Error) (*provider.RelayerTxResponse, error) {
-	return bc.bbnClient.ReliablySendMsgs(
-		context.Background(),
+func (bc *BabylonController) reliablySendMsgs(ctx context.Context, msgs []sdk.Msg, expectedErrs []*sdkErr.Error, unrecoverableErrs []*sdkErr.Error) (*provider.RelayerTxResponse, error) {
+	// the Babylon client does not support fee granters and applies the gas prices
+	// of its config to all the transactions, so the transactions paying the fees
+	// through x/feegrant or with the dynamic gas prices are sent without it, and
+	// so are all the transactions of the key once its sequence is tracked locally
+	if bc.cfg.FeeGranter != "" || bc.cfg.DynamicGasPrices || bc.sendAllWithTxSender {
+		return bc.sendMsgs(ctx, msgs)
+	}
+
+	res, err := bc.bbnClient.ReliablySendMsgs(
+		ctx,
 		msgs,
 		expectedErrs,
 		unrecoverableErrs,
 	)
+	if err != nil {
+		return nil, categorizeBabylonErr(err)
+	}
+
+	return res, nil
 }
 
 // RegisterFinalityProvider registers a finality provider via a MsgCreateFinalityProvider to Babylon
 // it returns tx hash, registered epoch, and error
 func (bc *BabylonController) RegisterFinalityProvider(
+	ctx context.Context,
 	chainPk []byte,
 	fpPk *btcec.PublicKey,
 	pop []byte,
@@ -139,12 +298,12 @@ func (bc *BabylonController) RegisterFinalityProvider(
 		MasterPubRand: masterPubRand,
 	}
 
-	res, err := bc.reliablySendMsg(msg, emptyErrs, emptyErrs)
+	res, err := bc.reliablySendMsg(ctx, msg, emptyErrs, emptyErrs)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	registeredEpoch, err := bc.QueryFinalityProviderRegisteredEpoch(fpPk)
+	registeredEpoch, err := bc.QueryFinalityProviderRegisteredEpoch(ctx, fpPk)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -153,22 +312,25 @@ func (bc *BabylonController) RegisterFinalityProvider(
 }
 
 // SubmitFinalitySig submits the finality signature via a MsgAddVote to Babylon
-func (bc *BabylonController) SubmitFinalitySig(fpPk *btcec.PublicKey, blockHeight uint64, blockHash []byte, sig *btcec.ModNScalar) (*types.TxResponse, error) {
+func (bc *BabylonController) SubmitFinalitySig(ctx context.Context, fpPk *btcec.PublicKey, blockHeight uint64, blockHash []byte, sig *btcec.ModNScalar) (*types.TxResponse, error) {
 	msg := &finalitytypes.MsgAddFinalitySig{
-		Signer:       bc.mustGetTxSigner(),
+		Signer:       bc.voteSigner(),
 		FpBtcPk:      bbntypes.NewBIP340PubKeyFromBTCPK(fpPk),
 		BlockHeight:  blockHeight,
 		BlockAppHash: blockHash,
 		FinalitySig:  bbntypes.NewSchnorrEOTSSigFromModNScalar(sig),
 	}
 
+	// the duplicated signatures are not retried by the Babylon client
+	// and they are returned as expected errors
 	unrecoverableErrs := []*sdkErr.Error{
 		finalitytypes.ErrInvalidFinalitySig,
 		finalitytypes.ErrPubRandNotFound,
+		finalitytypes.ErrDuplicatedFinalitySig,
 		btcstakingtypes.ErrFpAlreadySlashed,
 	}
 
-	res, err := bc.reliablySendMsg(msg, emptyErrs, unrecoverableErrs)
+	res, err := bc.reliablySendMsgs(ctx, bc.wrapVoteMsgs([]sdk.Msg{msg}), emptyErrs, unrecoverableErrs)
 	if err != nil {
 		return nil, err
 	}
@@ -177,30 +339,26 @@ func (bc *BabylonController) SubmitFinalitySig(fpPk *btcec.PublicKey, blockHeigh
 }
 
 // SubmitBatchFinalitySigs submits a batch of finality signatures to Babylon
-func (bc *BabylonController) SubmitBatchFinalitySigs(fpPk *btcec.PublicKey, blocks []*types.BlockInfo, sigs []*btcec.ModNScalar) (*types.TxResponse, error) {
+func (bc *BabylonController) SubmitBatchFinalitySigs(ctx context.Context, fpPk *btcec.PublicKey, blocks []*types.BlockInfo, sigs []*btcec.ModNScalar) (*types.TxResponse, error) {
 	if len(blocks) != len(sigs) {
 		return nil, fmt.Errorf("the number of blocks %v should match the number of finality signatures %v", len(blocks), len(sigs))
 	}
 
-	msgs := make([]sdk.Msg, 0, len(blocks))
+	finalitySigs := make([]*types.FinalitySig, 0, len(blocks))
 	for i, b := range blocks {
-		msg := &finalitytypes.MsgAddFinalitySig{
-			Signer:       bc.mustGetTxSigner(),
-			FpBtcPk:      bbntypes.NewBIP340PubKeyFromBTCPK(fpPk),
-			BlockHeight:  b.Height,
-			BlockAppHash: b.Hash,
-			FinalitySig:  bbntypes.NewSchnorrEOTSSigFromModNScalar(sigs[i]),
-		}
-		msgs = append(msgs, msg)
+		finalitySigs = append(finalitySigs, &types.FinalitySig{FpPk: fpPk, Block: b, Sig: sigs[i]})
 	}
 
+	// the duplicated signatures are not retried by the Babylon client
+	// and they are returned as expected errors
 	unrecoverableErrs := []*sdkErr.Error{
 		finalitytypes.ErrInvalidFinalitySig,
 		finalitytypes.ErrPubRandNotFound,
+		finalitytypes.ErrDuplicatedFinalitySig,
 		btcstakingtypes.ErrFpAlreadySlashed,
 	}
 
-	res, err := bc.reliablySendMsgs(msgs, emptyErrs, unrecoverableErrs)
+	res, err := bc.reliablySendMsgs(ctx, bc.finalitySigMsgs(finalitySigs), emptyErrs, unrecoverableErrs)
 	if err != nil {
 		return nil, err
 	}
@@ -208,25 +366,70 @@ func (bc *BabylonController) SubmitBatchFinalitySigs(fpPk *btcec.PublicKey, bloc
 	return &types.TxResponse{TxHash: res.TxHash, Events: res.Events}, nil
 }
 
-func (bc *BabylonController) QueryFinalityProviderSlashed(fpPk *btcec.PublicKey) (bool, error) {
-	fpPubKey := bbntypes.NewBIP340PubKeyFromBTCPK(fpPk)
-	res, err := bc.bbnClient.QueryClient.FinalityProvider(fpPubKey.MarshalHex())
+// SubmitFinalitySigs submits the finality signatures of several finality providers
+// in a single transaction, which is broadcast without waiting for the previous
+// transactions of the key to be included
+func (bc *BabylonController) SubmitFinalitySigs(ctx context.Context, sigs []*types.FinalitySig) (*types.TxResponse, error) {
+	if len(sigs) == 0 {
+		return nil, fmt.Errorf("should not submit zero finality signatures")
+	}
+
+	res, err := bc.sendMsgs(ctx, bc.finalitySigMsgs(sigs))
 	if err != nil {
-		return false, fmt.Errorf("failed to query the finality provider %s: %v", fpPubKey.MarshalHex(), err)
+		return nil, err
+	}
+
+	return &types.TxResponse{TxHash: res.TxHash, Events: res.Events}, nil
+}
+
+// finalitySigMsgs builds the messages of the finality signatures, which
+// are wrapped in a MsgExec if they are submitted on behalf of the granter
+func (bc *BabylonController) finalitySigMsgs(sigs []*types.FinalitySig) []sdk.Msg {
+	msgs := make([]sdk.Msg, 0, len(sigs))
+	for _, s := range sigs {
+		msgs = append(msgs, &finalitytypes.MsgAddFinalitySig{
+			Signer:       bc.voteSigner(),
+			FpBtcPk:      bbntypes.NewBIP340PubKeyFromBTCPK(s.FpPk),
+			BlockHeight:  s.Block.Height,
+			BlockAppHash: s.Block.Hash,
+			FinalitySig:  bbntypes.NewSchnorrEOTSSigFromModNScalar(s.Sig),
+		})
 	}
 
-	slashed := res.FinalityProvider.SlashedBtcHeight > 0
+	return bc.wrapVoteMsgs(msgs)
+}
+
+func (bc *BabylonController) QueryFinalityProviderSlashed(ctx context.Context, fpPk *btcec.PublicKey) (bool, error) {
+	fpPubKey := bbntypes.NewBIP340PubKeyFromBTCPK(fpPk)
+	var res btcstakingtypes.QueryFinalityProviderResponse
+	req := &btcstakingtypes.QueryFinalityProviderRequest{FpBtcPkHex: fpPubKey.MarshalHex()}
+	if err := bc.protoQuery(ctx, finalityProviderPath, req, &res); err != nil {
+		return false, fmt.Errorf("failed to query the finality provider %s: %w", fpPubKey.MarshalHex(), err)
+	}
+
+	return res.FinalityProvider.SlashedBtcHeight > 0, nil
+}
+
+// QueryVotesAtHeight returns the BTC public keys of the finality providers whose votes
+// at the given height are recorded by Babylon
+func (bc *BabylonController) QueryVotesAtHeight(ctx context.Context, height uint64) ([]bbntypes.BIP340PubKey, error) {
+	var res finalitytypes.QueryVotesAtHeightResponse
+	req := &finalitytypes.QueryVotesAtHeightRequest{Height: height}
+	if err := bc.protoQuery(ctx, votesAtHeightPath, req, &res); err != nil {
+		return nil, fmt.Errorf("failed to query the votes at height %d: %w", height, err)
+	}
 
-	return slashed, nil
+	return res.BtcPks, nil
 }
 
 // QueryFinalityProviderVotingPower queries the voting power of the finality provider at a given height
-func (bc *BabylonController) QueryFinalityProviderVotingPower(fpPk *btcec.PublicKey, blockHeight uint64) (uint64, error) {
-	res, err := bc.bbnClient.QueryClient.FinalityProviderPowerAtHeight(
-		bbntypes.NewBIP340PubKeyFromBTCPK(fpPk).MarshalHex(),
-		blockHeight,
-	)
-	if err != nil {
+func (bc *BabylonController) QueryFinalityProviderVotingPower(ctx context.Context, fpPk *btcec.PublicKey, blockHeight uint64) (uint64, error) {
+	var res btcstakingtypes.QueryFinalityProviderPowerAtHeightResponse
+	req := &btcstakingtypes.QueryFinalityProviderPowerAtHeightRequest{
+		FpBtcPkHex: bbntypes.NewBIP340PubKeyFromBTCPK(fpPk).MarshalHex(),
+		Height:     blockHeight,
+	}
+	if err := bc.protoQuery(ctx, finalityProviderPowerPath, req, &res); err != nil {
 		return 0, fmt.Errorf("failed to query the finality provider's voting power at height %d: %w", blockHeight, err)
 	}
 
@@ -234,30 +437,32 @@ func (bc *BabylonController) QueryFinalityProviderVotingPower(fpPk *btcec.Public
 }
 
 // QueryFinalityProviderRegisteredEpoch queries the registered epoch of the finality provider
-func (bc *BabylonController) QueryFinalityProviderRegisteredEpoch(fpPk *btcec.PublicKey) (uint64, error) {
-	res, err := bc.bbnClient.QueryClient.FinalityProvider(
-		bbntypes.NewBIP340PubKeyFromBTCPK(fpPk).MarshalHex(),
-	)
-	if err != nil {
+func (bc *BabylonController) QueryFinalityProviderRegisteredEpoch(ctx context.Context, fpPk *btcec.PublicKey) (uint64, error) {
+	var res btcstakingtypes.QueryFinalityProviderResponse
+	req := &btcstakingtypes.QueryFinalityProviderRequest{
+		FpBtcPkHex: bbntypes.NewBIP340PubKeyFromBTCPK(fpPk).MarshalHex(),
+	}
+	if err := bc.protoQuery(ctx, finalityProviderPath, req, &res); err != nil {
 		return 0, fmt.Errorf("failed to query finality provider registered epoch: %w", err)
 	}
 
 	return res.FinalityProvider.RegisteredEpoch, nil
 }
 
-func (bc *BabylonController) QueryLatestFinalizedBlocks(count uint64) ([]*types.BlockInfo, error) {
-	return bc.queryLatestBlocks(nil, count, finalitytypes.QueriedBlockStatus_FINALIZED, true)
+func (bc *BabylonController) QueryLatestFinalizedBlocks(ctx context.Context, count uint64) ([]*types.BlockInfo, error) {
+	return bc.queryLatestBlocks(ctx, nil, count, finalitytypes.QueriedBlockStatus_FINALIZED, true)
 }
 
-func (bc *BabylonController) QueryLastFinalizedEpoch() (uint64, error) {
-	resp, err := bc.bbnClient.LatestEpochFromStatus(ckpttypes.Finalized)
-	if err != nil {
+func (bc *BabylonController) QueryLastFinalizedEpoch(ctx context.Context) (uint64, error) {
+	var res ckpttypes.QueryLastCheckpointWithStatusResponse
+	req := &ckpttypes.QueryLastCheckpointWithStatusRequest{Status: ckpttypes.Finalized}
+	if err := bc.protoQuery(ctx, lastCheckpointWithStatusPath, req, &res); err != nil {
 		return 0, err
 	}
-	return resp.RawCheckpoint.EpochNum, nil
+	return res.RawCheckpoint.EpochNum, nil
 }
 
-func (bc *BabylonController) QueryBlocks(startHeight, endHeight, limit uint64) ([]*types.BlockInfo, error) {
+func (bc *BabylonController) QueryBlocks(ctx context.Context, startHeight, endHeight, limit uint64) ([]*types.BlockInfo, error) {
 	if endHeight < startHeight {
 		return nil, fmt.Errorf("the startHeight %v should not be higher than the endHeight %v", startHeight, endHeight)
 	}
@@ -265,10 +470,10 @@ func (bc *BabylonController) QueryBlocks(startHeight, endHeight, limit uint64) (
 	if count > limit {
 		count = limit
 	}
-	return bc.queryLatestBlocks(sdk.Uint64ToBigEndian(startHeight), count, finalitytypes.QueriedBlockStatus_ANY, false)
+	return bc.queryLatestBlocks(ctx, sdk.Uint64ToBigEndian(startHeight), count, finalitytypes.QueriedBlockStatus_ANY, false)
 }
 
-func (bc *BabylonController) queryLatestBlocks(startKey []byte, count uint64, status finalitytypes.QueriedBlockStatus, reverse bool) ([]*types.BlockInfo, error) {
+func (bc *BabylonController) queryLatestBlocks(ctx context.Context, startKey []byte, count uint64, status finalitytypes.QueriedBlockStatus, reverse bool) ([]*types.BlockInfo, error) {
 	var blocks []*types.BlockInfo
 	pagination := &sdkquery.PageRequest{
 		Limit:   count,
@@ -276,11 +481,11 @@ func (bc *BabylonController) queryLatestBlocks(startKey []byte, count uint64, st
 		Key:     startKey,
 	}
 
-	res, err := bc.bbnClient.QueryClient.ListBlocks(status, pagination)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query finalized blocks: %v", err)
+	var res finalitytypes.QueryListBlocksResponse
+	req := &finalitytypes.QueryListBlocksRequest{Status: status, Pagination: pagination}
+	if err := bc.protoQuery(ctx, listBlocksPath, req, &res); err != nil {
+		return nil, fmt.Errorf("failed to query finalized blocks: %w", err)
 	}
-
 	for _, b := range res.Blocks {
 		ib := &types.BlockInfo{
 			Height: b.Height,
@@ -292,14 +497,16 @@ func (bc *BabylonController) queryLatestBlocks(startKey []byte, count uint64, st
 	return blocks, nil
 }
 
-func getContextWithCancel(timeout time.Duration) (context.Context, context.CancelFunc) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	return ctx, cancel
+// getContextWithCancel returns a child context of ctx which is
+// also canceled once the given timeout is reached
+func getContextWithCancel(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, timeout)
 }
 
-func (bc *BabylonController) QueryBlock(height uint64) (*types.BlockInfo, error) {
-	res, err := bc.bbnClient.QueryClient.Block(height)
-	if err != nil {
+func (bc *BabylonController) QueryBlock(ctx context.Context, height uint64) (*types.BlockInfo, error) {
+	var res finalitytypes.QueryBlockResponse
+	req := &finalitytypes.QueryBlockRequest{Height: height}
+	if err := bc.protoQuery(ctx, blockPath, req, &res); err != nil {
 		return nil, fmt.Errorf("failed to query indexed block at height %v: %w", height, err)
 	}
 
@@ -310,27 +517,27 @@ func (bc *BabylonController) QueryBlock(height uint64) (*types.BlockInfo, error)
 	}, nil
 }
 
-func (bc *BabylonController) QueryActivatedHeight() (uint64, error) {
-	res, err := bc.bbnClient.QueryClient.ActivatedHeight()
-	if err != nil {
+func (bc *BabylonController) QueryActivatedHeight(ctx context.Context) (uint64, error) {
+	var res btcstakingtypes.QueryActivatedHeightResponse
+	if err := bc.protoQuery(ctx, activatedHeightPath, &btcstakingtypes.QueryActivatedHeightRequest{}, &res); err != nil {
 		return 0, fmt.Errorf("failed to query activated height: %w", err)
 	}
 
 	return res.Height, nil
 }
 
-func (bc *BabylonController) QueryBestBlock() (*types.BlockInfo, error) {
-	blocks, err := bc.queryLatestBlocks(nil, 1, finalitytypes.QueriedBlockStatus_ANY, true)
+func (bc *BabylonController) QueryBestBlock(ctx context.Context) (*types.BlockInfo, error) {
+	blocks, err := bc.queryLatestBlocks(ctx, nil, 1, finalitytypes.QueriedBlockStatus_ANY, true)
 	if err != nil || len(blocks) != 1 {
 		// try query comet block if the index block query is not available
-		return bc.queryCometBestBlock()
+		return bc.queryCometBestBlock(ctx)
 	}
 
 	return blocks[0], nil
 }
 
-func (bc *BabylonController) queryCometBestBlock() (*types.BlockInfo, error) {
-	ctx, cancel := getContextWithCancel(bc.cfg.Timeout)
+func (bc *BabylonController) queryCometBestBlock(ctx context.Context) (*types.BlockInfo, error) {
+	ctx, cancel := getContextWithCancel(ctx, bc.cfg.Timeout)
 	// this will return 20 items at max in the descending order (highest first)
 	chainInfo, err := bc.bbnClient.RPCClient.BlockchainInfo(ctx, 0, 0)
 	defer cancel()
@@ -347,6 +554,212 @@ func (bc *BabylonController) queryCometBestBlock() (*types.BlockInfo, error) {
 	}, nil
 }
 
+// SubscribeNewBlocks subscribes to the new block events through the CometBFT websocket
+func (bc *BabylonController) SubscribeNewBlocks(ctx context.Context) (<-chan *types.BlockInfo, error) {
+	query := cmttypes.QueryForEvent(cmttypes.EventNewBlock).String()
+	eventChan, err := bc.bbnClient.RPCClient.Subscribe(ctx, newBlockSubscriber, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to new block events: %w", err)
+	}
+
+	blockChan := make(chan *types.BlockInfo)
+	go func() {
+		defer close(blockChan)
+		defer func() {
+			// the context is done, so the unsubscription uses a new one
+			unsubCtx, cancel := getContextWithCancel(context.Background(), bc.cfg.Timeout)
+			defer cancel()
+			if err := bc.bbnClient.RPCClient.Unsubscribe(unsubCtx, newBlockSubscriber, query); err != nil {
+				bc.logger.Debug("failed to unsubscribe from new block events", zap.Error(err))
+			}
+		}()
+
+		for {
+			select {
+			case event, ok := <-eventChan:
+				if !ok {
+					return
+				}
+				data, ok := event.Data.(cmttypes.EventDataNewBlock)
+				if !ok || data.Block == nil {
+					continue
+				}
+				block := &types.BlockInfo{
+					Height: uint64(data.Block.Height),
+					Hash:   data.Block.AppHash,
+				}
+				select {
+				case blockChan <- block:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return blockChan, nil
+}
+
+// QueryBalance queries the balance of the account paying for the transactions in the fee denom,
+// which is the fee granter if the fees are paid through x/feegrant
+func (bc *BabylonController) QueryBalance(ctx context.Context) (*sdk.Coin, error) {
+	req := &banktypes.QueryBalanceRequest{
+		Address: bc.feePayer(),
+		Denom:   bc.feeDenom,
+	}
+	var balanceRes banktypes.QueryBalanceResponse
+	if err := bc.protoQuery(ctx, bankBalancePath, req, &balanceRes); err != nil {
+		return nil, fmt.Errorf("failed to query the balance: %w", err)
+	}
+	if balanceRes.Balance == nil {
+		coin := sdk.NewInt64Coin(bc.feeDenom, 0)
+		return &coin, nil
+	}
+
+	return balanceRes.Balance, nil
+}
+
+// abciQuery sends the encoded request to the given ABCI query path and returns the encoded
+// response, the error of a failed query is mapped to the registered error of its code
+func (bc *BabylonController) abciQuery(ctx context.Context, path string, reqBytes []byte) ([]byte, error) {
+	ctx, cancel := getContextWithCancel(ctx, bc.cfg.Timeout)
+	defer cancel()
+
+	queryRes, err := bc.querier.ABCIQuery(ctx, path, reqBytes)
+	if err != nil {
+		return nil, err
+	}
+	if !queryRes.Response.IsOK() {
+		return nil, sdkErr.ABCIError(queryRes.Response.Codespace, queryRes.Response.Code, queryRes.Response.Log)
+	}
+
+	return queryRes.Response.Value, nil
+}
+
+// protoMarshaler and protoUnmarshaler are implemented by the generated
+// request and response types of the gRPC queries
+type protoMarshaler interface {
+	Marshal() ([]byte, error)
+}
+
+type protoUnmarshaler interface {
+	Unmarshal(data []byte) error
+}
+
+// protoQuery sends the encoded request to the given ABCI query path and decodes
+// the response into res. The query is canceled once ctx is done
+func (bc *BabylonController) protoQuery(ctx context.Context, path string, req protoMarshaler, res protoUnmarshaler) error {
+	reqBytes, err := req.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to encode the query request: %w", err)
+	}
+
+	resBytes, err := bc.abciQuery(ctx, path, reqBytes)
+	if err != nil {
+		return err
+	}
+
+	if err := res.Unmarshal(resBytes); err != nil {
+		return fmt.Errorf("failed to decode the query response: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateGasPrices sets the gas prices of the transactions to the median gas price of
+// the transactions in the recent blocks, bounded by the configured gas prices and max
+// gas prices. It returns the gas prices in use, which are not changed if the dynamic
+// gas prices are disabled
+func (bc *BabylonController) UpdateGasPrices(ctx context.Context) (string, error) {
+	if !bc.cfg.DynamicGasPrices {
+		return bc.getGasPrices(), nil
+	}
+
+	minPrice, maxPrice, err := bc.cfg.GasPriceBounds()
+	if err != nil {
+		return "", err
+	}
+
+	price, err := bc.queryMedianGasPrice(ctx, minPrice.Denom)
+	if err != nil {
+		return "", err
+	}
+	if price == nil || price.LT(minPrice.Amount) {
+		price = &minPrice.Amount
+	}
+	if price.GT(maxPrice.Amount) {
+		price = &maxPrice.Amount
+	}
+
+	gasPrices := sdk.NewDecCoinFromDec(minPrice.Denom, *price).String()
+	if gasPrices == bc.getGasPrices() {
+		return gasPrices, nil
+	}
+
+	// the transactions with the dynamic gas prices are signed by the
+	// transaction sender, which picks up the new gas prices at once
+	bc.gasMu.Lock()
+	bc.gasPrices = gasPrices
+	bc.gasMu.Unlock()
+
+	bc.logger.Info("the gas prices are updated", zap.String("gas_prices", gasPrices))
+
+	return gasPrices, nil
+}
+
+// queryMedianGasPrice returns the median gas price of the transactions paying
+// the fees in the given denom in the recent blocks, or nil if there is none
+func (bc *BabylonController) queryMedianGasPrice(ctx context.Context, denom string) (*math.LegacyDec, error) {
+	ctx, cancel := getContextWithCancel(ctx, bc.cfg.Timeout)
+	defer cancel()
+
+	chainInfo, err := bc.bbnClient.RPCClient.BlockchainInfo(ctx, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the latest block: %w", err)
+	}
+
+	var prices []math.LegacyDec
+	for i := uint64(0); i < bc.cfg.GasPriceBlocks && int64(i) < chainInfo.LastHeight; i++ {
+		height := chainInfo.LastHeight - int64(i)
+		block, err := bc.bbnClient.RPCClient.Block(ctx, &height)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query the block at height %d: %w", height, err)
+		}
+		for _, txBytes := range block.Block.Txs {
+			var tx txtypes.Tx
+			if err := tx.Unmarshal(txBytes); err != nil {
+				continue
+			}
+			if tx.AuthInfo == nil || tx.AuthInfo.Fee == nil || tx.AuthInfo.Fee.GasLimit == 0 {
+				continue
+			}
+			fee := tx.AuthInfo.Fee.Amount.AmountOf(denom)
+			if !fee.IsPositive() {
+				continue
+			}
+			prices = append(prices, math.LegacyNewDecFromInt(fee).QuoInt64(int64(tx.AuthInfo.Fee.GasLimit)))
+		}
+	}
+
+	if len(prices) == 0 {
+		return nil, nil
+	}
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i].LT(prices[j])
+	})
+
+	return &prices[len(prices)/2], nil
+}
+
+func (bc *BabylonController) getGasPrices() string {
+	bc.gasMu.RLock()
+	defer bc.gasMu.RUnlock()
+
+	return bc.gasPrices
+}
+
 func (bc *BabylonController) Close() error {
 	if !bc.bbnClient.IsRunning() {
 		return nil
@@ -364,6 +777,7 @@ func (bc *BabylonController) GetBBNClient() *bbnclient.Client {
 }
 
 func (bc *BabylonController) CreateBTCDelegation(
+	ctx context.Context,
 	delBabylonPk *secp256k1.PubKey,
 	delBtcPk *bbntypes.BIP340PubKey,
 	fpPks []*btcec.PublicKey,
@@ -401,7 +815,7 @@ func (bc *BabylonController) CreateBTCDelegation(
 		DelegatorUnbondingSlashingSig: delUnbondingSlashingSig,
 	}
 
-	res, err := bc.reliablySendMsg(msg, emptyErrs, emptyErrs)
+	res, err := bc.reliablySendMsg(ctx, msg, emptyErrs, emptyErrs)
 	if err != nil {
 		return nil, err
 	}
@@ -409,13 +823,13 @@ func (bc *BabylonController) CreateBTCDelegation(
 	return &types.TxResponse{TxHash: res.TxHash}, nil
 }
 
-func (bc *BabylonController) InsertBtcBlockHeaders(headers []bbntypes.BTCHeaderBytes) (*provider.RelayerTxResponse, error) {
+func (bc *BabylonController) InsertBtcBlockHeaders(ctx context.Context, headers []bbntypes.BTCHeaderBytes) (*provider.RelayerTxResponse, error) {
 	msg := &btclctypes.MsgInsertHeaders{
 		Signer:  bc.mustGetTxSigner(),
 		Headers: headers,
 	}
 
-	res, err := bc.reliablySendMsg(msg, emptyErrs, emptyErrs)
+	res, err := bc.reliablySendMsg(ctx, msg, emptyErrs, emptyErrs)
 	if err != nil {
 		return nil, err
 	}
@@ -454,15 +868,6 @@ func (bc *BabylonController) QueryBtcLightClientTip() (*btclctypes.BTCHeaderInfo
 	return res.Header, nil
 }
 
-func (bc *BabylonController) QueryVotesAtHeight(height uint64) ([]bbntypes.BIP340PubKey, error) {
-	res, err := bc.bbnClient.QueryClient.VotesAtHeight(height)
-	if err != nil {
-		return nil, fmt.Errorf("failed to query BTC delegations: %w", err)
-	}
-
-	return res.BtcPks, nil
-}
-
 func (bc *BabylonController) QueryPendingDelegations(limit uint64) ([]*btcstakingtypes.BTCDelegationResponse, error) {
 	return bc.queryDelegationsWithStatus(btcstakingtypes.BTCDelegationStatus_PENDING, limit)
 }
@@ -526,6 +931,7 @@ func (bc *BabylonController) QueryStakingParams() (*types.StakingParams, error)
 }
 
 func (bc *BabylonController) SubmitCovenantSigs(
+	ctx context.Context,
 	covPk *btcec.PublicKey,
 	stakingTxHash string,
 	slashingSigs [][]byte,
@@ -543,7 +949,7 @@ func (bc *BabylonController) SubmitCovenantSigs(
 		SlashingUnbondingTxSigs: unbondingSlashingSigs,
 	}
 
-	res, err := bc.reliablySendMsg(msg, emptyErrs, emptyErrs)
+	res, err := bc.reliablySendMsg(ctx, msg, emptyErrs, emptyErrs)
 	if err != nil {
 		return nil, err
 	}
@@ -551,13 +957,13 @@ func (bc *BabylonController) SubmitCovenantSigs(
 	return &types.TxResponse{TxHash: res.TxHash, Events: res.Events}, nil
 }
 
-func (bc *BabylonController) InsertSpvProofs(submitter string, proofs []*btcctypes.BTCSpvProof) (*provider.RelayerTxResponse, error) {
+func (bc *BabylonController) InsertSpvProofs(ctx context.Context, submitter string, proofs []*btcctypes.BTCSpvProof) (*provider.RelayerTxResponse, error) {
 	msg := &btcctypes.MsgInsertBTCSpvProof{
 		Submitter: submitter,
 		Proofs:    proofs,
 	}
 
-	res, err := bc.reliablySendMsg(msg, emptyErrs, emptyErrs)
+	res, err := bc.reliablySendMsg(ctx, msg, emptyErrs, emptyErrs)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,395 @@
+package clientcontroller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	btcstakingtypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	finalitytypes "github.com/babylonchain/babylon/x/finality/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	jsonrpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
+	"github.com/babylonchain/finality-provider/types"
+)
+
+// mockBabylonQuerier answers the ABCI queries sent to Babylon with the
+// given handler, and blocks until the context is done if there's none
+type mockBabylonQuerier struct {
+	handle func(path string, data []byte) abci.ResponseQuery
+	// canceled is closed once a blocked query observes that its context is done
+	canceled chan struct{}
+}
+
+func (q *mockBabylonQuerier) ABCIQuery(ctx context.Context, path string, data cmtbytes.HexBytes) (*coretypes.ResultABCIQuery, error) {
+	if q.handle == nil {
+		<-ctx.Done()
+		close(q.canceled)
+		return nil, ctx.Err()
+	}
+
+	return &coretypes.ResultABCIQuery{Response: q.handle(path, data)}, nil
+}
+
+// mockTxRPCClient answers the transaction queries with the given handler
+type mockTxRPCClient struct {
+	broadcast func(tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error)
+	tx        func(hash []byte) (*coretypes.ResultTx, error)
+}
+
+func (c *mockTxRPCClient) BroadcastTxSync(_ context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	return c.broadcast(tx)
+}
+
+func (c *mockTxRPCClient) Tx(_ context.Context, hash []byte, _ bool) (*coretypes.ResultTx, error) {
+	return c.tx(hash)
+}
+
+func newMockedBabylonController(querier abciQuerier) *BabylonController {
+	return &BabylonController{
+		querier: querier,
+		cfg:     &fpcfg.BBNConfig{Timeout: time.Minute},
+	}
+}
+
+// newMockedBabylonSigner returns a Babylon controller signing with a
+// new key in memory, along with the address of the key
+func newMockedBabylonSigner(t *testing.T, cfg *fpcfg.BBNConfig) (*BabylonController, string) {
+	sender, err := newTxSender(cfg.AccountPrefix)
+	require.NoError(t, err)
+	kr := keyring.NewInMemory(codec.NewProtoCodec(sender.registry))
+	record, _, err := kr.NewMnemonic(cfg.Key, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+
+	bc := &BabylonController{
+		cfg:      cfg,
+		kr:       kr,
+		txSender: sender,
+		logger:   zap.NewNop(),
+	}
+
+	return bc, sdk.MustBech32ifyAddressBytes(cfg.AccountPrefix, addr)
+}
+
+func FuzzBabylonQueries(f *testing.F) {
+	addRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		activatedHeight := uint64(r.Int63n(1000) + 1)
+		missingHeight := activatedHeight + uint64(r.Int63n(1000))
+
+		bc := newMockedBabylonController(&mockBabylonQuerier{
+			handle: func(path string, data []byte) abci.ResponseQuery {
+				switch path {
+				case activatedHeightPath:
+					res := &btcstakingtypes.QueryActivatedHeightResponse{Height: activatedHeight}
+					bz, err := res.Marshal()
+					require.NoError(t, err)
+					return abci.ResponseQuery{Value: bz}
+				case blockPath:
+					var req finalitytypes.QueryBlockRequest
+					require.NoError(t, req.Unmarshal(data))
+					require.Equal(t, missingHeight, req.Height)
+					return abci.ResponseQuery{
+						Codespace: finalitytypes.ErrBlockNotFound.Codespace(),
+						Code:      finalitytypes.ErrBlockNotFound.ABCICode(),
+						Log:       finalitytypes.ErrBlockNotFound.Error(),
+					}
+				default:
+					t.Fatalf("unexpected query path %s", path)
+					return abci.ResponseQuery{}
+				}
+			},
+		})
+
+		queriedHeight, err := bc.QueryActivatedHeight(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, activatedHeight, queriedHeight)
+
+		// the failed query is mapped to the registered error of its code
+		_, err = bc.QueryBlock(context.Background(), missingHeight)
+		require.ErrorIs(t, err, finalitytypes.ErrBlockNotFound)
+	})
+}
+
+func TestBabylonQueryCanceled(t *testing.T) {
+	querier := &mockBabylonQuerier{canceled: make(chan struct{})}
+	bc := newMockedBabylonController(querier)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errChan := make(chan error, 1)
+	go func() {
+		_, err := bc.QueryActivatedHeight(ctx)
+		errChan <- err
+	}()
+	cancel()
+
+	// the in-flight query is canceled rather than abandoned
+	select {
+	case <-querier.canceled:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the query is not canceled")
+	}
+	require.True(t, errors.Is(<-errChan, context.Canceled))
+}
+
+// FuzzBabylonGranters tests that the finality signatures are signed by the key
+// or wrapped in a MsgExec on behalf of the granter, and the fees are paid by
+// the key or the fee granter
+func FuzzBabylonGranters(f *testing.F) {
+	addRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		fpSk, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+		sig := &types.FinalitySig{
+			FpPk:  fpSk.PubKey(),
+			Block: &types.BlockInfo{Height: uint64(r.Int63n(1000) + 1), Hash: datagen.GenRandomByteArray(r, 32)},
+			Sig:   new(btcec.ModNScalar).SetInt(uint32(r.Int63n(1000) + 1)),
+		}
+
+		// the key votes and pays for itself without granters
+		cfg := &fpcfg.BBNConfig{Key: "test", AccountPrefix: "bbn"}
+		bc, keyAddr := newMockedBabylonSigner(t, cfg)
+		require.Equal(t, keyAddr, bc.voteSigner())
+		require.Equal(t, keyAddr, bc.feePayer())
+		msgs := bc.finalitySigMsgs([]*types.FinalitySig{sig})
+		require.Len(t, msgs, 1)
+		msg, ok := msgs[0].(*finalitytypes.MsgAddFinalitySig)
+		require.True(t, ok)
+		require.Equal(t, keyAddr, msg.Signer)
+		require.Equal(t, sig.Block.Height, msg.BlockHeight)
+
+		// the key votes on behalf of the granter and the fee granter pays
+		cfg.Granter = sdk.MustBech32ifyAddressBytes(cfg.AccountPrefix, datagen.GenRandomByteArray(r, 20))
+		cfg.FeeGranter = sdk.MustBech32ifyAddressBytes(cfg.AccountPrefix, datagen.GenRandomByteArray(r, 20))
+		require.NoError(t, cfg.Validate())
+		require.Equal(t, cfg.Granter, bc.voteSigner())
+		require.Equal(t, cfg.FeeGranter, bc.feePayer())
+		msgs = bc.finalitySigMsgs([]*types.FinalitySig{sig})
+		require.Len(t, msgs, 1)
+		msgExec, ok := msgs[0].(*authz.MsgExec)
+		require.True(t, ok)
+		require.Equal(t, keyAddr, msgExec.Grantee)
+		innerMsgs, err := msgExec.GetMessages()
+		require.NoError(t, err)
+		require.Len(t, innerMsgs, 1)
+		msg, ok = innerMsgs[0].(*finalitytypes.MsgAddFinalitySig)
+		require.True(t, ok)
+		require.Equal(t, cfg.Granter, msg.Signer)
+		require.Equal(t, sig.Block.Height, msg.BlockHeight)
+	})
+}
+
+func TestBabylonWaitForTx(t *testing.T) {
+	bc := newMockedBabylonController(nil)
+	bc.cfg.BlockTimeout = time.Minute
+	hash := datagen.GenRandomByteArray(rand.New(rand.NewSource(time.Now().UnixNano())), 32)
+
+	// the transaction is polled until it is included
+	var queries int
+	bc.txClient = &mockTxRPCClient{tx: func(h []byte) (*coretypes.ResultTx, error) {
+		queries++
+		if queries == 1 {
+			return nil, &jsonrpctypes.RPCError{Code: rpcInternalErrorCode, Message: "Internal error", Data: fmt.Sprintf("tx (%X) not found", h)}
+		}
+		return &coretypes.ResultTx{Hash: h, Height: 10}, nil
+	}}
+	res, err := bc.waitForTx(context.Background(), hash)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), res.Height)
+	require.Equal(t, 2, queries)
+
+	// the failed transaction is mapped to the registered error of its code
+	bc.txClient = &mockTxRPCClient{tx: func(h []byte) (*coretypes.ResultTx, error) {
+		return &coretypes.ResultTx{Hash: h, TxResult: abci.ExecTxResult{
+			Codespace: finalitytypes.ErrDuplicatedFinalitySig.Codespace(),
+			Code:      finalitytypes.ErrDuplicatedFinalitySig.ABCICode(),
+		}}, nil
+	}}
+	_, err = bc.waitForTx(context.Background(), hash)
+	require.True(t, IsExpected(err))
+
+	// the other errors of the query are not mistaken for a pending transaction
+	rpcErr := fmt.Errorf("connection refused")
+	bc.txClient = &mockTxRPCClient{tx: func(h []byte) (*coretypes.ResultTx, error) {
+		return nil, rpcErr
+	}}
+	_, err = bc.waitForTx(context.Background(), hash)
+	require.ErrorIs(t, err, rpcErr)
+	otherErr := &jsonrpctypes.RPCError{Code: rpcInternalErrorCode, Message: "Internal error", Data: "failed to load the block"}
+	bc.txClient = &mockTxRPCClient{tx: func(h []byte) (*coretypes.ResultTx, error) {
+		return nil, otherErr
+	}}
+	_, err = bc.waitForTx(context.Background(), hash)
+	require.ErrorIs(t, err, otherErr)
+}
+
+// mockBabylonMempool accepts the transactions of the key in the order of their
+// sequence, like the mempool of Babylon, and answers the queries of the account
+// with the sequence of the committed state
+type mockBabylonMempool struct {
+	t             *testing.T
+	txConfig      client.TxConfig
+	accountNumber uint64
+	// committed is the sequence of the account on the chain
+	committed uint64
+	// expected is the sequence expected by the mempool, which is ahead of
+	// the committed one while there are pending transactions
+	expected uint64
+	// accountQueries is the number of the queries of the account
+	accountQueries int
+	// broadcastErr fails the broadcast after the transaction is accepted
+	broadcastErr error
+}
+
+func (m *mockBabylonMempool) handle(path string, data []byte) abci.ResponseQuery {
+	switch path {
+	case authAccountPath:
+		m.accountQueries++
+		var req authtypes.QueryAccountRequest
+		require.NoError(m.t, req.Unmarshal(data))
+		addr, err := sdk.GetFromBech32(req.Address, "bbn")
+		require.NoError(m.t, err)
+		account, err := codectypes.NewAnyWithValue(authtypes.NewBaseAccount(addr, nil, m.accountNumber, m.committed))
+		require.NoError(m.t, err)
+		res := &authtypes.QueryAccountResponse{Account: account}
+		bz, err := res.Marshal()
+		require.NoError(m.t, err)
+		return abci.ResponseQuery{Value: bz}
+	case txSimulatePath:
+		res := &txtypes.SimulateResponse{GasInfo: &sdk.GasInfo{GasUsed: 100000}}
+		bz, err := res.Marshal()
+		require.NoError(m.t, err)
+		return abci.ResponseQuery{Value: bz}
+	default:
+		m.t.Fatalf("unexpected query path %s", path)
+		return abci.ResponseQuery{}
+	}
+}
+
+func (m *mockBabylonMempool) broadcast(tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	decoded, err := m.txConfig.TxDecoder()(tx)
+	require.NoError(m.t, err)
+	sigTx, ok := decoded.(authsigning.SigVerifiableTx)
+	require.True(m.t, ok)
+	sigs, err := sigTx.GetSignaturesV2()
+	require.NoError(m.t, err)
+	require.Len(m.t, sigs, 1)
+
+	if sigs[0].Sequence != m.expected {
+		return &coretypes.ResultBroadcastTx{
+			Codespace: sdkerrors.ErrWrongSequence.Codespace(),
+			Code:      sdkerrors.ErrWrongSequence.ABCICode(),
+			Log:       fmt.Sprintf("account sequence mismatch, expected %d, got %d: incorrect account sequence", m.expected, sigs[0].Sequence),
+		}, nil
+	}
+	m.expected++
+	if m.broadcastErr != nil {
+		return nil, m.broadcastErr
+	}
+
+	return &coretypes.ResultBroadcastTx{Hash: tx.Hash()}, nil
+}
+
+// FuzzBabylonBroadcastSequence tests that the account sequence is bumped locally
+// for each broadcast transaction, and is synced up with the chain once the
+// mempool rejects a transaction for an account sequence mismatch
+func FuzzBabylonBroadcastSequence(f *testing.F) {
+	addRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		cfg := &fpcfg.BBNConfig{
+			Key:           "test",
+			AccountPrefix: "bbn",
+			ChainID:       "chain-test",
+			GasAdjustment: 1.5,
+			Timeout:       time.Minute,
+		}
+		bc, keyAddr := newMockedBabylonSigner(t, cfg)
+		bc.gasPrices = "0.002ubbn"
+		sequence := uint64(r.Int63n(1000))
+		mempool := &mockBabylonMempool{
+			t:             t,
+			txConfig:      bc.txSender.txConfig,
+			accountNumber: uint64(r.Int63n(1000)),
+			committed:     sequence,
+			expected:      sequence,
+		}
+		bc.querier = &mockBabylonQuerier{handle: mempool.handle}
+		bc.txClient = &mockTxRPCClient{broadcast: mempool.broadcast}
+		msgs := []sdk.Msg{&authtypes.MsgUpdateParams{Authority: keyAddr, Params: authtypes.DefaultParams()}}
+
+		// the transactions are broadcast one after another with the sequence
+		// queried once and tracked locally, while none of them is included
+		numTxs := int(r.Int63n(10) + 1)
+		for i := 0; i < numTxs; i++ {
+			_, err := bc.broadcastMsgs(context.Background(), msgs)
+			require.NoError(t, err)
+		}
+		require.Equal(t, 1, mempool.accountQueries)
+		require.Equal(t, sequence+uint64(numTxs), bc.txSender.sequence)
+		require.Equal(t, mempool.expected, bc.txSender.sequence)
+
+		// the sequence is taken by the transaction that might have been accepted
+		// by the mempool even if the broadcast fails
+		mempool.broadcastErr = fmt.Errorf("connection reset")
+		_, err := bc.broadcastMsgs(context.Background(), msgs)
+		require.ErrorIs(t, err, mempool.broadcastErr)
+		require.Equal(t, CategoryTransient, CategoryOf(err))
+		require.Equal(t, mempool.expected, bc.txSender.sequence)
+		mempool.broadcastErr = nil
+
+		// another process sends transactions of the key, which are included along
+		// with the pending ones, so the sequence is synced up with the chain after
+		// the mismatch and the transaction is broadcast once more
+		mempool.expected += uint64(r.Int63n(10) + 1)
+		mempool.committed = mempool.expected
+		_, err = bc.broadcastMsgs(context.Background(), msgs)
+		require.NoError(t, err)
+		require.Equal(t, 2, mempool.accountQueries)
+		require.Equal(t, mempool.expected, bc.txSender.sequence)
+
+		// the mempool is ahead of the chain due to the pending transactions sent
+		// by another process, so the sequence mismatch is returned and the sequence
+		// is synced up again for the next transaction
+		mempool.committed = mempool.expected
+		mempool.expected += uint64(r.Int63n(10) + 1)
+		_, err = bc.broadcastMsgs(context.Background(), msgs)
+		require.True(t, IsSequenceMismatch(err))
+		require.Equal(t, 3, mempool.accountQueries)
+		require.False(t, bc.txSender.synced)
+
+		// the pending transactions are included
+		mempool.committed = mempool.expected
+		_, err = bc.broadcastMsgs(context.Background(), msgs)
+		require.NoError(t, err)
+		require.Equal(t, 4, mempool.accountQueries)
+		require.Equal(t, mempool.expected, bc.txSender.sequence)
+		require.True(t, bc.txSender.synced)
+	})
+}
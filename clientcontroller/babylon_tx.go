@@ -0,0 +1,335 @@
+package clientcontroller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	sdkErr "cosmossdk.io/errors"
+	txsigning "cosmossdk.io/x/tx/signing"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	jsonrpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/cosmos/relayer/v2/relayer/provider"
+)
+
+const (
+	// the ABCI query paths of the queries to build the transactions
+	authAccountPath = "/cosmos.auth.v1beta1.Query/Account"
+	txSimulatePath  = "/cosmos.tx.v1beta1.Service/Simulate"
+
+	// txPollInterval is the interval between the queries of a broadcast transaction
+	txPollInterval = 1 * time.Second
+	// rpcInternalErrorCode is the JSON-RPC code of the internal errors of CometBFT
+	rpcInternalErrorCode = -32603
+)
+
+// txRPCClient broadcasts the transactions to Babylon and queries their results
+type txRPCClient interface {
+	BroadcastTxSync(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error)
+	Tx(ctx context.Context, hash []byte, prove bool) (*coretypes.ResultTx, error)
+}
+
+// txSender holds what is needed to build and sign the transactions sent without
+// the Babylon client, which are the ones paying the fees through x/feegrant and
+// the aggregated finality signatures
+type txSender struct {
+	registry codectypes.InterfaceRegistry
+	txConfig client.TxConfig
+
+	// mu guards the account sequence, which is tracked locally once queried
+	// so that a transaction can be broadcast before the previous ones are
+	// included
+	mu            sync.Mutex
+	synced        bool
+	accountNumber uint64
+	sequence      uint64
+}
+
+func newTxSender(accountPrefix string) (*txSender, error) {
+	signingOptions := txsigning.Options{
+		AddressCodec:          authcodec.NewBech32Codec(accountPrefix),
+		ValidatorAddressCodec: authcodec.NewBech32Codec(accountPrefix + sdk.PrefixValidator + sdk.PrefixOperator),
+	}
+
+	registry, err := codectypes.NewInterfaceRegistryWithOptions(codectypes.InterfaceRegistryOptions{
+		ProtoFiles:     gogoproto.HybridResolver,
+		SigningOptions: signingOptions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	std.RegisterInterfaces(registry)
+	authtypes.RegisterInterfaces(registry)
+
+	txConfig, err := authtx.NewTxConfigWithOptions(codec.NewProtoCodec(registry), authtx.ConfigOptions{
+		EnabledSignModes: []signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT},
+		SigningOptions:   &signingOptions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &txSender{
+		registry: registry,
+		txConfig: txConfig,
+	}, nil
+}
+
+// sendMsgs broadcasts a transaction of the messages signed with the key and waits for
+// its inclusion. The account sequence is only held during the broadcast, so that the
+// concurrent calls are pipelined. Unlike the Babylon client, it does not retry the
+// failed transaction, and the fees are paid by the fee granter if there is one
+func (bc *BabylonController) sendMsgs(ctx context.Context, msgs []sdk.Msg) (*provider.RelayerTxResponse, error) {
+	hash, err := bc.broadcastMsgs(ctx, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return bc.waitForTx(ctx, hash)
+}
+
+// broadcastMsgs broadcasts a transaction of the messages with the next account sequence
+// and returns its hash once it is accepted by the mempool
+func (bc *BabylonController) broadcastMsgs(ctx context.Context, msgs []sdk.Msg) ([]byte, error) {
+	sender := bc.txSender
+	sender.mu.Lock()
+	defer sender.mu.Unlock()
+
+	if !sender.synced {
+		if err := bc.syncAccount(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	hash, err := bc.signAndBroadcast(ctx, msgs)
+	if IsSequenceMismatch(err) {
+		// the local sequence is out of date, e.g., a transaction of the key has
+		// been sent by another process or a pending one has been evicted from
+		// the mempool, so it is synced up with the chain and the transaction
+		// is broadcast once more
+		if err := bc.syncAccount(ctx); err != nil {
+			return nil, err
+		}
+		hash, err = bc.signAndBroadcast(ctx, msgs)
+		if IsSequenceMismatch(err) {
+			// the mempool is still ahead of the chain due to the pending
+			// transactions, so the sequence is synced up again next time
+			sender.synced = false
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sender.sequence++
+
+	return hash, nil
+}
+
+// syncAccount sets the local account number and sequence to the ones on the chain
+func (bc *BabylonController) syncAccount(ctx context.Context) error {
+	account, err := bc.queryAccount(ctx, bc.mustGetTxSigner())
+	if err != nil {
+		return err
+	}
+
+	bc.txSender.accountNumber = account.GetAccountNumber()
+	bc.txSender.sequence = account.GetSequence()
+	bc.txSender.synced = true
+
+	return nil
+}
+
+// signAndBroadcast builds a transaction of the messages with the local account sequence,
+// signs it with the key, and broadcasts it
+func (bc *BabylonController) signAndBroadcast(ctx context.Context, msgs []sdk.Msg) ([]byte, error) {
+	sender := bc.txSender
+
+	txf := tx.Factory{}.
+		WithTxConfig(sender.txConfig).
+		WithKeybase(bc.kr).
+		WithChainID(bc.cfg.ChainID).
+		WithAccountNumber(sender.accountNumber).
+		WithSequence(sender.sequence).
+		WithGasAdjustment(bc.cfg.GasAdjustment).
+		WithGasPrices(bc.getGasPrices()).
+		WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+	if bc.cfg.FeeGranter != "" {
+		feeGranter, err := sdk.GetFromBech32(bc.cfg.FeeGranter, bc.cfg.AccountPrefix)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fee granter address %s: %w", bc.cfg.FeeGranter, err)
+		}
+		txf = txf.WithFeeGranter(feeGranter)
+	}
+
+	gas, err := bc.estimateGas(ctx, txf, msgs)
+	if err != nil {
+		return nil, err
+	}
+	txf = txf.WithGas(gas)
+
+	txBuilder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the transaction: %w", err)
+	}
+	if err := tx.Sign(ctx, txf, bc.cfg.Key, txBuilder, true); err != nil {
+		return nil, fmt.Errorf("failed to sign the transaction: %w", err)
+	}
+	txBytes, err := sender.txConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the transaction: %w", err)
+	}
+
+	broadcastCtx, cancel := getContextWithCancel(ctx, bc.cfg.Timeout)
+	defer cancel()
+	broadcastRes, err := bc.txClient.BroadcastTxSync(broadcastCtx, txBytes)
+	if err != nil {
+		// the transaction might have been accepted by the mempool, so its sequence
+		// is taken rather than synced up with the committed state, which is behind
+		// the mempool while the transaction is pending. If it was not accepted,
+		// the sequence is synced up after the mismatch of the next transaction
+		sender.sequence++
+		return nil, categorizeBabylonErr(fmt.Errorf("failed to broadcast the transaction: %w", err))
+	}
+	if broadcastRes.Code != 0 {
+		return nil, categorizeBabylonErr(sdkErr.ABCIError(broadcastRes.Codespace, broadcastRes.Code, broadcastRes.Log))
+	}
+
+	return broadcastRes.Hash, nil
+}
+
+// estimateGas simulates the transaction of the messages and returns its gas
+// limit, which is the gas used by the simulation with the gas adjustment
+func (bc *BabylonController) estimateGas(ctx context.Context, txf tx.Factory, msgs []sdk.Msg) (uint64, error) {
+	simTxBytes, err := txf.BuildSimTx(msgs...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to build the simulated transaction: %w", err)
+	}
+
+	req := &txtypes.SimulateRequest{TxBytes: simTxBytes}
+	reqBytes, err := req.Marshal()
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode the simulation request: %w", err)
+	}
+
+	resBytes, err := bc.abciQuery(ctx, txSimulatePath, reqBytes)
+	if err != nil {
+		return 0, categorizeBabylonErr(fmt.Errorf("failed to simulate the transaction: %w", err))
+	}
+
+	var simRes txtypes.SimulateResponse
+	if err := simRes.Unmarshal(resBytes); err != nil {
+		return 0, fmt.Errorf("failed to decode the simulation response: %w", err)
+	}
+	if simRes.GasInfo == nil {
+		return 0, fmt.Errorf("the simulation response has no gas info")
+	}
+
+	return uint64(math.Ceil(float64(simRes.GasInfo.GasUsed) * bc.cfg.GasAdjustment)), nil
+}
+
+// queryAccount queries the account number and the sequence of the given address
+func (bc *BabylonController) queryAccount(ctx context.Context, address string) (sdk.AccountI, error) {
+	req := &authtypes.QueryAccountRequest{Address: address}
+	reqBytes, err := req.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the account query request: %w", err)
+	}
+
+	resBytes, err := bc.abciQuery(ctx, authAccountPath, reqBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the account %s: %w", address, err)
+	}
+
+	var accountRes authtypes.QueryAccountResponse
+	if err := accountRes.Unmarshal(resBytes); err != nil {
+		return nil, fmt.Errorf("failed to decode the account query response: %w", err)
+	}
+
+	var account sdk.AccountI
+	if err := bc.txSender.registry.UnpackAny(accountRes.Account, &account); err != nil {
+		return nil, fmt.Errorf("failed to decode the account %s: %w", address, err)
+	}
+
+	return account, nil
+}
+
+// isTxNotFound returns true if the error of the transaction query is due to the
+// transaction not being included yet, which CometBFT returns as an internal RPC
+// error without an ABCI code, carrying the error of the missing transaction
+func isTxNotFound(err error, hash []byte) bool {
+	var rpcErr *jsonrpctypes.RPCError
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+
+	return rpcErr.Code == rpcInternalErrorCode && rpcErr.Data == fmt.Sprintf("tx (%X) not found", hash)
+}
+
+// waitForTx polls the transaction until it is included or the block timeout is reached
+// the transaction that is not included in time might still be in the mempool, so its
+// sequence is kept by the transaction sender
+func (bc *BabylonController) waitForTx(ctx context.Context, hash []byte) (*provider.RelayerTxResponse, error) {
+	ctx, cancel := getContextWithCancel(ctx, bc.cfg.BlockTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(txPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("the transaction %X is not included: %w", hash, ctx.Err())
+		}
+
+		res, err := bc.txClient.Tx(ctx, hash, false)
+		if err != nil {
+			if isTxNotFound(err, hash) || ctx.Err() != nil {
+				// the transaction is not included yet
+				continue
+			}
+			return nil, fmt.Errorf("failed to query the transaction %X: %w", hash, err)
+		}
+		if res.TxResult.Code != 0 {
+			return nil, categorizeBabylonErr(sdkErr.ABCIError(res.TxResult.Codespace, res.TxResult.Code, res.TxResult.Log))
+		}
+
+		events := make([]provider.RelayerEvent, 0, len(res.TxResult.Events))
+		for _, e := range res.TxResult.Events {
+			attributes := make(map[string]string, len(e.Attributes))
+			for _, a := range e.Attributes {
+				attributes[a.Key] = a.Value
+			}
+			events = append(events, provider.RelayerEvent{
+				EventType:  e.Type,
+				Attributes: attributes,
+			})
+		}
+
+		return &provider.RelayerTxResponse{
+			Height:    res.Height,
+			TxHash:    res.Hash.String(),
+			Codespace: res.TxResult.Codespace,
+			Code:      res.TxResult.Code,
+			Data:      string(res.TxResult.Data),
+			Events:    events,
+		}, nil
+	}
+}
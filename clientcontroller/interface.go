@@ -1,11 +1,13 @@
 package clientcontroller
 
 import (
+	"context"
 	"fmt"
 
 	"cosmossdk.io/math"
+	bbntypes "github.com/babylonchain/babylon/types"
 	"github.com/btcsuite/btcd/btcec/v2"
-	"github.com/btcsuite/btcd/chaincfg"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"go.uber.org/zap"
 
 	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
@@ -13,14 +15,18 @@ import (
 )
 
 const (
-	babylonConsumerChainName = "babylon"
+	babylonConsumerChainName   = "babylon"
+	opStackL2ConsumerChainName = "opstackl2"
 )
 
+// ClientController is the interface to the consumer chain. All the methods
+// but Close take a context, which cancels the in-flight request once it is done
 type ClientController interface {
 
 	// RegisterFinalityProvider registers a finality provider to the consumer chain
 	// it returns tx hash and error
 	RegisterFinalityProvider(
+		ctx context.Context,
 		chainPk []byte,
 		fpPk *btcec.PublicKey,
 		pop []byte,
@@ -30,55 +36,101 @@ type ClientController interface {
 	) (*types.TxResponse, uint64, error)
 
 	// SubmitFinalitySig submits the finality signature to the consumer chain
-	SubmitFinalitySig(fpPk *btcec.PublicKey, blockHeight uint64, blockHash []byte, sig *btcec.ModNScalar) (*types.TxResponse, error)
+	SubmitFinalitySig(ctx context.Context, fpPk *btcec.PublicKey, blockHeight uint64, blockHash []byte, sig *btcec.ModNScalar) (*types.TxResponse, error)
 
 	// SubmitBatchFinalitySigs submits a batch of finality signatures to the consumer chain
-	SubmitBatchFinalitySigs(fpPk *btcec.PublicKey, blocks []*types.BlockInfo, sigs []*btcec.ModNScalar) (*types.TxResponse, error)
+	SubmitBatchFinalitySigs(ctx context.Context, fpPk *btcec.PublicKey, blocks []*types.BlockInfo, sigs []*btcec.ModNScalar) (*types.TxResponse, error)
 
 	// Note: the following queries are only for PoC
 
 	// QueryFinalityProviderVotingPower queries the voting power of the finality provider at a given height
-	QueryFinalityProviderVotingPower(fpPk *btcec.PublicKey, blockHeight uint64) (uint64, error)
+	QueryFinalityProviderVotingPower(ctx context.Context, fpPk *btcec.PublicKey, blockHeight uint64) (uint64, error)
 
 	// QueryFinalityProviderSlashed queries if the finality provider is slashed
-	QueryFinalityProviderSlashed(fpPk *btcec.PublicKey) (bool, error)
+	QueryFinalityProviderSlashed(ctx context.Context, fpPk *btcec.PublicKey) (bool, error)
 
 	// QueryLatestFinalizedBlocks returns the latest finalized blocks
-	QueryLatestFinalizedBlocks(count uint64) ([]*types.BlockInfo, error)
+	QueryLatestFinalizedBlocks(ctx context.Context, count uint64) ([]*types.BlockInfo, error)
 
 	// QueryBlock queries the block at the given height
-	QueryBlock(height uint64) (*types.BlockInfo, error)
+	QueryBlock(ctx context.Context, height uint64) (*types.BlockInfo, error)
 
 	// QueryBlocks returns a list of blocks from startHeight to endHeight
-	QueryBlocks(startHeight, endHeight, limit uint64) ([]*types.BlockInfo, error)
+	QueryBlocks(ctx context.Context, startHeight, endHeight, limit uint64) ([]*types.BlockInfo, error)
 
 	// QueryBestBlock queries the tip block of the consumer chain
-	QueryBestBlock() (*types.BlockInfo, error)
+	QueryBestBlock(ctx context.Context) (*types.BlockInfo, error)
 
 	// QueryActivatedHeight returns the activated height of the consumer chain
 	// error will be returned if the consumer chain has not been activated
-	QueryActivatedHeight() (uint64, error)
+	QueryActivatedHeight(ctx context.Context) (uint64, error)
 
 	// QueryLastFinalizedEpoch returns the last finalised epoch of Babylon
-	QueryLastFinalizedEpoch() (uint64, error)
+	QueryLastFinalizedEpoch(ctx context.Context) (uint64, error)
+
+	// QueryBalance queries the balance of the account paying for the transactions in the fee denom
+	QueryBalance(ctx context.Context) (*sdk.Coin, error)
 
 	Close() error
 }
 
-func NewClientController(chainName string, bbnConfig *fpcfg.BBNConfig, netParams *chaincfg.Params, logger *zap.Logger) (ClientController, error) {
-	var (
-		cc  ClientController
-		err error
-	)
+// BlockSubscriber is implemented by the client controllers which can push
+// the new blocks of the consumer chain instead of being polled
+type BlockSubscriber interface {
+	// SubscribeNewBlocks returns a channel receiving the new blocks of the consumer
+	// chain, which is closed once the subscription is lost or the context is done
+	SubscribeNewBlocks(ctx context.Context) (<-chan *types.BlockInfo, error)
+}
+
+// GasPriceUpdater is implemented by the client controllers which can adjust
+// the gas prices of their transactions to the recent fee data of the chain
+type GasPriceUpdater interface {
+	// UpdateGasPrices updates the gas prices from the recent fee data
+	// and returns the gas prices in use
+	UpdateGasPrices(ctx context.Context) (string, error)
+}
+
+// FinalitySigsSubmitter is implemented by the client controllers which can submit
+// the finality signatures of several finality providers in a single transaction
+type FinalitySigsSubmitter interface {
+	// SubmitFinalitySigs submits the finality signatures in a single transaction, the
+	// concurrent calls do not wait for the transactions of each other to be included
+	SubmitFinalitySigs(ctx context.Context, sigs []*types.FinalitySig) (*types.TxResponse, error)
+}
+
+// VoteQuerier is implemented by the client controllers which can query
+// the votes recorded by the consumer chain
+type VoteQuerier interface {
+	// QueryVotesAtHeight returns the BTC public keys of the finality
+	// providers whose votes at the given height are recorded
+	QueryVotesAtHeight(ctx context.Context, height uint64) ([]bbntypes.BIP340PubKey, error)
+}
+
+func NewClientController(chainName string, cfg *fpcfg.Config, logger *zap.Logger) (ClientController, error) {
+	var cc ClientController
+	// the submission aggregator and the vote verifier broadcast the finality signatures
+	// without waiting for the previous transactions of the key, so the other transactions
+	// of the key have to be sent with the account sequence tracked locally too
+	aggregatorEnabled := cfg.SubmissionAggregator != nil && cfg.SubmissionAggregator.Enabled
+	verifierEnabled := cfg.VoteVerifier != nil && cfg.VoteVerifier.Interval > 0
 	switch chainName {
 	case babylonConsumerChainName:
-		cc, err = NewBabylonController(bbnConfig, netParams, logger)
+		bc, err := NewBabylonController(cfg.BabylonConfig, &cfg.BTCNetParams, logger)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create Babylon rpc client: %w", err)
 		}
+		bc.sendAllWithTxSender = aggregatorEnabled || verifierEnabled
+		cc = bc
+	case opStackL2ConsumerChainName:
+		opcc, err := NewOPStackL2ConsumerController(cfg.OPStackL2Config, cfg.BabylonConfig, &cfg.BTCNetParams, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OP-stack L2 rpc client: %w", err)
+		}
+		opcc.bbnController.sendAllWithTxSender = aggregatorEnabled
+		cc = opcc
 	default:
 		return nil, fmt.Errorf("unsupported consumer chain")
 	}
 
-	return cc, err
+	return cc, nil
 }
@@ -0,0 +1,509 @@
+package clientcontroller
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdkErr "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	bbntypes "github.com/babylonchain/babylon/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/relayer/v2/relayer/provider"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"go.uber.org/zap"
+
+	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
+	"github.com/babylonchain/finality-provider/types"
+)
+
+const (
+	// smartContractStatePath is the ABCI query path of CosmWasm smart queries
+	smartContractStatePath = "/cosmwasm.wasm.v1.Query/SmartContractState"
+)
+
+var _ ClientController = &OPStackL2ConsumerController{}
+var _ GasPriceUpdater = &OPStackL2ConsumerController{}
+var _ FinalitySigsSubmitter = &OPStackL2ConsumerController{}
+
+// OPStackL2ConsumerController is the client controller of an OP-stack L2 consumer chain
+// L2 blocks are read from the Ethereum JSON-RPC endpoint of an L2 node while
+// finality signatures are submitted to the finality contract deployed on Babylon.
+// Registration, slashing and epoch queries are served by Babylon
+type OPStackL2ConsumerController struct {
+	bbnController *BabylonController
+	ethClient     *ethclient.Client
+	cfg           *fpcfg.OPStackL2Config
+	logger        *zap.Logger
+
+	// contractExecutor and contractQuerier execute and query the finality
+	// contract on Babylon, which are served by the Babylon controller
+	contractExecutor contractExecutor
+	contractQuerier  abciQuerier
+}
+
+// contractExecutor sends the transactions executing the finality contract
+// with the key of the Babylon controller
+type contractExecutor interface {
+	voteSigner() string
+	wrapVoteMsgs(msgs []sdk.Msg) []sdk.Msg
+	sendMsgs(ctx context.Context, msgs []sdk.Msg) (*provider.RelayerTxResponse, error)
+}
+
+// categorizeExecuteErr maps the execute failure of the finality contract submitting
+// the given finality signatures to its category. The contract errors are not registered
+// on Babylon but returned as the execute failures of CosmWasm, so they are categorized by
+// the state the failure leads to: the submission is expected if all the finality
+// signatures have been recorded by the contract, and the finality provider is slashed if
+// Babylon says so. The other failures, and the ones whose state cannot be queried, keep
+// their Babylon categories
+func (cc *OPStackL2ConsumerController) categorizeExecuteErr(ctx context.Context, sigs []*types.FinalitySig, err error) error {
+	if !errors.Is(err, wasmtypes.ErrExecuteFailed) {
+		return err
+	}
+
+	for _, s := range sigs {
+		slashed, qErr := cc.QueryFinalityProviderSlashed(ctx, s.FpPk)
+		if qErr != nil {
+			cc.logger.Debug("failed to query the slashed status after the execute failure", zap.Error(qErr))
+			return err
+		}
+		if slashed {
+			return NewError(CategorySlashed, err)
+		}
+	}
+
+	for _, s := range sigs {
+		voted, qErr := cc.queryVoted(ctx, s)
+		if qErr != nil {
+			cc.logger.Debug("failed to query the block voters after the execute failure", zap.Error(qErr))
+			return err
+		}
+		if !voted {
+			return err
+		}
+	}
+
+	return NewError(CategoryExpected, err)
+}
+
+// queryVoted returns true if the finality signature of the finality provider on
+// the block has been recorded by the finality contract
+func (cc *OPStackL2ConsumerController) queryVoted(ctx context.Context, s *types.FinalitySig) (bool, error) {
+	query := &contractQueryMsg{
+		BlockVoters: &blockVotersQuery{
+			Height: s.Block.Height,
+			Hash:   hex.EncodeToString(s.Block.Hash),
+		},
+	}
+
+	var voters blockVotersResponse
+	if err := cc.querySmartContract(ctx, query, &voters); err != nil {
+		return false, fmt.Errorf("failed to query the voters of the block at height %d: %w", s.Block.Height, err)
+	}
+
+	fpPkHex := bbntypes.NewBIP340PubKeyFromBTCPK(s.FpPk).MarshalHex()
+	for _, v := range voters {
+		if v == fpPkHex {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// abciQuerier sends the ABCI queries to Babylon
+type abciQuerier interface {
+	ABCIQuery(ctx context.Context, path string, data cmtbytes.HexBytes) (*coretypes.ResultABCIQuery, error)
+}
+
+func NewOPStackL2ConsumerController(
+	opCfg *fpcfg.OPStackL2Config,
+	bbnCfg *fpcfg.BBNConfig,
+	btcParams *chaincfg.Params,
+	logger *zap.Logger,
+) (*OPStackL2ConsumerController, error) {
+	if err := opCfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config for OP-stack L2 client: %w", err)
+	}
+
+	bc, err := NewBabylonController(bbnCfg, btcParams, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	ethClient, err := ethclient.Dial(opCfg.RPCAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OP-stack L2 client: %w", err)
+	}
+
+	return &OPStackL2ConsumerController{
+		bbnController:    bc,
+		ethClient:        ethClient,
+		cfg:              opCfg,
+		logger:           logger,
+		contractExecutor: bc,
+		contractQuerier:  bc.bbnClient.RPCClient,
+	}, nil
+}
+
+// submitFinalitySignatureMsg is the execute message of the finality contract
+// to submit a finality signature
+type submitFinalitySignatureMsg struct {
+	SubmitFinalitySignature submitFinalitySignatureParams `json:"submit_finality_signature"`
+}
+
+type submitFinalitySignatureParams struct {
+	FpPubkeyHex string `json:"fp_pubkey_hex"`
+	Height      uint64 `json:"height"`
+	BlockHash   []byte `json:"block_hash"`
+	Signature   []byte `json:"signature"`
+}
+
+// contractQueryMsg is the query message of the finality contract
+// exactly one of the fields is expected to be set
+type contractQueryMsg struct {
+	FinalityProviderPower *finalityProviderPowerQuery `json:"finality_provider_power,omitempty"`
+	ActivatedHeight       *activatedHeightQuery       `json:"activated_height,omitempty"`
+	BlockVoters           *blockVotersQuery           `json:"block_voters,omitempty"`
+}
+
+type finalityProviderPowerQuery struct {
+	FpPubkeyHex string `json:"fp_pubkey_hex"`
+	Height      uint64 `json:"height"`
+}
+
+type finalityProviderPowerResponse struct {
+	Power uint64 `json:"power"`
+}
+
+type activatedHeightQuery struct{}
+
+type activatedHeightResponse struct {
+	Height uint64 `json:"height"`
+}
+
+// blockVotersQuery queries the finality providers having voted for the block
+// of the given height and hash in hex
+type blockVotersQuery struct {
+	Height uint64 `json:"height"`
+	Hash   string `json:"hash"`
+}
+
+// blockVotersResponse is the BTC public keys in hex of the finality providers
+// having voted for the block, which is null if there is no vote
+type blockVotersResponse []string
+
+// RegisterFinalityProvider registers the finality provider to Babylon
+// it returns tx hash, registered epoch, and error
+func (cc *OPStackL2ConsumerController) RegisterFinalityProvider(
+	ctx context.Context,
+	chainPk []byte,
+	fpPk *btcec.PublicKey,
+	pop []byte,
+	commission *math.LegacyDec,
+	description []byte,
+	masterPubRand string,
+) (*types.TxResponse, uint64, error) {
+	return cc.bbnController.RegisterFinalityProvider(ctx, chainPk, fpPk, pop, commission, description, masterPubRand)
+}
+
+// SubmitFinalitySig submits the finality signature to the finality contract
+func (cc *OPStackL2ConsumerController) SubmitFinalitySig(ctx context.Context, fpPk *btcec.PublicKey, blockHeight uint64, blockHash []byte, sig *btcec.ModNScalar) (*types.TxResponse, error) {
+	return cc.SubmitBatchFinalitySigs(ctx, fpPk, []*types.BlockInfo{{Height: blockHeight, Hash: blockHash}}, []*btcec.ModNScalar{sig})
+}
+
+// SubmitBatchFinalitySigs submits a batch of finality signatures to the finality contract
+func (cc *OPStackL2ConsumerController) SubmitBatchFinalitySigs(ctx context.Context, fpPk *btcec.PublicKey, blocks []*types.BlockInfo, sigs []*btcec.ModNScalar) (*types.TxResponse, error) {
+	if len(blocks) != len(sigs) {
+		return nil, fmt.Errorf("the number of blocks %v should match the number of finality signatures %v", len(blocks), len(sigs))
+	}
+
+	finalitySigs := make([]*types.FinalitySig, 0, len(blocks))
+	for i, b := range blocks {
+		finalitySigs = append(finalitySigs, &types.FinalitySig{FpPk: fpPk, Block: b, Sig: sigs[i]})
+	}
+
+	msgs, err := cc.finalitySigMsgs(finalitySigs)
+	if err != nil {
+		return nil, err
+	}
+
+	// the transaction is sent without the Babylon client, which only keeps the
+	// codes of the failed transactions and drops the errors of the contract
+	// the failed submissions are retried by the finality provider instance
+	res, err := cc.contractExecutor.sendMsgs(ctx, msgs)
+	if err != nil {
+		return nil, cc.categorizeExecuteErr(ctx, finalitySigs, err)
+	}
+
+	return &types.TxResponse{TxHash: res.TxHash, Events: res.Events}, nil
+}
+
+// SubmitFinalitySigs submits the finality signatures of several finality providers
+// to the finality contract in a single transaction, which is broadcast without
+// waiting for the previous transactions of the key to be included
+func (cc *OPStackL2ConsumerController) SubmitFinalitySigs(ctx context.Context, sigs []*types.FinalitySig) (*types.TxResponse, error) {
+	if len(sigs) == 0 {
+		return nil, fmt.Errorf("should not submit zero finality signatures")
+	}
+
+	msgs, err := cc.finalitySigMsgs(sigs)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := cc.contractExecutor.sendMsgs(ctx, msgs)
+	if err != nil {
+		return nil, cc.categorizeExecuteErr(ctx, sigs, err)
+	}
+
+	return &types.TxResponse{TxHash: res.TxHash, Events: res.Events}, nil
+}
+
+// finalitySigMsgs builds the execute messages of the finality contract submitting the
+// finality signatures, which are wrapped in a MsgExec if they are submitted on behalf
+// of the granter
+func (cc *OPStackL2ConsumerController) finalitySigMsgs(sigs []*types.FinalitySig) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, 0, len(sigs))
+	for _, s := range sigs {
+		sigBytes := s.Sig.Bytes()
+		executeMsg, err := json.Marshal(submitFinalitySignatureMsg{
+			SubmitFinalitySignature: submitFinalitySignatureParams{
+				FpPubkeyHex: bbntypes.NewBIP340PubKeyFromBTCPK(s.FpPk).MarshalHex(),
+				Height:      s.Block.Height,
+				BlockHash:   s.Block.Hash,
+				Signature:   sigBytes[:],
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode the finality signature message: %w", err)
+		}
+
+		msgs = append(msgs, &wasmtypes.MsgExecuteContract{
+			Sender:   cc.contractExecutor.voteSigner(),
+			Contract: cc.cfg.FinalityContractAddress,
+			Msg:      executeMsg,
+		})
+	}
+
+	return cc.contractExecutor.wrapVoteMsgs(msgs), nil
+}
+
+// QueryFinalityProviderVotingPower queries the voting power of the finality provider
+// at a given height from the finality contract
+func (cc *OPStackL2ConsumerController) QueryFinalityProviderVotingPower(ctx context.Context, fpPk *btcec.PublicKey, blockHeight uint64) (uint64, error) {
+	query := &contractQueryMsg{
+		FinalityProviderPower: &finalityProviderPowerQuery{
+			FpPubkeyHex: bbntypes.NewBIP340PubKeyFromBTCPK(fpPk).MarshalHex(),
+			Height:      blockHeight,
+		},
+	}
+
+	var res finalityProviderPowerResponse
+	if err := cc.querySmartContract(ctx, query, &res); err != nil {
+		return 0, fmt.Errorf("failed to query the finality provider's voting power at height %d: %w", blockHeight, err)
+	}
+
+	return res.Power, nil
+}
+
+// QueryFinalityProviderSlashed queries if the finality provider is slashed on Babylon
+func (cc *OPStackL2ConsumerController) QueryFinalityProviderSlashed(ctx context.Context, fpPk *btcec.PublicKey) (bool, error) {
+	return cc.bbnController.QueryFinalityProviderSlashed(ctx, fpPk)
+}
+
+// QueryLatestFinalizedBlocks returns the latest finalized L2 blocks in descending order
+// the L2 blocks are considered finalized once they are marked as finalized by the L2 node
+func (cc *OPStackL2ConsumerController) QueryLatestFinalizedBlocks(ctx context.Context, count uint64) ([]*types.BlockInfo, error) {
+	finalizedHeader, err := cc.queryHeader(ctx, big.NewInt(int64(rpc.FinalizedBlockNumber)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the finalized L2 block: %w", err)
+	}
+	finalizedHeight := finalizedHeader.Number.Uint64()
+
+	blocks := make([]*types.BlockInfo, 0, count)
+	for i := uint64(0); i < count && i <= finalizedHeight; i++ {
+		height := finalizedHeight - i
+		header := finalizedHeader
+		if i > 0 {
+			header, err = cc.queryHeader(ctx, new(big.Int).SetUint64(height))
+			if err != nil {
+				return nil, fmt.Errorf("failed to query the L2 block at height %d: %w", height, err)
+			}
+		}
+		blocks = append(blocks, &types.BlockInfo{
+			Height:     height,
+			Hash:       header.Hash().Bytes(),
+			ParentHash: header.ParentHash.Bytes(),
+			Finalized:  true,
+		})
+	}
+
+	return blocks, nil
+}
+
+// QueryBlock queries the L2 block at the given height
+func (cc *OPStackL2ConsumerController) QueryBlock(ctx context.Context, height uint64) (*types.BlockInfo, error) {
+	header, err := cc.queryHeader(ctx, new(big.Int).SetUint64(height))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the L2 block at height %d: %w", height, err)
+	}
+
+	finalizedHeight, err := cc.queryFinalizedHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BlockInfo{
+		Height:     height,
+		Hash:       header.Hash().Bytes(),
+		ParentHash: header.ParentHash.Bytes(),
+		Finalized:  height <= finalizedHeight,
+	}, nil
+}
+
+// QueryBlocks returns a list of L2 blocks from startHeight to endHeight
+func (cc *OPStackL2ConsumerController) QueryBlocks(ctx context.Context, startHeight, endHeight, limit uint64) ([]*types.BlockInfo, error) {
+	if endHeight < startHeight {
+		return nil, fmt.Errorf("the startHeight %v should not be higher than the endHeight %v", startHeight, endHeight)
+	}
+	count := endHeight - startHeight + 1
+	if count > limit {
+		count = limit
+	}
+
+	finalizedHeight, err := cc.queryFinalizedHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]*types.BlockInfo, 0, count)
+	for height := startHeight; height < startHeight+count; height++ {
+		header, err := cc.queryHeader(ctx, new(big.Int).SetUint64(height))
+		if err != nil {
+			return nil, fmt.Errorf("failed to query the L2 block at height %d: %w", height, err)
+		}
+		blocks = append(blocks, &types.BlockInfo{
+			Height:     height,
+			Hash:       header.Hash().Bytes(),
+			ParentHash: header.ParentHash.Bytes(),
+			Finalized:  height <= finalizedHeight,
+		})
+	}
+
+	return blocks, nil
+}
+
+// QueryBestBlock queries the latest L2 block
+func (cc *OPStackL2ConsumerController) QueryBestBlock(ctx context.Context) (*types.BlockInfo, error) {
+	header, err := cc.queryHeader(ctx, big.NewInt(int64(rpc.LatestBlockNumber)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the latest L2 block: %w", err)
+	}
+
+	return &types.BlockInfo{
+		Height:     header.Number.Uint64(),
+		Hash:       header.Hash().Bytes(),
+		ParentHash: header.ParentHash.Bytes(),
+	}, nil
+}
+
+// QueryActivatedHeight returns the L2 height from which the finality contract accepts
+// finality signatures
+func (cc *OPStackL2ConsumerController) QueryActivatedHeight(ctx context.Context) (uint64, error) {
+	var res activatedHeightResponse
+	if err := cc.querySmartContract(ctx, &contractQueryMsg{ActivatedHeight: &activatedHeightQuery{}}, &res); err != nil {
+		return 0, fmt.Errorf("failed to query activated height: %w", err)
+	}
+
+	if res.Height == 0 {
+		return 0, fmt.Errorf("the finality contract has not been activated")
+	}
+
+	return res.Height, nil
+}
+
+// QueryLastFinalizedEpoch returns the last finalised epoch of Babylon
+func (cc *OPStackL2ConsumerController) QueryLastFinalizedEpoch(ctx context.Context) (uint64, error) {
+	return cc.bbnController.QueryLastFinalizedEpoch(ctx)
+}
+
+// QueryBalance queries the balance of the account paying for the transactions on Babylon
+func (cc *OPStackL2ConsumerController) QueryBalance(ctx context.Context) (*sdk.Coin, error) {
+	return cc.bbnController.QueryBalance(ctx)
+}
+
+// UpdateGasPrices updates the gas prices of the transactions on Babylon
+func (cc *OPStackL2ConsumerController) UpdateGasPrices(ctx context.Context) (string, error) {
+	return cc.bbnController.UpdateGasPrices(ctx)
+}
+
+func (cc *OPStackL2ConsumerController) Close() error {
+	cc.ethClient.Close()
+
+	return cc.bbnController.Close()
+}
+
+func (cc *OPStackL2ConsumerController) queryHeader(ctx context.Context, number *big.Int) (*ethtypes.Header, error) {
+	ctx, cancel := getContextWithCancel(ctx, cc.cfg.Timeout)
+	defer cancel()
+
+	return cc.ethClient.HeaderByNumber(ctx, number)
+}
+
+func (cc *OPStackL2ConsumerController) queryFinalizedHeight(ctx context.Context) (uint64, error) {
+	header, err := cc.queryHeader(ctx, big.NewInt(int64(rpc.FinalizedBlockNumber)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to query the finalized L2 block: %w", err)
+	}
+
+	return header.Number.Uint64(), nil
+}
+
+// querySmartContract sends the given query to the finality contract
+// and decodes the response into res
+func (cc *OPStackL2ConsumerController) querySmartContract(ctx context.Context, query *contractQueryMsg, res interface{}) error {
+	queryData, err := json.Marshal(query)
+	if err != nil {
+		return fmt.Errorf("failed to encode the contract query: %w", err)
+	}
+
+	req := &wasmtypes.QuerySmartContractStateRequest{
+		Address:   cc.cfg.FinalityContractAddress,
+		QueryData: queryData,
+	}
+	reqBytes, err := req.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to encode the smart query request: %w", err)
+	}
+
+	ctx, cancel := getContextWithCancel(ctx, cc.cfg.Timeout)
+	defer cancel()
+
+	queryRes, err := cc.contractQuerier.ABCIQuery(ctx, smartContractStatePath, reqBytes)
+	if err != nil {
+		return err
+	}
+	if !queryRes.Response.IsOK() {
+		return categorizeBabylonErr(fmt.Errorf("the smart query failed: %w",
+			sdkErr.ABCIError(queryRes.Response.Codespace, queryRes.Response.Code, queryRes.Response.Log)))
+	}
+
+	var stateRes wasmtypes.QuerySmartContractStateResponse
+	if err := stateRes.Unmarshal(queryRes.Response.Value); err != nil {
+		return fmt.Errorf("failed to decode the smart query response: %w", err)
+	}
+
+	return json.Unmarshal(stateRes.Data, res)
+}
@@ -0,0 +1,401 @@
+package clientcontroller
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	bbntypes "github.com/babylonchain/babylon/types"
+	btcstakingtypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/relayer/v2/relayer/provider"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
+	"github.com/babylonchain/finality-provider/types"
+)
+
+const (
+	mockContractAddress = "bbn14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9sw76fy2"
+	mockContractSender  = "bbn1l9w7v3jyhpjugz6hvhm5hp9m3kmp4qmyxk6m9s"
+)
+
+// mockFinalityContract is an in-memory finality contract serving the
+// execute messages and the smart queries sent to Babylon
+type mockFinalityContract struct {
+	activatedHeight uint64
+	// powers are the voting power of the finality providers keyed by their
+	// BTC public keys in hex and the heights
+	powers map[string]map[uint64]uint64
+	// votes are the submitted finality signatures keyed by the
+	// BTC public keys of the finality providers in hex and the heights
+	votes map[string]map[uint64]submitFinalitySignatureParams
+	// slashed are the BTC public keys in hex of the finality providers
+	// slashed on Babylon, whose finality signatures are rejected
+	slashed map[string]bool
+	// numTxs is the number of transactions executing the contract
+	numTxs int
+}
+
+var _ contractExecutor = &mockFinalityContract{}
+var _ abciQuerier = &mockFinalityContract{}
+
+func newMockFinalityContract() *mockFinalityContract {
+	return &mockFinalityContract{
+		powers:  make(map[string]map[uint64]uint64),
+		votes:   make(map[string]map[uint64]submitFinalitySignatureParams),
+		slashed: make(map[string]bool),
+	}
+}
+
+func (c *mockFinalityContract) voteSigner() string {
+	return mockContractSender
+}
+
+func (c *mockFinalityContract) wrapVoteMsgs(msgs []sdk.Msg) []sdk.Msg {
+	return msgs
+}
+
+// sendMsgs executes the messages in a transaction, which either
+// records all the finality signatures or none of them
+func (c *mockFinalityContract) sendMsgs(_ context.Context, msgs []sdk.Msg) (*provider.RelayerTxResponse, error) {
+	params := make([]submitFinalitySignatureParams, 0, len(msgs))
+	for _, msg := range msgs {
+		executeMsg, ok := msg.(*wasmtypes.MsgExecuteContract)
+		if !ok {
+			return nil, fmt.Errorf("unexpected message %T", msg)
+		}
+		if executeMsg.Contract != mockContractAddress || executeMsg.Sender != mockContractSender {
+			return nil, fmt.Errorf("unexpected contract %s or sender %s", executeMsg.Contract, executeMsg.Sender)
+		}
+		var m submitFinalitySignatureMsg
+		if err := json.Unmarshal(executeMsg.Msg, &m); err != nil {
+			return nil, err
+		}
+		p := m.SubmitFinalitySignature
+		if c.slashed[p.FpPubkeyHex] {
+			return nil, newABCIError(wasmtypes.ErrExecuteFailed)
+		}
+		if _, ok := c.votes[p.FpPubkeyHex][p.Height]; ok {
+			return nil, newABCIError(wasmtypes.ErrExecuteFailed)
+		}
+		params = append(params, p)
+	}
+
+	for _, p := range params {
+		if c.votes[p.FpPubkeyHex] == nil {
+			c.votes[p.FpPubkeyHex] = make(map[uint64]submitFinalitySignatureParams)
+		}
+		c.votes[p.FpPubkeyHex][p.Height] = p
+	}
+	c.numTxs++
+
+	return &provider.RelayerTxResponse{TxHash: fmt.Sprintf("%064X", c.numTxs)}, nil
+}
+
+// ABCIQuery serves the smart queries of the contract, along with
+// the queries of the finality providers on Babylon
+func (c *mockFinalityContract) ABCIQuery(_ context.Context, path string, data cmtbytes.HexBytes) (*coretypes.ResultABCIQuery, error) {
+	if path == finalityProviderPath {
+		var req btcstakingtypes.QueryFinalityProviderRequest
+		if err := req.Unmarshal(data); err != nil {
+			return nil, err
+		}
+		fp := &btcstakingtypes.FinalityProviderResponse{}
+		if c.slashed[req.FpBtcPkHex] {
+			fp.SlashedBtcHeight = 1
+		}
+		resBytes, err := (&btcstakingtypes.QueryFinalityProviderResponse{FinalityProvider: fp}).Marshal()
+		if err != nil {
+			return nil, err
+		}
+		return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: resBytes}}, nil
+	}
+	if path != smartContractStatePath {
+		return nil, fmt.Errorf("unexpected query path %s", path)
+	}
+	var req wasmtypes.QuerySmartContractStateRequest
+	if err := req.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	if req.Address != mockContractAddress {
+		return nil, fmt.Errorf("unexpected contract %s", req.Address)
+	}
+
+	var query contractQueryMsg
+	if err := json.Unmarshal(req.QueryData, &query); err != nil {
+		return nil, err
+	}
+	var res interface{}
+	switch {
+	case query.FinalityProviderPower != nil:
+		q := query.FinalityProviderPower
+		res = finalityProviderPowerResponse{Power: c.powers[q.FpPubkeyHex][q.Height]}
+	case query.ActivatedHeight != nil:
+		res = activatedHeightResponse{Height: c.activatedHeight}
+	case query.BlockVoters != nil:
+		q := query.BlockVoters
+		var voters blockVotersResponse
+		for fpPkHex, votes := range c.votes {
+			if v, ok := votes[q.Height]; ok && hex.EncodeToString(v.BlockHash) == q.Hash {
+				voters = append(voters, fpPkHex)
+			}
+		}
+		res = voters
+	default:
+		return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{
+			Code:      wasmtypes.ErrQueryFailed.ABCICode(),
+			Codespace: wasmtypes.ErrQueryFailed.Codespace(),
+			Log:       "unknown query",
+		}}, nil
+	}
+
+	resData, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	stateRes := &wasmtypes.QuerySmartContractStateResponse{Data: resData}
+	resBytes, err := stateRes.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: resBytes}}, nil
+}
+
+func newMockedOPStackL2ConsumerController(contract *mockFinalityContract) *OPStackL2ConsumerController {
+	cfg := fpcfg.DefaultOPStackL2Config()
+	cfg.FinalityContractAddress = mockContractAddress
+
+	return &OPStackL2ConsumerController{
+		bbnController:    newMockedBabylonController(contract),
+		cfg:              &cfg,
+		logger:           zap.NewNop(),
+		contractExecutor: contract,
+		contractQuerier:  contract,
+	}
+}
+
+func genRandomFinalitySig(r *rand.Rand, fpPk *btcec.PublicKey, height uint64) *types.FinalitySig {
+	var sig btcec.ModNScalar
+	sig.SetByteSlice(genRandomByteArray(r, 32))
+
+	return &types.FinalitySig{
+		FpPk:  fpPk,
+		Block: &types.BlockInfo{Height: height, Hash: genRandomByteArray(r, 32)},
+		Sig:   &sig,
+	}
+}
+
+func requireVoteRecorded(t *testing.T, contract *mockFinalityContract, s *types.FinalitySig) {
+	fpPkHex := bbntypes.NewBIP340PubKeyFromBTCPK(s.FpPk).MarshalHex()
+	vote, ok := contract.votes[fpPkHex][s.Block.Height]
+	require.True(t, ok)
+	require.Equal(t, s.Block.Hash, vote.BlockHash)
+	sigBytes := s.Sig.Bytes()
+	require.Equal(t, sigBytes[:], vote.Signature)
+}
+
+// FuzzOPStackL2SubmitFinalitySigs tests that the finality signatures are
+// submitted to the finality contract with the expected execute messages
+func FuzzOPStackL2SubmitFinalitySigs(f *testing.F) {
+	addRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		contract := newMockFinalityContract()
+		cc := newMockedOPStackL2ConsumerController(contract)
+
+		fpSk, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+		fpPk := fpSk.PubKey()
+		startHeight := uint64(r.Int63n(1000) + 1)
+
+		// a single finality signature
+		s := genRandomFinalitySig(r, fpPk, startHeight)
+		res, err := cc.SubmitFinalitySig(context.Background(), fpPk, s.Block.Height, s.Block.Hash, s.Sig)
+		require.NoError(t, err)
+		require.NotEmpty(t, res.TxHash)
+		requireVoteRecorded(t, contract, s)
+		// the duplicated finality signature is rejected by the contract as expected
+		_, err = cc.SubmitFinalitySig(context.Background(), fpPk, s.Block.Height, s.Block.Hash, s.Sig)
+		require.True(t, IsExpected(err))
+
+		// a batch of finality signatures of the finality provider
+		numBlocks := r.Intn(10) + 1
+		blocks := make([]*types.BlockInfo, 0, numBlocks)
+		sigs := make([]*btcec.ModNScalar, 0, numBlocks)
+		batch := make([]*types.FinalitySig, 0, numBlocks)
+		for i := 0; i < numBlocks; i++ {
+			s := genRandomFinalitySig(r, fpPk, startHeight+uint64(i)+1)
+			blocks = append(blocks, s.Block)
+			sigs = append(sigs, s.Sig)
+			batch = append(batch, s)
+		}
+		_, err = cc.SubmitBatchFinalitySigs(context.Background(), fpPk, blocks, sigs[:numBlocks-1])
+		require.Error(t, err)
+		_, err = cc.SubmitBatchFinalitySigs(context.Background(), fpPk, blocks, sigs)
+		require.NoError(t, err)
+		for _, s := range batch {
+			requireVoteRecorded(t, contract, s)
+		}
+
+		// the finality signatures of several finality providers in a single transaction
+		numFps := r.Intn(5) + 1
+		aggregated := make([]*types.FinalitySig, 0, numFps)
+		for i := 0; i < numFps; i++ {
+			sk, err := btcec.NewPrivateKey()
+			require.NoError(t, err)
+			aggregated = append(aggregated, genRandomFinalitySig(r, sk.PubKey(), startHeight))
+		}
+		numTxs := contract.numTxs
+		_, err = cc.SubmitFinalitySigs(context.Background(), aggregated)
+		require.NoError(t, err)
+		require.Equal(t, numTxs+1, contract.numTxs)
+		for _, s := range aggregated {
+			requireVoteRecorded(t, contract, s)
+		}
+		_, err = cc.SubmitFinalitySigs(context.Background(), nil)
+		require.Error(t, err)
+	})
+}
+
+// FuzzOPStackL2CategorizeExecuteErr tests that the execute failures of the
+// finality contract are categorized by the state of the finality providers
+func FuzzOPStackL2CategorizeExecuteErr(f *testing.F) {
+	addRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		contract := newMockFinalityContract()
+		cc := newMockedOPStackL2ConsumerController(contract)
+
+		numFps := r.Intn(5) + 1
+		height := uint64(r.Int63n(1000) + 1)
+		sigs := make([]*types.FinalitySig, 0, numFps)
+		for i := 0; i < numFps; i++ {
+			sk, err := btcec.NewPrivateKey()
+			require.NoError(t, err)
+			sigs = append(sigs, genRandomFinalitySig(r, sk.PubKey(), height))
+		}
+		_, err := cc.SubmitFinalitySigs(context.Background(), sigs)
+		require.NoError(t, err)
+
+		// all the finality signatures have been recorded
+		_, err = cc.SubmitFinalitySigs(context.Background(), sigs)
+		require.True(t, IsExpected(err))
+
+		// a finality signature on another block at the same height is not expected
+		conflicting := genRandomFinalitySig(r, sigs[0].FpPk, height)
+		_, err = cc.SubmitFinalitySigs(context.Background(), append([]*types.FinalitySig{conflicting}, sigs[1:]...))
+		require.Error(t, err)
+		require.Equal(t, CategoryTransient, CategoryOf(err))
+
+		// any of the finality providers is slashed
+		slashed := sigs[r.Intn(numFps)].FpPk
+		contract.slashed[bbntypes.NewBIP340PubKeyFromBTCPK(slashed).MarshalHex()] = true
+		_, err = cc.SubmitFinalitySigs(context.Background(), sigs)
+		require.True(t, IsSlashed(err))
+
+		// the other errors keep their categories
+		err = NewError(CategoryInsufficientFunds, newABCIError(sdkerrors.ErrInsufficientFunds))
+		require.Equal(t, CategoryInsufficientFunds, CategoryOf(cc.categorizeExecuteErr(context.Background(), sigs, err)))
+	})
+}
+
+// FuzzOPStackL2QueryContract tests that the voting power and the activated
+// height are queried from the finality contract
+func FuzzOPStackL2QueryContract(f *testing.F) {
+	addRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		contract := newMockFinalityContract()
+		cc := newMockedOPStackL2ConsumerController(contract)
+
+		// the contract is not activated yet
+		_, err := cc.QueryActivatedHeight(context.Background())
+		require.Error(t, err)
+		contract.activatedHeight = uint64(r.Int63n(1000) + 1)
+		activatedHeight, err := cc.QueryActivatedHeight(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, contract.activatedHeight, activatedHeight)
+
+		fpSk, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+		fpPk := fpSk.PubKey()
+		fpPkHex := bbntypes.NewBIP340PubKeyFromBTCPK(fpPk).MarshalHex()
+		height := activatedHeight + uint64(r.Int63n(100))
+		power := uint64(r.Int63n(1000) + 1)
+		contract.powers[fpPkHex] = map[uint64]uint64{height: power}
+
+		queriedPower, err := cc.QueryFinalityProviderVotingPower(context.Background(), fpPk, height)
+		require.NoError(t, err)
+		require.Equal(t, power, queriedPower)
+
+		// the finality provider has no voting power at other heights
+		queriedPower, err = cc.QueryFinalityProviderVotingPower(context.Background(), fpPk, height+1)
+		require.NoError(t, err)
+		require.Zero(t, queriedPower)
+
+		// the failed query is mapped to the registered error of its code
+		err = cc.querySmartContract(context.Background(), &contractQueryMsg{}, &activatedHeightResponse{})
+		require.ErrorIs(t, err, wasmtypes.ErrQueryFailed)
+		var categorized *Error
+		require.ErrorAs(t, err, &categorized)
+	})
+}
+
+func TestOPStackL2ConfigValidate(t *testing.T) {
+	testCases := []struct {
+		rpcAddress string
+		valid      bool
+	}{
+		{"http://127.0.0.1:8545", true},
+		{"https://l2.example.com", true},
+		{"ws://127.0.0.1:8546", true},
+		{"127.0.0.1:8545", false},
+		{"localhost", false},
+		{"ftp://127.0.0.1:8545", false},
+		{"http://", false},
+		{"", false},
+	}
+
+	for _, tc := range testCases {
+		cfg := fpcfg.DefaultOPStackL2Config()
+		cfg.FinalityContractAddress = mockContractAddress
+		cfg.RPCAddress = tc.rpcAddress
+		err := cfg.Validate()
+		if tc.valid {
+			require.NoError(t, err, tc.rpcAddress)
+		} else {
+			require.Error(t, err, tc.rpcAddress)
+		}
+	}
+}
+
+// addRandomSeedsToFuzzer is the same as addRandomSeedsToFuzzer,
+// which cannot be imported by the tests of this package due to an import cycle
+func addRandomSeedsToFuzzer(f *testing.F, num uint) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	for i := uint(0); i < num; i++ {
+		f.Add(r.Int63())
+	}
+}
+
+func genRandomByteArray(r *rand.Rand, length uint64) []byte {
+	bytes := make([]byte, length)
+	r.Read(bytes)
+	return bytes
+}
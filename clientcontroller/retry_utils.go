@@ -2,64 +2,114 @@ package clientcontroller
 
 import (
 	"errors"
-	"strings"
+	"fmt"
+)
+
+// ErrorCategory classifies the errors returned by the ClientController,
+// so that they are handled in the same way for all the consumer chains
+type ErrorCategory int
 
-	sdkErr "cosmossdk.io/errors"
-	btcstakingtypes "github.com/babylonchain/babylon/x/btcstaking/types"
-	finalitytypes "github.com/babylonchain/babylon/x/finality/types"
+const (
+	// CategoryTransient errors might not occur on retry, which is
+	// the category of all the errors not mapped to another category
+	CategoryTransient ErrorCategory = iota
+	// CategoryExpected errors indicate that the request is not needed,
+	// e.g., the same finality signature has been submitted before
+	CategoryExpected
+	// CategoryUnrecoverable errors indicate something critical in the
+	// finality provider program or the consumer chain
+	CategoryUnrecoverable
+	// CategorySlashed errors indicate that the finality provider has been slashed
+	CategorySlashed
+	// CategoryInsufficientFunds errors indicate that the account paying
+	// for the transactions cannot afford the fees
+	CategoryInsufficientFunds
+	// CategorySequenceMismatch errors indicate that the account sequence
+	// of the transaction is out of date
+	CategorySequenceMismatch
 )
 
-// these errors are considered unrecoverable because these indicate
-// something critical in the finality provider program or the consumer chain
-var unrecoverableErrors = []*sdkErr.Error{
-	finalitytypes.ErrBlockNotFound,
-	finalitytypes.ErrInvalidFinalitySig,
-	finalitytypes.ErrNoPubRandYet,
-	finalitytypes.ErrPubRandNotFound,
-	finalitytypes.ErrTooFewPubRand,
-	btcstakingtypes.ErrFpAlreadySlashed,
+func (c ErrorCategory) String() string {
+	switch c {
+	case CategoryTransient:
+		return "transient"
+	case CategoryExpected:
+		return "expected"
+	case CategoryUnrecoverable:
+		return "unrecoverable"
+	case CategorySlashed:
+		return "slashed"
+	case CategoryInsufficientFunds:
+		return "insufficient funds"
+	case CategorySequenceMismatch:
+		return "sequence mismatch"
+	default:
+		return fmt.Sprintf("unknown category %d", int(c))
+	}
 }
 
-// IsUnrecoverable returns true when the error is in the unrecoverableErrors list
-func IsUnrecoverable(err error) bool {
-	for _, e := range unrecoverableErrors {
-		// cannot use error.Is because the unwrapped error
-		// is not the expected error type
-		if strings.Contains(err.Error(), e.Error()) {
-			return true
-		}
+// Error is an error of the consumer chain mapped to a category
+// by the ClientController implementation
+type Error struct {
+	Category ErrorCategory
+	Err      error
+}
+
+func (e *Error) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("%s error", e.Category)
 	}
+	return e.Err.Error()
+}
 
-	return false
+func (e *Error) Unwrap() error {
+	return e.Err
 }
 
-type ExpectedError struct {
-	error
+// NewError wraps the error in the given category
+func NewError(category ErrorCategory, err error) error {
+	return &Error{Category: category, Err: err}
 }
 
-func (e ExpectedError) Error() string {
-	if e.error == nil {
-		return "expected error"
+// CategoryOf returns the category of the error, which is
+// CategoryTransient if the error is not mapped to any category
+func CategoryOf(err error) ErrorCategory {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Category
 	}
-	return e.error.Error()
+
+	return CategoryTransient
+}
+
+// IsUnrecoverable returns true if retrying the request would not help,
+// which includes the errors of the slashed finality providers
+func IsUnrecoverable(err error) bool {
+	category := CategoryOf(err)
+	return category == CategoryUnrecoverable || category == CategorySlashed
+}
+
+// IsSlashed returns true if the error indicates that the finality provider has been slashed
+func IsSlashed(err error) bool {
+	return CategoryOf(err) == CategorySlashed
 }
 
-func (e ExpectedError) Unwrap() error {
-	return e.error
+// IsInsufficientFunds returns true if the error indicates that the fees cannot be paid
+func IsInsufficientFunds(err error) bool {
+	return CategoryOf(err) == CategoryInsufficientFunds
 }
 
-// Is adds support for errors.Is usage on isExpected
-func (ExpectedError) Is(err error) bool {
-	_, isExpected := err.(ExpectedError)
-	return isExpected
+// IsSequenceMismatch returns true if the error indicates an out of date account sequence
+func IsSequenceMismatch(err error) bool {
+	return CategoryOf(err) == CategorySequenceMismatch
 }
 
-// Expected wraps an error in ExpectedError struct
+// Expected wraps an error in the expected category
 func Expected(err error) error {
-	return ExpectedError{err}
+	return NewError(CategoryExpected, err)
 }
 
-// IsExpected checks if error is an instance of ExpectedError
+// IsExpected checks if the error is in the expected category
 func IsExpected(err error) bool {
-	return errors.Is(err, ExpectedError{})
+	return CategoryOf(err) == CategoryExpected
 }
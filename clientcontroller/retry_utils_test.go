@@ -4,6 +4,10 @@ import (
 	"fmt"
 	"testing"
 
+	sdkErr "cosmossdk.io/errors"
+	btcstakingtypes "github.com/babylonchain/babylon/x/btcstaking/types"
+	finalitytypes "github.com/babylonchain/babylon/x/finality/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -13,3 +17,45 @@ func TestExpectedErr(t *testing.T) {
 	wrappedErr := fmt.Errorf("expected: %w", expectedErr)
 	require.True(t, IsExpected(wrappedErr))
 }
+
+func TestErrorCategories(t *testing.T) {
+	err := fmt.Errorf("some error")
+	require.Equal(t, CategoryTransient, CategoryOf(err))
+	require.False(t, IsUnrecoverable(err))
+
+	slashedErr := fmt.Errorf("failed to submit: %w", NewError(CategorySlashed, err))
+	require.True(t, IsSlashed(slashedErr))
+	require.True(t, IsUnrecoverable(slashedErr))
+	require.ErrorIs(t, slashedErr, err)
+
+	unrecoverableErr := NewError(CategoryUnrecoverable, err)
+	require.True(t, IsUnrecoverable(unrecoverableErr))
+	require.False(t, IsSlashed(unrecoverableErr))
+}
+
+func TestCategorizeBabylonErr(t *testing.T) {
+	testCases := []struct {
+		err      error
+		category ErrorCategory
+	}{
+		{btcstakingtypes.ErrFpAlreadySlashed.Wrap("the fp is slashed"), CategorySlashed},
+		// the errors of the failed transactions are restored from their codespaces and codes
+		{newABCIError(finalitytypes.ErrDuplicatedFinalitySig), CategoryExpected},
+		{fmt.Errorf("tx failed: %w", newABCIError(finalitytypes.ErrInvalidFinalitySig)), CategoryUnrecoverable},
+		{newABCIError(sdkerrors.ErrInsufficientFunds), CategoryInsufficientFunds},
+		{sdkerrors.ErrWrongSequence.Wrap("account sequence mismatch"), CategorySequenceMismatch},
+		// the errors only keeping the messages of the registered errors are not matched
+		{fmt.Errorf("tx failed: %s", finalitytypes.ErrDuplicatedFinalitySig.Error()), CategoryTransient},
+		{fmt.Errorf("connection refused"), CategoryTransient},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.category, CategoryOf(categorizeBabylonErr(tc.err)), tc.err.Error())
+	}
+}
+
+// newABCIError returns the error restored from the codespace
+// and the code of the given error as in a failed transaction
+func newABCIError(err *sdkErr.Error) error {
+	return sdkErr.ABCIError(err.Codespace(), err.ABCICode(), "failed to execute message")
+}
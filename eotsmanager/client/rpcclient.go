@@ -7,11 +7,11 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/babylonchain/finality-provider/eotsmanager"
 	"github.com/babylonchain/finality-provider/eotsmanager/proto"
 	"github.com/babylonchain/finality-provider/eotsmanager/types"
+	"github.com/babylonchain/finality-provider/rpcauth"
 )
 
 var _ eotsmanager.EOTSManager = &EOTSManagerGRpcClient{}
@@ -21,8 +21,16 @@ type EOTSManagerGRpcClient struct {
 	conn   *grpc.ClientConn
 }
 
-func NewEOTSManagerGRpcClient(remoteAddr string) (*EOTSManagerGRpcClient, error) {
-	conn, err := grpc.Dial(remoteAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// NewEOTSManagerGRpcClient connects to the EOTS manager at the given address,
+// following the given auth config, or insecurely if it is nil
+func NewEOTSManagerGRpcClient(remoteAddr string, authCfg *rpcauth.ClientConfig) (*EOTSManagerGRpcClient, error) {
+	dialOpts, err := rpcauth.DialOptions(authCfg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid EOTS manager auth config: %w", err)
+	}
+
+	dialOpts = append(dialOpts, types.StatusErrorClientInterceptor())
+	conn, err := grpc.Dial(remoteAddr, dialOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build gRPC connection to %s: %w", remoteAddr, err)
 	}
@@ -60,17 +68,24 @@ func (c *EOTSManagerGRpcClient) CreateKey(name, passphrase, hdPath string) ([]by
 	return res.Pk, nil
 }
 
-func (c *EOTSManagerGRpcClient) CreateMasterRandPair(uid, chainID []byte, passphrase string) (string, error) {
+func (c *EOTSManagerGRpcClient) CreateMasterRandPair(uid, chainID []byte, version types.MasterRandVersion, passphrase string) (string, error) {
 	req := &proto.CreateMasterRandPairRequest{
 		Uid:        uid,
 		ChainId:    chainID,
 		Passphrase: passphrase,
+		Version:    uint32(version),
 	}
 	res, err := c.client.CreateMasterRandPair(context.Background(), req)
 	if err != nil {
 		return "", err
 	}
 
+	// the EOTS managers predating the versions ignore the requested
+	// version and return the v1 master public randomness
+	if returned := types.MasterPubRandVersion(res.MasterPubRand); returned != version {
+		return "", fmt.Errorf("the EOTS manager returned the master public randomness of v%d instead of v%d", returned, version)
+	}
+
 	return res.MasterPubRand, nil
 }
 
@@ -124,6 +139,34 @@ func (c *EOTSManagerGRpcClient) SignSchnorrSig(uid, msg []byte, passphrase strin
 	return sig, nil
 }
 
+func (c *EOTSManagerGRpcClient) SignPop(uid, babylonSig []byte, passphrase string) (*schnorr.Signature, error) {
+	req := &proto.SignPopRequest{Uid: uid, BabylonSig: babylonSig, Passphrase: passphrase}
+	res, err := c.client.SignPop(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := schnorr.ParseSignature(res.BtcSig)
+	if err != nil {
+		return nil, err
+	}
+
+	return sig, nil
+}
+
+func (c *EOTSManagerGRpcClient) Unlock(uid []byte, passphrase string) error {
+	req := &proto.UnlockRequest{Uid: uid, Passphrase: passphrase}
+	_, err := c.client.Unlock(context.Background(), req)
+
+	return err
+}
+
+func (c *EOTSManagerGRpcClient) Lock(uid []byte) error {
+	_, err := c.client.Lock(context.Background(), &proto.LockRequest{Uid: uid})
+
+	return err
+}
+
 func (c *EOTSManagerGRpcClient) Close() error {
 	return c.conn.Close()
 }
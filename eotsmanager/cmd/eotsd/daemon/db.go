@@ -0,0 +1,105 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/urfave/cli"
+	"go.uber.org/zap"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/eotsmanager/store"
+	"github.com/babylonchain/finality-provider/migration"
+)
+
+var DBCommands = []cli.Command{
+	{
+		Name:     "db",
+		Usage:    "Command sets of managing the EOTS manager database.",
+		Category: "Database",
+		Subcommands: []cli.Command{
+			MigrateDBCmd,
+		},
+	},
+}
+
+var MigrateDBCmd = cli.Command{
+	Name:  "migrate",
+	Usage: "Migrate the EOTS manager database to the latest schema version.",
+	Description: `Apply the pending migrations of the database in order. The database is
+	copied to a backup file next to it before each migration. The migrations are also
+	applied when eotsd is started, which should be stopped before migrating`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "Path to the EOTS manager home directory",
+			Value: config.DefaultEOTSDir,
+		},
+		cli.BoolFlag{
+			Name:  dryRunFlag,
+			Usage: "Apply the pending migrations without saving the changes, to check that they succeed",
+		},
+	},
+	Action: migrateDB,
+}
+
+func migrateDB(ctx *cli.Context) error {
+	homePath, err := getHomeFlag(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load home flag: %w", err)
+	}
+
+	cfg, err := config.LoadConfig(homePath)
+	if err != nil {
+		return fmt.Errorf("failed to load config at %s: %w", homePath, err)
+	}
+
+	dbBackend, err := cfg.DatabaseConfig.GetDbBackend()
+	if err != nil {
+		return fmt.Errorf("failed to create db backend: %w", err)
+	}
+	defer dbBackend.Close()
+
+	var res *migration.Result
+	if ctx.Bool(dryRunFlag) {
+		res, err = migration.DryRun(dbBackend, store.Migrations())
+	} else {
+		res, err = migration.Run(dbBackend, store.Migrations(), migration.BackupPath(cfg.DatabaseConfig.DBPath, cfg.DatabaseConfig.DBFileName))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to migrate the db: %w", err)
+	}
+
+	printRespJSON(res)
+
+	return nil
+}
+
+// migrateDBOnStart applies the pending migrations of the
+// database before the EOTS manager is created
+func migrateDBOnStart(db kvdb.Backend, cfg *config.DBConfig, logger *zap.Logger) error {
+	res, err := migration.Run(db, store.Migrations(), migration.BackupPath(cfg.DBPath, cfg.DBFileName))
+	if err != nil {
+		return fmt.Errorf("failed to migrate the db: %w", err)
+	}
+
+	if len(res.Applied) > 0 {
+		logger.Info("the db is migrated",
+			zap.Uint32("from_version", res.FromVersion),
+			zap.Uint32("to_version", res.ToVersion),
+			zap.Strings("backups", res.Backups))
+	}
+
+	return nil
+}
+
+// withMigrationHint adds the hint to migrate the database to the error of opening
+// it if its schema is outdated, as only the start of eotsd migrates it
+func withMigrationHint(err error) error {
+	if errors.Is(err, migration.ErrOutdatedSchema) {
+		return fmt.Errorf("%w, run `eotsd db migrate` to migrate the database", err)
+	}
+
+	return err
+}
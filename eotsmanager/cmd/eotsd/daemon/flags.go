@@ -8,6 +8,7 @@ const (
 	rpcListenerFlag = "rpc-listener"
 	fpPkFlag        = "btc-pk"
 	signatureFlag   = "signature"
+	dryRunFlag      = "dry-run"
 
 	// flags for keys
 	keyNameFlag        = "key-name"
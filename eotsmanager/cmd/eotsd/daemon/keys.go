@@ -99,7 +99,7 @@ func addKey(ctx *cli.Context) error {
 
 	eotsManager, err := eotsmanager.NewLocalEOTSManager(homePath, keyringBackend, dbBackend, logger)
 	if err != nil {
-		return fmt.Errorf("failed to create EOTS manager: %w", err)
+		return fmt.Errorf("failed to create EOTS manager: %w", withMigrationHint(err))
 	}
 
 	eotsPk, mnemonic, err := createKey(ctx, eotsManager, keyName)
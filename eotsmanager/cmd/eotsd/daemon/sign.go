@@ -160,7 +160,7 @@ func SignSchnorr(ctx *cli.Context) error {
 
 	eotsManager, err := eotsmanager.NewLocalEOTSManager(homePath, keyringBackend, dbBackend, logger)
 	if err != nil {
-		return fmt.Errorf("failed to create EOTS manager: %w", err)
+		return fmt.Errorf("failed to create EOTS manager: %w", withMigrationHint(err))
 	}
 
 	hashOfMsgToSign, err := hashFromFile(inputFilePath)
@@ -151,5 +151,7 @@ func testApp() *cli.App {
 	app.Name = "eotsd"
 	app.Commands = append(app.Commands, dcli.StartCommand, dcli.InitCommand, dcli.SignSchnorrSig, dcli.VerifySchnorrSig)
 	app.Commands = append(app.Commands, dcli.KeysCommands...)
+	app.Commands = append(app.Commands, dcli.SlashingProtectionCommands...)
+	app.Commands = append(app.Commands, dcli.DBCommands...)
 	return app
 }
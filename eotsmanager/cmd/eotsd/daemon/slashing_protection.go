@@ -0,0 +1,262 @@
+package daemon
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"unicode/utf8"
+
+	"github.com/urfave/cli"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/eotsmanager/store"
+)
+
+// SlashingProtectionFormatVersion is the version of the slashing protection
+// interchange format produced by the export command
+const SlashingProtectionFormatVersion = 1
+
+// SlashingProtectionData is the interchange format of the EOTS signing history,
+// used to move the slashing protection data of EOTS keys between hosts
+type SlashingProtectionData struct {
+	Version uint32                      `json:"version"`
+	Records []*SlashingProtectionRecord `json:"records"`
+}
+
+// SlashingProtectionRecord lists the heights signed by an EOTS key for a chain
+type SlashingProtectionRecord struct {
+	EOTSPkHex     string          `json:"eots_pk_hex"`
+	ChainID       string          `json:"chain_id"`
+	SignedHeights []*SignedHeight `json:"signed_heights"`
+}
+
+// SignedHeight is the hash of the message signed at a height
+type SignedHeight struct {
+	Height     uint64 `json:"height"`
+	MsgHashHex string `json:"msg_hash_hex"`
+}
+
+type SlashingProtectionImported struct {
+	NumRecords int `json:"num_records"`
+	NumAdded   int `json:"num_added"`
+}
+
+var SlashingProtectionCommands = []cli.Command{
+	{
+		Name:     "slashing-protection",
+		Usage:    "Command sets of managing the slashing protection data of EOTS keys.",
+		Category: "Slashing protection",
+		Subcommands: []cli.Command{
+			ExportSlashingProtectionCmd,
+			ImportSlashingProtectionCmd,
+		},
+	},
+}
+
+var ExportSlashingProtectionCmd = cli.Command{
+	Name:      "export",
+	Usage:     "Export the EOTS signing history to a JSON file.",
+	UsageText: "export [file-path]",
+	Description: `Write the heights and message hashes signed by each EOTS key
+	for each chain to the file received as argument. The EOTS manager daemon
+	should be stopped before exporting so that the file covers all the signatures`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "Path to the EOTS manager home directory",
+			Value: config.DefaultEOTSDir,
+		},
+	},
+	Action: exportSlashingProtection,
+}
+
+var ImportSlashingProtectionCmd = cli.Command{
+	Name:      "import",
+	Usage:     "Import the EOTS signing history from a JSON file.",
+	UsageText: "import [file-path]",
+	Description: `Merge the signing history in the file received as argument, produced
+	by the export command, into the local signing history, so that the heights signed
+	on another host cannot be signed again with a different message. Nothing is imported
+	if the file conflicts with the local signing history`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "Path to the EOTS manager home directory",
+			Value: config.DefaultEOTSDir,
+		},
+	},
+	Action: importSlashingProtection,
+}
+
+func exportSlashingProtection(ctx *cli.Context) error {
+	outputFilePath := ctx.Args().First()
+	if len(outputFilePath) == 0 {
+		return errors.New("invalid argument, please provide a valid file path as output argument")
+	}
+
+	es, closeStore, err := loadEOTSStore(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeStore()
+
+	entries, err := es.GetSigningHistory()
+	if err != nil {
+		return fmt.Errorf("failed to get the signing history: %w", err)
+	}
+
+	data, err := NewSlashingProtectionData(entries)
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.MarshalIndent(data, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(outputFilePath, bz, 0600); err != nil {
+		return fmt.Errorf("failed to write the file %s: %w", outputFilePath, err)
+	}
+
+	fmt.Printf("Successfully exported the slashing protection data to %s\n", outputFilePath)
+	return nil
+}
+
+func importSlashingProtection(ctx *cli.Context) error {
+	inputFilePath := ctx.Args().First()
+	if len(inputFilePath) == 0 {
+		return errors.New("invalid argument, please provide a valid file path as input argument")
+	}
+
+	bz, err := os.ReadFile(inputFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to read the file %s: %w", inputFilePath, err)
+	}
+
+	var data SlashingProtectionData
+	if err := json.Unmarshal(bz, &data); err != nil {
+		return fmt.Errorf("failed to parse the file %s: %w", inputFilePath, err)
+	}
+
+	entries, err := data.ToSigningHistory()
+	if err != nil {
+		return err
+	}
+
+	es, closeStore, err := loadEOTSStore(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeStore()
+
+	numAdded, err := es.MergeSigningHistory(entries)
+	if err != nil {
+		return fmt.Errorf("failed to import the slashing protection data: %w", err)
+	}
+
+	printRespJSON(SlashingProtectionImported{
+		NumRecords: len(entries),
+		NumAdded:   numAdded,
+	})
+
+	return nil
+}
+
+func loadEOTSStore(ctx *cli.Context) (*store.EOTSStore, func(), error) {
+	homePath, err := getHomeFlag(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load home flag: %w", err)
+	}
+
+	cfg, err := config.LoadConfig(homePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load config at %s: %w", homePath, err)
+	}
+
+	dbBackend, err := cfg.DatabaseConfig.GetDbBackend()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create db backend: %w", err)
+	}
+
+	es, err := store.NewEOTSStore(dbBackend)
+	if err != nil {
+		dbBackend.Close()
+		return nil, nil, fmt.Errorf("failed to initialize store: %w", withMigrationHint(err))
+	}
+
+	return es, func() { dbBackend.Close() }, nil
+}
+
+// NewSlashingProtectionData groups the given signing history entries
+// by EOTS key and chain into the interchange format
+func NewSlashingProtectionData(entries []*store.SigningHistoryEntry) (*SlashingProtectionData, error) {
+	data := &SlashingProtectionData{
+		Version: SlashingProtectionFormatVersion,
+		Records: []*SlashingProtectionRecord{},
+	}
+
+	// entries are ordered by EOTS key and chain, so the entries of the same
+	// EOTS key and chain are contiguous
+	var record *SlashingProtectionRecord
+	for _, e := range entries {
+		if !utf8.Valid(e.ChainID) {
+			return nil, fmt.Errorf("the chain ID %x of EOTS key %x is not a valid UTF-8 string", e.ChainID, e.FpPk)
+		}
+
+		eotsPkHex := hex.EncodeToString(e.FpPk)
+		chainID := string(e.ChainID)
+		if record == nil || record.EOTSPkHex != eotsPkHex || record.ChainID != chainID {
+			record = &SlashingProtectionRecord{
+				EOTSPkHex: eotsPkHex,
+				ChainID:   chainID,
+			}
+			data.Records = append(data.Records, record)
+		}
+
+		record.SignedHeights = append(record.SignedHeights, &SignedHeight{
+			Height:     e.Height,
+			MsgHashHex: hex.EncodeToString(e.MsgHash),
+		})
+	}
+
+	return data, nil
+}
+
+// ToSigningHistory validates the interchange data and converts it
+// into signing history entries
+func (d *SlashingProtectionData) ToSigningHistory() ([]*store.SigningHistoryEntry, error) {
+	if d.Version != SlashingProtectionFormatVersion {
+		return nil, fmt.Errorf("unsupported slashing protection format version %d, expected %d",
+			d.Version, SlashingProtectionFormatVersion)
+	}
+
+	var entries []*store.SigningHistoryEntry
+	for _, r := range d.Records {
+		fpPk, err := hex.DecodeString(r.EOTSPkHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EOTS public key %s: %w", r.EOTSPkHex, err)
+		}
+		if len(r.ChainID) == 0 {
+			return nil, fmt.Errorf("empty chain ID for EOTS public key %s", r.EOTSPkHex)
+		}
+
+		for _, h := range r.SignedHeights {
+			msgHash, err := hex.DecodeString(h.MsgHashHex)
+			if err != nil {
+				return nil, fmt.Errorf("invalid message hash %s: %w", h.MsgHashHex, err)
+			}
+
+			entries = append(entries, &store.SigningHistoryEntry{
+				FpPk:    fpPk,
+				ChainID: []byte(r.ChainID),
+				Height:  h.Height,
+				MsgHash: msgHash,
+			})
+		}
+	}
+
+	return entries, nil
+}
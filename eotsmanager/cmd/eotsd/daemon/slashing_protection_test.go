@@ -0,0 +1,131 @@
+package daemon_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+
+	dcli "github.com/babylonchain/finality-provider/eotsmanager/cmd/eotsd/daemon"
+	"github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/eotsmanager/store"
+	"github.com/babylonchain/finality-provider/migration"
+	"github.com/babylonchain/finality-provider/testutil"
+)
+
+// FuzzSlashingProtectionExportImport tests moving the signing history
+// between two EOTS manager homes through the slashing protection commands
+func FuzzSlashingProtectionExportImport(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		tempDir := t.TempDir()
+		srcHomeDir := filepath.Join(tempDir, "eots-home-src")
+		dstHomeDir := filepath.Join(tempDir, "eots-home-dst")
+		app := testApp()
+
+		for _, homeDir := range []string{srcHomeDir, dstHomeDir} {
+			err := app.Run([]string{"eotsd", "init", fmt.Sprintf("--home=%s", homeDir)})
+			require.NoError(t, err)
+		}
+
+		_, btcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fpPk := schnorr.SerializePubKey(btcPk)
+		chainID := []byte(testutil.GenRandomHexStr(r, 10))
+		height := datagen.RandomInt(r, 1000)
+		msgHash := datagen.GenRandomByteArray(r, 32)
+		withEOTSStore(t, srcHomeDir, func(es *store.EOTSStore) {
+			err := es.SaveSigningRecord(fpPk, chainID, height, msgHash, datagen.GenRandomByteArray(r, 32))
+			require.NoError(t, err)
+		})
+
+		exportPath := filepath.Join(tempDir, "slashing-protection.json")
+		err = app.Run([]string{"eotsd", "slashing-protection", "export", exportPath, fmt.Sprintf("--home=%s", srcHomeDir)})
+		require.NoError(t, err)
+
+		importArgs := []string{"eotsd", "slashing-protection", "import", exportPath, fmt.Sprintf("--home=%s", dstHomeDir)}
+		var imported dcli.SlashingProtectionImported
+		err = json.Unmarshal([]byte(appRunWithOutput(r, t, app, importArgs)), &imported)
+		require.NoError(t, err)
+		require.Equal(t, 1, imported.NumRecords)
+		require.Equal(t, 1, imported.NumAdded)
+
+		withEOTSStore(t, dstHomeDir, func(es *store.EOTSStore) {
+			record, err := es.GetSigningRecord(fpPk, chainID, height)
+			require.NoError(t, err)
+			require.Equal(t, msgHash, record.MsgHash)
+
+			err = es.SaveSigningRecord(fpPk, chainID, height+1, datagen.GenRandomByteArray(r, 32), nil)
+			require.NoError(t, err)
+		})
+
+		// a different message signed at the same height in the
+		// source home makes the next import conflict
+		withEOTSStore(t, srcHomeDir, func(es *store.EOTSStore) {
+			err := es.SaveSigningRecord(fpPk, chainID, height+1, datagen.GenRandomByteArray(r, 32), nil)
+			require.NoError(t, err)
+		})
+		err = app.Run([]string{"eotsd", "slashing-protection", "export", exportPath, fmt.Sprintf("--home=%s", srcHomeDir)})
+		require.NoError(t, err)
+		err = app.Run(importArgs)
+		require.ErrorIs(t, err, store.ErrConflictingSigningRecord)
+	})
+}
+
+// TestSlashingProtectionOutdatedSchema tests that the commands opening a database
+// created before the schema versioning hint to migrate it, and succeed once the
+// database is migrated
+func TestSlashingProtectionOutdatedSchema(t *testing.T) {
+	tempDir := t.TempDir()
+	homeDir := filepath.Join(tempDir, "eots-home")
+	app := testApp()
+	err := app.Run([]string{"eotsd", "init", fmt.Sprintf("--home=%s", homeDir)})
+	require.NoError(t, err)
+
+	// the database is created before the schema versioning, which has neither
+	// the schema version nor the bucket of the signing history
+	withEOTSStore(t, homeDir, func(es *store.EOTSStore) {})
+	cfg, err := config.LoadConfig(homeDir)
+	require.NoError(t, err)
+	dbBackend, err := cfg.DatabaseConfig.GetDbBackend()
+	require.NoError(t, err)
+	err = kvdb.Update(dbBackend, func(tx kvdb.RwTx) error {
+		if err := tx.DeleteTopLevelBucket([]byte("signingHistory")); err != nil {
+			return err
+		}
+		return migration.SetVersion(tx, 0)
+	}, func() {})
+	require.NoError(t, err)
+	require.NoError(t, dbBackend.Close())
+
+	exportPath := filepath.Join(tempDir, "slashing-protection.json")
+	exportArgs := []string{"eotsd", "slashing-protection", "export", exportPath, fmt.Sprintf("--home=%s", homeDir)}
+	err = app.Run(exportArgs)
+	require.ErrorIs(t, err, migration.ErrOutdatedSchema)
+	require.ErrorContains(t, err, "eotsd db migrate")
+
+	err = app.Run([]string{"eotsd", "db", "migrate", fmt.Sprintf("--home=%s", homeDir)})
+	require.NoError(t, err)
+	err = app.Run(exportArgs)
+	require.NoError(t, err)
+}
+
+func withEOTSStore(t *testing.T, homeDir string, f func(es *store.EOTSStore)) {
+	cfg, err := config.LoadConfig(homeDir)
+	require.NoError(t, err)
+	dbBackend, err := cfg.DatabaseConfig.GetDbBackend()
+	require.NoError(t, err)
+	defer dbBackend.Close()
+
+	es, err := store.NewEOTSStore(dbBackend)
+	require.NoError(t, err)
+	f(es)
+}
@@ -5,12 +5,15 @@ import (
 	"net"
 	"path/filepath"
 
+	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/signal"
 	"github.com/urfave/cli"
+	"go.uber.org/zap"
 
 	"github.com/babylonchain/finality-provider/eotsmanager"
 	"github.com/babylonchain/finality-provider/eotsmanager/config"
 	eotsservice "github.com/babylonchain/finality-provider/eotsmanager/service"
+	"github.com/babylonchain/finality-provider/eotsmanager/signer"
 	"github.com/babylonchain/finality-provider/log"
 	"github.com/babylonchain/finality-provider/util"
 )
@@ -63,7 +66,11 @@ func startFn(ctx *cli.Context) error {
 		return fmt.Errorf("failed to create db backend: %w", err)
 	}
 
-	eotsManager, err := eotsmanager.NewLocalEOTSManager(homePath, cfg.KeyringBackend, dbBackend, logger)
+	if err := migrateDBOnStart(dbBackend, cfg.DatabaseConfig, logger); err != nil {
+		return err
+	}
+
+	eotsManager, err := newEOTSManager(homePath, cfg, dbBackend, logger)
 	if err != nil {
 		return fmt.Errorf("failed to create EOTS manager: %w", err)
 	}
@@ -79,6 +86,37 @@ func startFn(ctx *cli.Context) error {
 	return eotsServer.RunUntilShutdown()
 }
 
+// newEOTSManager creates the EOTS manager with the signer backend set in the config
+func newEOTSManager(homePath string, cfg *config.Config, dbBackend kvdb.Backend, logger *zap.Logger) (*eotsmanager.LocalEOTSManager, error) {
+	var (
+		signerBackend signer.Backend
+		err           error
+	)
+	if cfg.Signer.IsRemote() {
+		signerBackend, err = signer.NewGRPCBackend(cfg.Signer.RemoteAddress, cfg.Signer.Timeout, cfg.SignerAuth)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to the remote signer: %w", err)
+		}
+	} else {
+		signerBackend, err = signer.NewSoftwareBackend(homePath, cfg.KeyringBackend, cfg.Signer.KeyCacheTTL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	em, err := eotsmanager.NewLocalEOTSManagerWithSigner(signerBackend, dbBackend, logger)
+	if err != nil {
+		signerBackend.Close()
+		return nil, err
+	}
+
+	if cfg.Signer.IsRemote() {
+		logger.Info("using the remote signer", zap.String("address", cfg.Signer.RemoteAddress))
+	}
+
+	return em, nil
+}
+
 func getHomeFlag(ctx *cli.Context) (string, error) {
 	homePath, err := filepath.Abs(ctx.String(homeFlag))
 	if err != nil {
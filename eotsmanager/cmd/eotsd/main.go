@@ -20,6 +20,8 @@ func main() {
 	app.Usage = "Extractable One Time Signature Daemon (eotsd)."
 	app.Commands = append(app.Commands, dcli.StartCommand, dcli.InitCommand, dcli.SignSchnorrSig, dcli.VerifySchnorrSig)
 	app.Commands = append(app.Commands, dcli.KeysCommands...)
+	app.Commands = append(app.Commands, dcli.SlashingProtectionCommands...)
+	app.Commands = append(app.Commands, dcli.DBCommands...)
 
 	if err := app.Run(os.Args); err != nil {
 		fatal(err)
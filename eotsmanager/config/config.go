@@ -11,6 +11,7 @@ import (
 	"github.com/jessevdk/go-flags"
 
 	"github.com/babylonchain/finality-provider/metrics"
+	"github.com/babylonchain/finality-provider/rpcauth"
 	"github.com/babylonchain/finality-provider/util"
 )
 
@@ -35,10 +36,14 @@ var (
 )
 
 type Config struct {
-	LogLevel       string          `long:"loglevel" description:"Logging level for all subsystems" choice:"trace" choice:"debug" choice:"info" choice:"warn" choice:"error" choice:"fatal"`
-	KeyringBackend string          `long:"keyring-type" description:"Type of keyring to use"`
-	RpcListener    string          `long:"rpclistener" description:"the listener for RPC connections, e.g., 127.0.0.1:1234"`
-	Metrics        *metrics.Config `group:"metrics" namespace:"metrics"`
+	LogLevel         string                `long:"loglevel" description:"Logging level for all subsystems" choice:"trace" choice:"debug" choice:"info" choice:"warn" choice:"error" choice:"fatal"`
+	KeyringBackend   string                `long:"keyring-type" description:"Type of keyring to use"`
+	RpcListener      string                `long:"rpclistener" description:"the listener for RPC connections, e.g., 127.0.0.1:1234"`
+	DisableKeyRecord bool                  `long:"disablekeyrecord" description:"Disable the KeyRecord RPC which exposes the EOTS private keys, recommended for production deployments"`
+	Metrics          *metrics.Config       `group:"metrics" namespace:"metrics"`
+	Signer           *SignerConfig         `group:"signer" namespace:"signer"`
+	SignerAuth       *rpcauth.ClientConfig `group:"signerauth" namespace:"signerauth"`
+	RPCAuth          *rpcauth.ServerConfig `group:"rpcauth" namespace:"rpcauth"`
 
 	DatabaseConfig *DBConfig `group:"dbconfig" namespace:"dbconfig"`
 }
@@ -60,9 +65,11 @@ func LoadConfig(homePath string) (*Config, error) {
 			"not exist in %s", cfgFile)
 	}
 
-	// Next, load any additional configuration options from the file.
-	var cfg Config
-	fileParser := flags.NewParser(&cfg, flags.Default)
+	// Next, load any additional configuration options from the file, which
+	// overwrite the defaults, so that the options and sections missing from
+	// the config files created by previous releases keep their defaults
+	cfg := DefaultConfigWithHomePath(homePath)
+	fileParser := flags.NewParser(cfg, flags.Default)
 	err := flags.NewIniParser(fileParser).ParseFile(cfgFile)
 	if err != nil {
 		return nil, err
@@ -73,7 +80,7 @@ func LoadConfig(homePath string) (*Config, error) {
 		return nil, err
 	}
 
-	return &cfg, nil
+	return cfg, nil
 }
 
 // Validate check the given configuration to be sane. This makes sure no
@@ -97,6 +104,36 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("invalid metrics config")
 	}
 
+	if cfg.RPCAuth == nil {
+		return fmt.Errorf("empty RPC auth config")
+	}
+
+	if err := cfg.RPCAuth.Validate(); err != nil {
+		return fmt.Errorf("invalid RPC auth config: %w", err)
+	}
+
+	if cfg.Signer == nil {
+		return fmt.Errorf("empty signer config")
+	}
+
+	if err := cfg.Signer.Validate(); err != nil {
+		return fmt.Errorf("invalid signer config: %w", err)
+	}
+
+	if cfg.SignerAuth == nil {
+		return fmt.Errorf("empty signer auth config")
+	}
+
+	if err := cfg.SignerAuth.Validate(); err != nil {
+		return fmt.Errorf("invalid signer auth config: %w", err)
+	}
+
+	// the passphrases and mnemonics are sent to the remote signer,
+	// which should not be in plaintext over the network
+	if cfg.Signer.IsRemote() && !cfg.SignerAuth.TLSEnabled() && !rpcauth.IsLoopbackAddress(cfg.Signer.RemoteAddress) {
+		return fmt.Errorf("the remote signer at %s requires TLS as it is not a loopback address", cfg.Signer.RemoteAddress)
+	}
+
 	return nil
 }
 
@@ -127,6 +164,9 @@ func DefaultConfigWithHomePath(homePath string) *Config {
 		DatabaseConfig: DefaultDBConfigWithHomePath(homePath),
 		RpcListener:    defaultRpcListener,
 		Metrics:        metrics.DefaultEotsConfig(),
+		Signer:         DefaultSignerConfig(),
+		SignerAuth:     rpcauth.DefaultClientConfig(),
+		RPCAuth:        rpcauth.DefaultServerConfig(),
 	}
 	if err := cfg.Validate(); err != nil {
 		panic(err)
@@ -0,0 +1,30 @@
+package config_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/config"
+)
+
+// TestLoadConfigWithMissingSections tests that the sections missing from
+// the config files created by previous releases keep their defaults
+func TestLoadConfigWithMissingSections(t *testing.T) {
+	homePath := t.TempDir()
+	cfgContent := "[Application Options]\nKeyringBackend = file\nRpcListener = 127.0.0.1:12590\n"
+	err := os.WriteFile(config.ConfigFile(homePath), []byte(cfgContent), 0600)
+	require.NoError(t, err)
+
+	cfg, err := config.LoadConfig(homePath)
+	require.NoError(t, err)
+	require.Equal(t, "file", cfg.KeyringBackend)
+	require.Equal(t, "127.0.0.1:12590", cfg.RpcListener)
+
+	defaultCfg := config.DefaultConfigWithHomePath(homePath)
+	require.Equal(t, defaultCfg.Signer, cfg.Signer)
+	require.Equal(t, defaultCfg.SignerAuth, cfg.SignerAuth)
+	require.Equal(t, defaultCfg.RPCAuth, cfg.RPCAuth)
+	require.Equal(t, defaultCfg.DatabaseConfig, cfg.DatabaseConfig)
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"time"
+)
+
+const (
+	// SignerBackendLocal keeps the EOTS keys in the keyring of eotsd
+	SignerBackendLocal = "local"
+	// SignerBackendRemote delegates the operations requiring the EOTS keys
+	// to an external signer plugin over gRPC
+	SignerBackendRemote = "remote"
+
+	defaultSignerTimeout = 10 * time.Second
+	defaultKeyCacheTTL   = 10 * time.Minute
+)
+
+type SignerConfig struct {
+	Backend       string        `long:"backend" description:"The backend holding the EOTS keys" choice:"local" choice:"remote"`
+	RemoteAddress string        `long:"remoteaddress" description:"The address of the remote signer plugin, e.g., 127.0.0.1:12583"`
+	Timeout       time.Duration `long:"timeout" description:"The timeout of the requests to the remote signer plugin"`
+	KeyCacheTTL   time.Duration `long:"keycachettl" description:"How long the local backend keeps the unlocked EOTS keys and master secret randomness in memory, 0 disables the cache"`
+}
+
+func DefaultSignerConfig() *SignerConfig {
+	return &SignerConfig{
+		Backend:     SignerBackendLocal,
+		Timeout:     defaultSignerTimeout,
+		KeyCacheTTL: defaultKeyCacheTTL,
+	}
+}
+
+// IsRemote returns whether the operations requiring the EOTS keys
+// are delegated to a remote signer plugin
+func (cfg *SignerConfig) IsRemote() bool {
+	return cfg.Backend == SignerBackendRemote
+}
+
+func (cfg *SignerConfig) Validate() error {
+	if cfg.KeyCacheTTL < 0 {
+		return fmt.Errorf("the key cache TTL should not be negative")
+	}
+
+	switch cfg.Backend {
+	// config files created before the signer backend was introduced
+	// do not have the option, in which case the keyring is used
+	case "", SignerBackendLocal:
+		return nil
+	case SignerBackendRemote:
+		if _, err := net.ResolveTCPAddr("tcp", cfg.RemoteAddress); err != nil {
+			return fmt.Errorf("invalid remote signer address %s, %w", cfg.RemoteAddress, err)
+		}
+		if cfg.Timeout <= 0 {
+			return fmt.Errorf("the remote signer timeout should be positive")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported signer backend %s", cfg.Backend)
+	}
+}
@@ -13,10 +13,12 @@ type EOTSManager interface {
 	// It fails if there is an existing key Info with the same name or public key.
 	CreateKey(name, passphrase, hdPath string) ([]byte, error)
 
-	// CreateMasterRandPair generates a pair of master secret/public randomness
+	// CreateMasterRandPair generates a pair of master secret/public randomness of the given version,
+	// which is recorded for signing the EOTS signatures of the finality provider on the chain
 	// It fails if the finality provider does not exist or passPhrase is incorrect
+	// or a different version has been created for the chain
 	// NOTE: the master randomness pair is deterministically generated based on the EOTS key and chainID
-	CreateMasterRandPair(uid []byte, chainID []byte, passphrase string) (string, error)
+	CreateMasterRandPair(uid []byte, chainID []byte, version types.MasterRandVersion, passphrase string) (string, error)
 
 	// KeyRecord returns the finality provider record
 	// It fails if the finality provider does not exist or passPhrase is incorrect
@@ -26,6 +28,10 @@ type EOTSManager interface {
 	// secret randomness of the give chain at the given height
 	// It fails if the finality provider does not exist or there's no randomness committed to the given height
 	// or passPhrase is incorrect
+	// It refuses to sign if a different message has already been signed at the same height
+	// and returns the recorded signature if the same message has been signed before
+	// It refuses to sign above the maximum height of the recorded master randomness version,
+	// where the randomness would be reused
 	SignEOTS(uid []byte, chainID []byte, msg []byte, height uint64, passphrase string) (*btcec.ModNScalar, error)
 
 	// SignSchnorrSig signs a Schnorr signature using the private key of the finality provider
@@ -33,5 +39,21 @@ type EOTSManager interface {
 	// or passPhrase is incorrect
 	SignSchnorrSig(uid []byte, msg []byte, passphrase string) (*schnorr.Signature, error)
 
+	// SignPop signs the BTC part of a proof-of-possession, i.e., a BIP-340 signature
+	// over the hash of babylonSig, the signature of the finality provider's Babylon key
+	// over the EOTS public key
+	// It fails if the finality provider does not exist or passPhrase is incorrect
+	SignPop(uid []byte, babylonSig []byte, passphrase string) (*schnorr.Signature, error)
+
+	// Unlock keeps the private key and master secret randomness of the finality provider
+	// in memory, so that signing does not load them again until they expire or are locked
+	// It fails if the finality provider does not exist or passPhrase is incorrect
+	// or the signer backend does not cache keys
+	Unlock(uid []byte, passphrase string) error
+
+	// Lock wipes the private key and master secret randomness of the finality provider
+	// from memory, or of all the finality providers if uid is empty
+	Lock(uid []byte) error
+
 	Close() error
 }
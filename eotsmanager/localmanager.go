@@ -1,85 +1,85 @@
 package eotsmanager
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
-	"strings"
+	"sync"
 
 	"github.com/babylonchain/finality-provider/metrics"
 
-	"github.com/babylonchain/babylon/crypto/eots"
 	bbntypes "github.com/babylonchain/babylon/types"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
-	"github.com/cosmos/cosmos-sdk/crypto/keyring"
-	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
-	"github.com/cosmos/go-bip39"
 	"github.com/lightningnetwork/lnd/kvdb"
 	"go.uber.org/zap"
 
-	"github.com/babylonchain/finality-provider/codec"
+	"github.com/babylonchain/finality-provider/eotsmanager/signer"
 	"github.com/babylonchain/finality-provider/eotsmanager/store"
 	eotstypes "github.com/babylonchain/finality-provider/eotsmanager/types"
-	fpkeyring "github.com/babylonchain/finality-provider/keyring"
 )
 
 const (
-	secp256k1Type       = "secp256k1"
-	MnemonicEntropySize = 256
+	MnemonicEntropySize = signer.MnemonicEntropySize
 )
 
-var _ EOTSManager = &LocalEOTSManager{}
+var (
+	_ EOTSManager = &LocalEOTSManager{}
+
+	// ErrPrivKeyNotExportable is returned when the private key of an EOTS key
+	// is requested but the signer backend does not expose private keys
+	ErrPrivKeyNotExportable = errors.New("the signer backend does not expose EOTS private keys")
+
+	// ErrKeyCacheNotSupported is returned when an EOTS key is unlocked or locked
+	// but the signer backend does not keep the keys in memory
+	ErrKeyCacheNotSupported = errors.New("the signer backend does not cache EOTS keys")
+)
 
 type LocalEOTSManager struct {
-	kr     keyring.Keyring
-	es     *store.EOTSStore
-	logger *zap.Logger
-	// input is to send passphrase to kr
-	input   *strings.Reader
+	signer  signer.Backend
+	es      *store.EOTSStore
+	logger  *zap.Logger
 	metrics *metrics.EotsMetrics
+	// signMu ensures the signing history is checked and
+	// updated atomically across concurrent EOTS signing requests
+	signMu sync.Mutex
 }
 
+// NewLocalEOTSManager creates an EOTS manager which keeps the EOTS keys in
+// the keyring under the given home directory, without caching the unlocked keys
 func NewLocalEOTSManager(homeDir, keyringBackend string, dbbackend kvdb.Backend, logger *zap.Logger) (*LocalEOTSManager, error) {
-	inputReader := strings.NewReader("")
-
-	es, err := store.NewEOTSStore(dbbackend)
+	sb, err := signer.NewSoftwareBackend(homeDir, keyringBackend, 0)
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize store: %w", err)
+		return nil, err
 	}
 
-	kr, err := initKeyring(homeDir, keyringBackend, inputReader)
+	return NewLocalEOTSManagerWithSigner(sb, dbbackend, logger)
+}
+
+// NewLocalEOTSManagerWithSigner creates an EOTS manager which delegates
+// the operations requiring the EOTS private keys to the given signer backend
+func NewLocalEOTSManagerWithSigner(signerBackend signer.Backend, dbbackend kvdb.Backend, logger *zap.Logger) (*LocalEOTSManager, error) {
+	es, err := store.NewEOTSStore(dbbackend)
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize keyring: %w", err)
+		return nil, fmt.Errorf("failed to initialize store: %w", err)
 	}
 
 	eotsMetrics := metrics.NewEotsMetrics()
 
 	return &LocalEOTSManager{
-		kr:      kr,
+		signer:  signerBackend,
 		es:      es,
 		logger:  logger,
-		input:   inputReader,
 		metrics: eotsMetrics,
 	}, nil
 }
 
-func initKeyring(homeDir, keyringBackend string, inputReader *strings.Reader) (keyring.Keyring, error) {
-	return keyring.New(
-		"eots-manager",
-		keyringBackend,
-		homeDir,
-		inputReader,
-		codec.MakeCodec(),
-	)
-}
-
+// CreateKey generates a key pair in the signer backend, which
+// also generates the mnemonic the key is derived from
 func (lm *LocalEOTSManager) CreateKey(name, passphrase, hdPath string) ([]byte, error) {
-	mnemonic, err := NewMnemonic()
-	if err != nil {
-		return nil, err
-	}
-
-	eotsPk, err := lm.CreateKeyWithMnemonic(name, passphrase, hdPath, mnemonic)
+	eotsPk, err := lm.CreateKeyWithMnemonic(name, passphrase, hdPath, "")
 	if err != nil {
 		return nil, err
 	}
@@ -88,41 +88,11 @@ func (lm *LocalEOTSManager) CreateKey(name, passphrase, hdPath string) ([]byte,
 }
 
 func NewMnemonic() (string, error) {
-	// read entropy seed straight from tmcrypto.Rand and convert to mnemonic
-	entropySeed, err := bip39.NewEntropy(MnemonicEntropySize)
-	if err != nil {
-		return "", err
-	}
-
-	mnemonic, err := bip39.NewMnemonic(entropySeed)
-	if err != nil {
-		return "", err
-	}
-
-	return mnemonic, nil
+	return signer.NewMnemonic()
 }
 
 func (lm *LocalEOTSManager) CreateKeyWithMnemonic(name, passphrase, hdPath, mnemonic string) (*bbntypes.BIP340PubKey, error) {
-	if lm.keyExists(name) {
-		return nil, eotstypes.ErrFinalityProviderAlreadyExisted
-	}
-
-	keyringAlgos, _ := lm.kr.SupportedAlgorithms()
-	algo, err := keyring.NewSigningAlgoFromString(secp256k1Type, keyringAlgos)
-	if err != nil {
-		return nil, err
-	}
-
-	// we need to repeat the passphrase to mock the re-entry
-	// as when creating an account, passphrase will be asked twice
-	// by the keyring
-	lm.input.Reset(passphrase + "\n" + passphrase)
-	record, err := lm.kr.NewAccount(name, mnemonic, passphrase, hdPath, algo)
-	if err != nil {
-		return nil, err
-	}
-
-	eotsPk, err := loadBIP340PubKeyFromKeyringRecord(record)
+	eotsPk, err := lm.signer.CreateKey(name, passphrase, hdPath, mnemonic)
 	if err != nil {
 		return nil, err
 	}
@@ -141,98 +111,161 @@ func (lm *LocalEOTSManager) CreateKeyWithMnemonic(name, passphrase, hdPath, mnem
 	return eotsPk, nil
 }
 
-func loadBIP340PubKeyFromKeyringRecord(record *keyring.Record) (*bbntypes.BIP340PubKey, error) {
-	pubKey, err := record.GetPubKey()
+// CreateMasterRandPair creates a pair of master secret/public randomness of the given
+// version deterministically from the finality provider's secret key and chain ID. The
+// version is recorded, so that the EOTS signatures of the finality provider on the chain
+// are produced with the randomness derived from the same master randomness
+func (lm *LocalEOTSManager) CreateMasterRandPair(fpPk []byte, chainID []byte, version eotstypes.MasterRandVersion, passphrase string) (string, error) {
+	if err := version.Validate(); err != nil {
+		return "", err
+	}
+
+	keyName, err := lm.es.GetEOTSKeyName(fpPk)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	var eotsPk *bbntypes.BIP340PubKey
-	switch v := pubKey.(type) {
-	case *secp256k1.PubKey:
-		pk, err := btcec.ParsePubKey(v.Key)
-		if err != nil {
-			return nil, err
-		}
-		eotsPk = bbntypes.NewBIP340PubKeyFromBTCPK(pk)
-		return eotsPk, nil
-	default:
-		return nil, fmt.Errorf("unsupported key type in keyring")
+	mpr, err := lm.signer.MasterPublicRand(keyName, chainID, version, passphrase)
+	if err != nil {
+		return "", err
 	}
+
+	if err := lm.es.SaveMasterRandVersion(fpPk, chainID, uint32(version)); err != nil {
+		return "", fmt.Errorf("failed to save the master randomness version: %w", err)
+	}
+
+	return mpr, nil
 }
 
-// CreateMasterRandPair creates a pair of master secret/public randomness deterministically
-// from the finality provider's secret key and chain ID
-func (lm *LocalEOTSManager) CreateMasterRandPair(fpPk []byte, chainID []byte, passphrase string) (string, error) {
-	_, mpr, err := lm.getMasterRandPair(fpPk, chainID, passphrase)
+// masterRandVersion returns the version of the master randomness created by
+// the finality provider for the chain. The finality providers which created
+// their master randomness before the versions were recorded have v1
+func (lm *LocalEOTSManager) masterRandVersion(fpPk []byte, chainID []byte) (eotstypes.MasterRandVersion, error) {
+	version, err := lm.es.GetMasterRandVersion(fpPk, chainID)
+	if errors.Is(err, store.ErrMasterRandVersionNotFound) {
+		return eotstypes.MasterRandV1, nil
+	}
 	if err != nil {
-		return "", err
+		return 0, fmt.Errorf("failed to get the master randomness version: %w", err)
 	}
 
-	return mpr.MarshalBase58(), nil
+	return eotstypes.MasterRandVersion(version), nil
 }
 
 func (lm *LocalEOTSManager) SignEOTS(fpPk []byte, chainID []byte, msg []byte, height uint64, passphrase string) (*btcec.ModNScalar, error) {
-	// get master secret randomness
-	// TODO: instead of calculating master secret randomness everytime, is it possible
-	// to manage it in the keyring?
-	msr, _, err := lm.getMasterRandPair(fpPk, chainID, passphrase)
+	version, err := lm.masterRandVersion(fpPk, chainID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get master secret randomness: %w", err)
+		return nil, err
+	}
+	// the randomness must not wrap around the
+	// maximum height of the version
+	if err := version.CheckHeight(height); err != nil {
+		return nil, err
+	}
+
+	lm.signMu.Lock()
+	defer lm.signMu.Unlock()
+
+	// check the signing history to avoid signing a different message
+	// at the same height, which would leak the EOTS private key
+	msgHash := sha256.Sum256(msg)
+	record, err := lm.es.GetSigningRecord(fpPk, chainID, height)
+	if err != nil && !errors.Is(err, store.ErrSigningRecordNotFound) {
+		return nil, fmt.Errorf("failed to get the signing record: %w", err)
 	}
+	if record != nil {
+		if !bytes.Equal(record.MsgHash, msgHash[:]) {
+			return nil, &eotstypes.DoubleSignError{
+				FpPk:    fpPk,
+				ChainID: chainID,
+				Height:  height,
+			}
+		}
 
-	// derive secret randomness
-	sr, _, err := msr.DeriveRandPair(uint32(height)) // TODO: generalise to uint64
+		// records imported from another host do not carry the signature,
+		// in which case the same message is signed again below
+		if len(record.Signature) != 0 {
+			lm.logger.Debug(
+				"the message has already been signed at the height, returning the recorded signature",
+				zap.String("pk", hex.EncodeToString(fpPk)),
+				zap.Uint64("height", height),
+			)
+			var sig btcec.ModNScalar
+			sig.SetByteSlice(record.Signature)
+
+			return &sig, nil
+		}
+	}
+
+	keyName, err := lm.es.GetEOTSKeyName(fpPk)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get secret randomness: %w", err)
+		return nil, err
 	}
 
-	privKey, err := lm.getEOTSPrivKey(fpPk, passphrase)
+	sig, err := lm.signer.SignEOTS(keyName, chainID, msg, height, version, passphrase)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get EOTS private key: %w", err)
+		return nil, err
+	}
+
+	// record the signature before returning it
+	sigBytes := sig.Bytes()
+	if err := lm.es.SaveSigningRecord(fpPk, chainID, height, msgHash[:], sigBytes[:]); err != nil {
+		return nil, fmt.Errorf("failed to save the signing record: %w", err)
 	}
 
 	// Update metrics
 	lm.metrics.IncrementEotsFpTotalEotsSignCounter(hex.EncodeToString(fpPk))
 	lm.metrics.SetEotsFpLastEotsSignHeight(hex.EncodeToString(fpPk), float64(height))
 
-	return eots.Sign(privKey, sr, msg)
+	return sig, nil
 }
 
 func (lm *LocalEOTSManager) SignSchnorrSig(fpPk []byte, msg []byte, passphrase string) (*schnorr.Signature, error) {
-	privKey, err := lm.getEOTSPrivKey(fpPk, passphrase)
+	keyName, err := lm.es.GetEOTSKeyName(fpPk)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get EOTS private key: %w", err)
+		return nil, err
 	}
 
-	return lm.signSchnorrSigFromPrivKey(privKey, fpPk, msg)
+	return lm.signSchnorrSig(keyName, fpPk, msg, passphrase)
 }
 
-// signSchnorrSigFromPrivKey signs a Schnorr signature using the private key and updates metrics by the fpPk
-func (lm *LocalEOTSManager) signSchnorrSigFromPrivKey(privKey *btcec.PrivateKey, fpPk []byte, msg []byte) (*schnorr.Signature, error) {
-	// Update metrics
-	lm.metrics.IncrementEotsFpTotalSchnorrSignCounter(hex.EncodeToString(fpPk))
-	return schnorr.Sign(privKey, msg)
-}
+// SignPop signs the BTC part of a proof-of-possession over the hash of the given
+// Babylon signature, so that the EOTS private key does not leave the signer
+func (lm *LocalEOTSManager) SignPop(fpPk []byte, babylonSig []byte, passphrase string) (*schnorr.Signature, error) {
+	if len(babylonSig) == 0 {
+		return nil, fmt.Errorf("empty Babylon signature")
+	}
 
-func (lm *LocalEOTSManager) SignSchnorrSigFromKeyname(keyName, passphrase string, msg []byte) (*schnorr.Signature, *bbntypes.BIP340PubKey, error) {
-	lm.input.Reset(passphrase)
-	k, err := lm.kr.Key(keyName)
+	keyName, err := lm.es.GetEOTSKeyName(fpPk)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to load keyring record for key %s: %w", keyName, err)
+		return nil, err
 	}
 
-	eotsPk, err := loadBIP340PubKeyFromKeyringRecord(k)
+	// NOTE: the same hash is used by the proof-of-possession verification
+	babylonSigHash := sha256.Sum256(babylonSig)
+
+	return lm.signSchnorrSig(keyName, fpPk, babylonSigHash[:], passphrase)
+}
+
+// signSchnorrSig signs a Schnorr signature using the key at the given name and updates metrics by the fpPk
+func (lm *LocalEOTSManager) signSchnorrSig(keyName string, fpPk []byte, msg []byte, passphrase string) (*schnorr.Signature, error) {
+	sig, err := lm.signer.SignSchnorrSig(keyName, msg, passphrase)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	privKey, err := eotsPrivKeyFromRecord(k)
+	// Update metrics
+	lm.metrics.IncrementEotsFpTotalSchnorrSignCounter(hex.EncodeToString(fpPk))
+	return sig, nil
+}
+
+func (lm *LocalEOTSManager) SignSchnorrSigFromKeyname(keyName, passphrase string, msg []byte) (*schnorr.Signature, *bbntypes.BIP340PubKey, error) {
+	eotsPk, err := lm.signer.PubKey(keyName)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	signature, err := lm.signSchnorrSigFromPrivKey(privKey, *eotsPk, msg)
+	signature, err := lm.signSchnorrSig(keyName, *eotsPk, msg, passphrase)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to schnorr sign: %w", err)
 	}
@@ -240,25 +273,23 @@ func (lm *LocalEOTSManager) SignSchnorrSigFromKeyname(keyName, passphrase string
 }
 
 func (lm *LocalEOTSManager) Close() error {
-	return nil
-}
-
-// getMasterRandPair returns a randomness pair generated based on the given finality provider key, chainID and height
-func (lm *LocalEOTSManager) getMasterRandPair(fpPk []byte, chainID []byte, passphrase string) (*eots.MasterSecretRand, *eots.MasterPublicRand, error) {
-	record, err := lm.KeyRecord(fpPk, passphrase)
-	if err != nil {
-		return nil, nil, err
-	}
-	return fpkeyring.GenerateMasterRandPair(record.PrivKey.Serialize(), chainID)
+	return lm.signer.Close()
 }
 
-// TODO: we ignore passPhrase in local implementation for now
+// KeyRecord returns the key record, which is only available if
+// the signer backend exposes the EOTS private keys
 func (lm *LocalEOTSManager) KeyRecord(fpPk []byte, passphrase string) (*eotstypes.KeyRecord, error) {
 	name, err := lm.es.GetEOTSKeyName(fpPk)
 	if err != nil {
 		return nil, err
 	}
-	privKey, err := lm.getEOTSPrivKey(fpPk, passphrase)
+
+	exporter, ok := lm.signer.(signer.PrivKeyExporter)
+	if !ok {
+		return nil, ErrPrivKeyNotExportable
+	}
+
+	privKey, err := exporter.PrivKey(name, passphrase)
 	if err != nil {
 		return nil, err
 	}
@@ -269,35 +300,49 @@ func (lm *LocalEOTSManager) KeyRecord(fpPk []byte, passphrase string) (*eotstype
 	}, nil
 }
 
-func (lm *LocalEOTSManager) getEOTSPrivKey(fpPk []byte, passphrase string) (*btcec.PrivateKey, error) {
-	keyName, err := lm.es.GetEOTSKeyName(fpPk)
-	if err != nil {
-		return nil, err
+// Unlock keeps the EOTS key and master secret randomness of the given finality
+// provider in memory until the key cache TTL is reached or the key is locked
+func (lm *LocalEOTSManager) Unlock(fpPk []byte, passphrase string) error {
+	keyCache, ok := lm.signer.(signer.KeyCache)
+	if !ok {
+		return ErrKeyCacheNotSupported
 	}
 
-	lm.input.Reset(passphrase)
-	k, err := lm.kr.Key(keyName)
+	name, err := lm.es.GetEOTSKeyName(fpPk)
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	if err := keyCache.Unlock(name, passphrase); err != nil {
+		return err
 	}
 
-	return eotsPrivKeyFromRecord(k)
+	lm.logger.Info("unlocked the EOTS key", zap.String("pk", hex.EncodeToString(fpPk)))
+
+	return nil
 }
 
-func eotsPrivKeyFromRecord(k *keyring.Record) (*btcec.PrivateKey, error) {
-	privKeyCached := k.GetLocal().PrivKey.GetCachedValue()
+// Lock wipes the EOTS key and master secret randomness of the given finality
+// provider from memory, or of all the finality providers if fpPk is empty
+func (lm *LocalEOTSManager) Lock(fpPk []byte) error {
+	keyCache, ok := lm.signer.(signer.KeyCache)
+	if !ok {
+		return ErrKeyCacheNotSupported
+	}
 
-	var privKey *btcec.PrivateKey
-	switch v := privKeyCached.(type) {
-	case *secp256k1.PrivKey:
-		privKey, _ = btcec.PrivKeyFromBytes(v.Key)
-		return privKey, nil
-	default:
-		return nil, fmt.Errorf("unsupported key type in keyring")
+	if len(fpPk) == 0 {
+		keyCache.LockAll()
+		lm.logger.Info("locked all the EOTS keys")
+		return nil
 	}
-}
 
-func (lm *LocalEOTSManager) keyExists(name string) bool {
-	_, err := lm.kr.Key(name)
-	return err == nil
+	name, err := lm.es.GetEOTSKeyName(fpPk)
+	if err != nil {
+		return err
+	}
+
+	keyCache.Lock(name)
+	lm.logger.Info("locked the EOTS key", zap.String("pk", hex.EncodeToString(fpPk)))
+
+	return nil
 }
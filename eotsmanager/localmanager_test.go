@@ -1,18 +1,25 @@
 package eotsmanager_test
 
 import (
+	"crypto/sha256"
+	"math"
 	"math/rand"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/babylonchain/babylon/crypto/eots"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	bbn "github.com/babylonchain/babylon/types"
 	"github.com/babylonchain/finality-provider/eotsmanager"
 	eotscfg "github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/eotsmanager/signer"
+	"github.com/babylonchain/finality-provider/eotsmanager/store"
 	"github.com/babylonchain/finality-provider/eotsmanager/types"
+	"github.com/babylonchain/finality-provider/rpcauth"
 	"github.com/babylonchain/finality-provider/testutil"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
@@ -82,11 +89,18 @@ func FuzzCreateMasterRandPair(f *testing.F) {
 		require.NoError(t, err)
 
 		chainID := datagen.GenRandomByteArray(r, 10)
+		version := types.MasterRandVersion(r.Intn(2) + 1)
 
-		mprStr, err := lm.CreateMasterRandPair(fpPk, chainID, passphrase)
+		mprStr, err := lm.CreateMasterRandPair(fpPk, chainID, version, passphrase)
 		require.NoError(t, err)
-		mpr, err := eots.NewMasterPublicRandFromBase58(mprStr)
+		require.Equal(t, version, types.MasterPubRandVersion(mprStr))
+
+		// the version of the chain cannot be changed once created
+		_, err = lm.CreateMasterRandPair(fpPk, chainID, 3-version, passphrase)
+		require.ErrorIs(t, err, store.ErrConflictingMasterRandVersion)
+		mprStr2, err := lm.CreateMasterRandPair(fpPk, chainID, version, passphrase)
 		require.NoError(t, err)
+		require.Equal(t, mprStr, mprStr2)
 
 		startHeight := datagen.RandomInt(r, 100)
 		num := r.Intn(10) + 1
@@ -101,10 +115,349 @@ func FuzzCreateMasterRandPair(f *testing.F) {
 			require.NotNil(t, sig)
 
 			// verify using the master public randomness and height
-			pr, err := mpr.DerivePubRand(uint32(height))
+			pr, err := types.DerivePubRand(mprStr, height)
 			require.NoError(t, err)
 			err = eots.Verify(fpBTCPK.MustToBTCPK(), pr, msg, sig)
 			require.NoError(t, err)
 		}
 	})
 }
+
+// FuzzRemoteSigner tests the EOTS manager backed by the reference
+// software signer plugin over the gRPC plugin protocol
+func FuzzRemoteSigner(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		fpName := testutil.GenRandomHexStr(r, 4)
+		homeDir := filepath.Join(t.TempDir(), "eots-home")
+		eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+		dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			dbBackend.Close()
+			err := os.RemoveAll(homeDir)
+			require.NoError(t, err)
+		}()
+
+		// the software signer keeps the keys in a separate keyring
+		softwareBackend, err := signer.NewSoftwareBackend(filepath.Join(t.TempDir(), "signer-home"), eotsCfg.KeyringBackend, 0)
+		require.NoError(t, err)
+		plugin, err := signer.StartPlugin(softwareBackend, "127.0.0.1:0", rpcauth.DefaultServerConfig())
+		require.NoError(t, err)
+		defer plugin.Stop()
+
+		remoteBackend, err := signer.NewGRPCBackend(plugin.Address(), 10*time.Second, rpcauth.DefaultClientConfig())
+		require.NoError(t, err)
+		lm, err := eotsmanager.NewLocalEOTSManagerWithSigner(remoteBackend, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+		defer func() {
+			err := lm.Close()
+			require.NoError(t, err)
+		}()
+
+		fpPk, err := lm.CreateKey(fpName, passphrase, hdPath)
+		require.NoError(t, err)
+		fpBTCPK, err := bbn.NewBIP340PubKey(fpPk)
+		require.NoError(t, err)
+		pkFromSigner, err := softwareBackend.PubKey(fpName)
+		require.NoError(t, err)
+		require.True(t, fpBTCPK.Equals(pkFromSigner))
+
+		// the private key is held by the signer only
+		_, err = lm.KeyRecord(fpPk, passphrase)
+		require.ErrorIs(t, err, eotsmanager.ErrPrivKeyNotExportable)
+
+		chainID := datagen.GenRandomByteArray(r, 10)
+		version := types.MasterRandVersion(r.Intn(2) + 1)
+		mprStr, err := lm.CreateMasterRandPair(fpPk, chainID, version, passphrase)
+		require.NoError(t, err)
+		require.Equal(t, version, types.MasterPubRandVersion(mprStr))
+
+		height := datagen.RandomInt(r, 100)
+		msg := datagen.GenRandomByteArray(r, 32)
+		sig, err := lm.SignEOTS(fpPk, chainID, msg, height, passphrase)
+		require.NoError(t, err)
+		pr, err := types.DerivePubRand(mprStr, height)
+		require.NoError(t, err)
+		err = eots.Verify(fpBTCPK.MustToBTCPK(), pr, msg, sig)
+		require.NoError(t, err)
+
+		// double signing is refused before reaching the signer
+		_, err = lm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), height, passphrase)
+		var doubleSignErr *types.DoubleSignError
+		require.ErrorAs(t, err, &doubleSignErr)
+
+		schnorrSig, err := lm.SignSchnorrSig(fpPk, msg, passphrase)
+		require.NoError(t, err)
+		require.True(t, schnorrSig.Verify(msg, fpBTCPK.MustToBTCPK()))
+	})
+}
+
+// TestRemoteSignerIncorrectPassphrase tests that an incorrect passphrase is told
+// apart from the other errors of the signer across the gRPC connection
+func TestRemoteSignerIncorrectPassphrase(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	fpName := testutil.GenRandomHexStr(r, 4)
+	signerHome := filepath.Join(t.TempDir(), "signer-home")
+
+	// the EOTS key is encrypted with the passphrase in the file keyring
+	softwareBackend, err := signer.NewSoftwareBackend(signerHome, keyring.BackendFile, 0)
+	require.NoError(t, err)
+	_, err = softwareBackend.CreateKey(fpName, passphrase, hdPath, "")
+	require.NoError(t, err)
+
+	// the keyring is reopened as it keeps the passphrase once the key is created
+	softwareBackend, err = signer.NewSoftwareBackend(signerHome, keyring.BackendFile, 0)
+	require.NoError(t, err)
+	plugin, err := signer.StartPlugin(softwareBackend, "127.0.0.1:0", rpcauth.DefaultServerConfig())
+	require.NoError(t, err)
+	defer plugin.Stop()
+	remoteBackend, err := signer.NewGRPCBackend(plugin.Address(), 10*time.Second, rpcauth.DefaultClientConfig())
+	require.NoError(t, err)
+	defer remoteBackend.Close()
+
+	chainID := datagen.GenRandomByteArray(r, 10)
+	_, err = remoteBackend.MasterPublicRand(fpName, chainID, types.MasterRandV1, passphrase+"wrong")
+	require.ErrorIs(t, err, types.ErrIncorrectPassphrase)
+
+	// a missing key is not mistaken for an incorrect passphrase
+	_, err = remoteBackend.MasterPublicRand(fpName+"missing", chainID, types.MasterRandV1, passphrase)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, types.ErrIncorrectPassphrase)
+
+	_, err = remoteBackend.MasterPublicRand(fpName, chainID, types.MasterRandV1, passphrase)
+	require.NoError(t, err)
+}
+
+// TestRemoteSignerRequiresTLS tests that the passphrases and mnemonics are not
+// sent in plaintext to a remote signer which is not on a loopback address
+func TestRemoteSignerRequiresTLS(t *testing.T) {
+	remoteAddr := "192.0.2.1:12583"
+
+	_, err := signer.NewGRPCBackend(remoteAddr, time.Second, rpcauth.DefaultClientConfig())
+	require.ErrorContains(t, err, "requires TLS")
+	_, err = signer.NewGRPCBackend(remoteAddr, time.Second, nil)
+	require.ErrorContains(t, err, "requires TLS")
+
+	cfg := eotscfg.DefaultConfigWithHomePath(t.TempDir())
+	cfg.Signer.Backend = eotscfg.SignerBackendRemote
+	cfg.Signer.RemoteAddress = remoteAddr
+	require.Error(t, cfg.Validate())
+
+	cfg.SignerAuth.TLSCACertPath = filepath.Join(t.TempDir(), "ca.crt")
+	require.NoError(t, cfg.Validate())
+
+	// a signer on a loopback address can be reached without TLS
+	cfg.SignerAuth.TLSCACertPath = ""
+	cfg.Signer.RemoteAddress = "127.0.0.1:12583"
+	require.NoError(t, cfg.Validate())
+}
+
+// FuzzKeyCache tests the EOTS manager keeping the unlocked EOTS keys
+// and master secret randomness in the cache of the software signer
+func FuzzKeyCache(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		fpName := testutil.GenRandomHexStr(r, 4)
+		homeDir := filepath.Join(t.TempDir(), "eots-home")
+		eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+		dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			dbBackend.Close()
+			err := os.RemoveAll(homeDir)
+			require.NoError(t, err)
+		}()
+
+		softwareBackend, err := signer.NewSoftwareBackend(homeDir, eotsCfg.KeyringBackend, eotsCfg.Signer.KeyCacheTTL)
+		require.NoError(t, err)
+		lm, err := eotsmanager.NewLocalEOTSManagerWithSigner(softwareBackend, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+		defer func() {
+			err := lm.Close()
+			require.NoError(t, err)
+		}()
+
+		fpPk, err := lm.CreateKey(fpName, passphrase, hdPath)
+		require.NoError(t, err)
+		fpBTCPK, err := bbn.NewBIP340PubKey(fpPk)
+		require.NoError(t, err)
+		err = lm.Unlock(fpPk, passphrase)
+		require.NoError(t, err)
+
+		chainID := datagen.GenRandomByteArray(r, 10)
+		mprStr, err := lm.CreateMasterRandPair(fpPk, chainID, types.MasterRandVersion(r.Intn(2)+1), passphrase)
+		require.NoError(t, err)
+
+		// sign a batch of heights with the cached key, locking it in the middle
+		startHeight := datagen.RandomInt(r, 100)
+		numHeights := datagen.RandomInt(r, 10) + 2
+		lockHeight := startHeight + datagen.RandomInt(r, int(numHeights))
+		for height := startHeight; height < startHeight+numHeights; height++ {
+			if height == lockHeight {
+				err := lm.Lock(fpPk)
+				require.NoError(t, err)
+			}
+
+			msg := datagen.GenRandomByteArray(r, 32)
+			sig, err := lm.SignEOTS(fpPk, chainID, msg, height, passphrase)
+			require.NoError(t, err)
+			pr, err := types.DerivePubRand(mprStr, height)
+			require.NoError(t, err)
+			err = eots.Verify(fpBTCPK.MustToBTCPK(), pr, msg, sig)
+			require.NoError(t, err)
+		}
+
+		// the exported private key is not wiped by locking the cached key
+		record, err := lm.KeyRecord(fpPk, passphrase)
+		require.NoError(t, err)
+		err = lm.Lock(nil)
+		require.NoError(t, err)
+		require.True(t, fpBTCPK.Equals(bbn.NewBIP340PubKeyFromBTCPK(record.PrivKey.PubKey())))
+
+		// unknown finality providers cannot be unlocked or locked
+		unknownPk := datagen.GenRandomByteArray(r, 32)
+		require.Error(t, lm.Unlock(unknownPk, passphrase))
+		require.Error(t, lm.Lock(unknownPk))
+
+		// the key cannot be explicitly unlocked if the cache is disabled
+		uncachedLm, err := eotsmanager.NewLocalEOTSManager(homeDir, eotsCfg.KeyringBackend, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+		err = uncachedLm.Unlock(fpPk, passphrase)
+		require.ErrorIs(t, err, signer.ErrKeyCacheDisabled)
+	})
+}
+
+// FuzzSignEOTSDoubleSign tests that the EOTS manager refuses to sign
+// a different message at a height that has been signed before
+func FuzzSignEOTSDoubleSign(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		fpName := testutil.GenRandomHexStr(r, 4)
+		homeDir := filepath.Join(t.TempDir(), "eots-home")
+		eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+		dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+		defer func() {
+			dbBackend.Close()
+			err := os.RemoveAll(homeDir)
+			require.NoError(t, err)
+		}()
+		require.NoError(t, err)
+		lm, err := eotsmanager.NewLocalEOTSManager(homeDir, eotsCfg.KeyringBackend, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+
+		fpPk, err := lm.CreateKey(fpName, passphrase, hdPath)
+		require.NoError(t, err)
+
+		chainID := datagen.GenRandomByteArray(r, 10)
+		height := datagen.RandomInt(r, 100)
+		msg := datagen.GenRandomByteArray(r, 32)
+
+		sig, err := lm.SignEOTS(fpPk, chainID, msg, height, passphrase)
+		require.NoError(t, err)
+
+		// signing the same message again returns the same signature
+		sig2, err := lm.SignEOTS(fpPk, chainID, msg, height, passphrase)
+		require.NoError(t, err)
+		require.True(t, sig.Equals(sig2))
+
+		// signing a different message at the same height is refused
+		_, err = lm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), height, passphrase)
+		var doubleSignErr *types.DoubleSignError
+		require.ErrorAs(t, err, &doubleSignErr)
+		require.Equal(t, height, doubleSignErr.Height)
+
+		// signing a different message at another height is allowed
+		_, err = lm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), height+1, passphrase)
+		require.NoError(t, err)
+
+		// heights imported from another host are protected as well
+		es, err := store.NewEOTSStore(dbBackend)
+		require.NoError(t, err)
+		importedMsg := datagen.GenRandomByteArray(r, 32)
+		importedMsgHash := sha256.Sum256(importedMsg)
+		_, err = es.MergeSigningHistory([]*store.SigningHistoryEntry{{
+			FpPk:    fpPk,
+			ChainID: chainID,
+			Height:  height + 2,
+			MsgHash: importedMsgHash[:],
+		}})
+		require.NoError(t, err)
+		_, err = lm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), height+2, passphrase)
+		require.ErrorAs(t, err, &doubleSignErr)
+		importedSig, err := lm.SignEOTS(fpPk, chainID, importedMsg, height+2, passphrase)
+		require.NoError(t, err)
+		require.False(t, importedSig.IsZero())
+	})
+}
+
+// FuzzSignEOTSHeightBound tests that the EOTS manager refuses to sign
+// above the heights the randomness can be derived at
+func FuzzSignEOTSHeightBound(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		fpName := testutil.GenRandomHexStr(r, 4)
+		homeDir := filepath.Join(t.TempDir(), "eots-home")
+		eotsCfg := eotscfg.DefaultConfigWithHomePath(homeDir)
+		dbBackend, err := eotsCfg.DatabaseConfig.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			dbBackend.Close()
+			err := os.RemoveAll(homeDir)
+			require.NoError(t, err)
+		}()
+		lm, err := eotsmanager.NewLocalEOTSManager(homeDir, eotsCfg.KeyringBackend, dbBackend, zap.NewNop())
+		require.NoError(t, err)
+
+		fpPk, err := lm.CreateKey(fpName, passphrase, hdPath)
+		require.NoError(t, err)
+		fpBTCPK, err := bbn.NewBIP340PubKey(fpPk)
+		require.NoError(t, err)
+
+		// the chain without a recorded version, e.g., registered before the versions,
+		// signs with v1 up to the highest 32-bit height
+		chainID := datagen.GenRandomByteArray(r, 10)
+		_, err = lm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), types.MaxEOTSHeightV1, passphrase)
+		require.NoError(t, err)
+
+		// a height above it would reuse the randomness of the height it wraps around to
+		wrappedHeight := datagen.RandomInt(r, 100)
+		_, err = lm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), wrappedHeight, passphrase)
+		require.NoError(t, err)
+		_, err = lm.SignEOTS(fpPk, chainID, datagen.GenRandomByteArray(r, 32), types.MaxEOTSHeightV1+1+wrappedHeight, passphrase)
+		require.ErrorIs(t, err, types.ErrHeightOutOfRange)
+
+		// the refused height is not recorded in the signing history
+		es, err := store.NewEOTSStore(dbBackend)
+		require.NoError(t, err)
+		_, err = es.GetSigningRecord(fpPk, chainID, types.MaxEOTSHeightV1+1+wrappedHeight)
+		require.ErrorIs(t, err, store.ErrSigningRecordNotFound)
+
+		// the chain of v2 signs above the 32-bit heights with the randomness
+		// which is not reused by the height it would wrap around to
+		chainIDV2 := datagen.GenRandomByteArray(r, 10)
+		mprStr, err := lm.CreateMasterRandPair(fpPk, chainIDV2, types.MasterRandV2, passphrase)
+		require.NoError(t, err)
+		for _, height := range []uint64{wrappedHeight, types.MaxEOTSHeightV1 + 1 + wrappedHeight, math.MaxUint64} {
+			msg := datagen.GenRandomByteArray(r, 32)
+			sig, err := lm.SignEOTS(fpPk, chainIDV2, msg, height, passphrase)
+			require.NoError(t, err)
+			pr, err := types.DerivePubRand(mprStr, height)
+			require.NoError(t, err)
+			require.NoError(t, eots.Verify(fpBTCPK.MustToBTCPK(), pr, msg, sig))
+		}
+		pr, err := types.DerivePubRand(mprStr, wrappedHeight)
+		require.NoError(t, err)
+		wrappedPr, err := types.DerivePubRand(mprStr, types.MaxEOTSHeightV1+1+wrappedHeight)
+		require.NoError(t, err)
+		require.False(t, pr.Equals(wrappedPr))
+	})
+}
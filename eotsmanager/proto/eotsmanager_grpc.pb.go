@@ -25,6 +25,9 @@ const (
 	EOTSManager_KeyRecord_FullMethodName            = "/proto.EOTSManager/KeyRecord"
 	EOTSManager_SignEOTS_FullMethodName             = "/proto.EOTSManager/SignEOTS"
 	EOTSManager_SignSchnorrSig_FullMethodName       = "/proto.EOTSManager/SignSchnorrSig"
+	EOTSManager_SignPop_FullMethodName              = "/proto.EOTSManager/SignPop"
+	EOTSManager_Unlock_FullMethodName               = "/proto.EOTSManager/Unlock"
+	EOTSManager_Lock_FullMethodName                 = "/proto.EOTSManager/Lock"
 )
 
 // EOTSManagerClient is the client API for EOTSManager service.
@@ -42,6 +45,12 @@ type EOTSManagerClient interface {
 	SignEOTS(ctx context.Context, in *SignEOTSRequest, opts ...grpc.CallOption) (*SignEOTSResponse, error)
 	// SignSchnorrSig signs a Schnorr sig with the EOTS private key
 	SignSchnorrSig(ctx context.Context, in *SignSchnorrSigRequest, opts ...grpc.CallOption) (*SignSchnorrSigResponse, error)
+	// SignPop signs the BTC part of a proof-of-possession with the EOTS private key
+	SignPop(ctx context.Context, in *SignPopRequest, opts ...grpc.CallOption) (*SignPopResponse, error)
+	// Unlock keeps the EOTS private key and master secret randomness in memory
+	Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error)
+	// Lock wipes the EOTS private key and master secret randomness from memory
+	Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error)
 }
 
 type eOTSManagerClient struct {
@@ -106,6 +115,33 @@ func (c *eOTSManagerClient) SignSchnorrSig(ctx context.Context, in *SignSchnorrS
 	return out, nil
 }
 
+func (c *eOTSManagerClient) SignPop(ctx context.Context, in *SignPopRequest, opts ...grpc.CallOption) (*SignPopResponse, error) {
+	out := new(SignPopResponse)
+	err := c.cc.Invoke(ctx, EOTSManager_SignPop_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSManagerClient) Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error) {
+	out := new(UnlockResponse)
+	err := c.cc.Invoke(ctx, EOTSManager_Unlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSManagerClient) Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error) {
+	out := new(LockResponse)
+	err := c.cc.Invoke(ctx, EOTSManager_Lock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EOTSManagerServer is the server API for EOTSManager service.
 // All implementations must embed UnimplementedEOTSManagerServer
 // for forward compatibility
@@ -121,6 +157,12 @@ type EOTSManagerServer interface {
 	SignEOTS(context.Context, *SignEOTSRequest) (*SignEOTSResponse, error)
 	// SignSchnorrSig signs a Schnorr sig with the EOTS private key
 	SignSchnorrSig(context.Context, *SignSchnorrSigRequest) (*SignSchnorrSigResponse, error)
+	// SignPop signs the BTC part of a proof-of-possession with the EOTS private key
+	SignPop(context.Context, *SignPopRequest) (*SignPopResponse, error)
+	// Unlock keeps the EOTS private key and master secret randomness in memory
+	Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error)
+	// Lock wipes the EOTS private key and master secret randomness from memory
+	Lock(context.Context, *LockRequest) (*LockResponse, error)
 	mustEmbedUnimplementedEOTSManagerServer()
 }
 
@@ -146,6 +188,15 @@ func (UnimplementedEOTSManagerServer) SignEOTS(context.Context, *SignEOTSRequest
 func (UnimplementedEOTSManagerServer) SignSchnorrSig(context.Context, *SignSchnorrSigRequest) (*SignSchnorrSigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignSchnorrSig not implemented")
 }
+func (UnimplementedEOTSManagerServer) SignPop(context.Context, *SignPopRequest) (*SignPopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignPop not implemented")
+}
+func (UnimplementedEOTSManagerServer) Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+}
+func (UnimplementedEOTSManagerServer) Lock(context.Context, *LockRequest) (*LockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
 func (UnimplementedEOTSManagerServer) mustEmbedUnimplementedEOTSManagerServer() {}
 
 // UnsafeEOTSManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -267,6 +318,60 @@ func _EOTSManager_SignSchnorrSig_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EOTSManager_SignPop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignPopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).SignPop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSManager_SignPop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).SignPop(ctx, req.(*SignPopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSManager_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSManager_Unlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).Unlock(ctx, req.(*UnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSManager_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSManagerServer).Lock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSManager_Lock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSManagerServer).Lock(ctx, req.(*LockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EOTSManager_ServiceDesc is the grpc.ServiceDesc for EOTSManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -298,6 +403,18 @@ var EOTSManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SignSchnorrSig",
 			Handler:    _EOTSManager_SignSchnorrSig_Handler,
 		},
+		{
+			MethodName: "SignPop",
+			Handler:    _EOTSManager_SignPop_Handler,
+		},
+		{
+			MethodName: "Unlock",
+			Handler:    _EOTSManager_Unlock_Handler,
+		},
+		{
+			MethodName: "Lock",
+			Handler:    _EOTSManager_Lock_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eotsmanager.proto",
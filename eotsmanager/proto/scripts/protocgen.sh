@@ -7,4 +7,9 @@ buf mod update
 buf generate .
 cd ..
 
+cd signer/proto
+buf mod update
+buf generate .
+cd ../..
+
 go mod tidy -compat=1.20
@@ -4,9 +4,12 @@ import (
 	"context"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/babylonchain/finality-provider/eotsmanager"
 	"github.com/babylonchain/finality-provider/eotsmanager/proto"
+	"github.com/babylonchain/finality-provider/eotsmanager/types"
 )
 
 // rpcServer is the main RPC server for the EOTS daemon that handles
@@ -15,15 +18,21 @@ type rpcServer struct {
 	proto.UnimplementedEOTSManagerServer
 
 	em eotsmanager.EOTSManager
+
+	// disableKeyRecord refuses the KeyRecord requests
+	// so that the private keys cannot be exported
+	disableKeyRecord bool
 }
 
 // newRPCServer creates a new RPC sever from the set of input dependencies.
 func newRPCServer(
 	em eotsmanager.EOTSManager,
+	disableKeyRecord bool,
 ) *rpcServer {
 
 	return &rpcServer{
-		em: em,
+		em:               em,
+		disableKeyRecord: disableKeyRecord,
 	}
 }
 
@@ -55,7 +64,7 @@ func (r *rpcServer) CreateKey(ctx context.Context, req *proto.CreateKeyRequest)
 // CreateMasterRandPair returns a list of Schnorr randomness pairs
 func (r *rpcServer) CreateMasterRandPair(ctx context.Context, req *proto.CreateMasterRandPairRequest) (*proto.CreateMasterRandPairResponse, error) {
 
-	mpr, err := r.em.CreateMasterRandPair(req.Uid, req.ChainId, req.Passphrase)
+	mpr, err := r.em.CreateMasterRandPair(req.Uid, req.ChainId, types.RequestedMasterRandVersion(req.Version), req.Passphrase)
 	if err != nil {
 		return nil, err
 	}
@@ -69,6 +78,10 @@ func (r *rpcServer) CreateMasterRandPair(ctx context.Context, req *proto.CreateM
 func (r *rpcServer) KeyRecord(ctx context.Context, req *proto.KeyRecordRequest) (
 	*proto.KeyRecordResponse, error) {
 
+	if r.disableKeyRecord {
+		return nil, status.Error(codes.PermissionDenied, "the KeyRecord RPC is disabled")
+	}
+
 	record, err := r.em.KeyRecord(req.Uid, req.Passphrase)
 	if err != nil {
 		return nil, err
@@ -107,3 +120,37 @@ func (r *rpcServer) SignSchnorrSig(ctx context.Context, req *proto.SignSchnorrSi
 
 	return &proto.SignSchnorrSigResponse{Sig: sig.Serialize()}, nil
 }
+
+// SignPop signs the BTC part of a proof-of-possession with the EOTS private key
+func (r *rpcServer) SignPop(ctx context.Context, req *proto.SignPopRequest) (
+	*proto.SignPopResponse, error) {
+
+	sig, err := r.em.SignPop(req.Uid, req.BabylonSig, req.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.SignPopResponse{BtcSig: sig.Serialize()}, nil
+}
+
+// Unlock keeps the EOTS private key and master secret randomness in memory
+func (r *rpcServer) Unlock(ctx context.Context, req *proto.UnlockRequest) (
+	*proto.UnlockResponse, error) {
+
+	if err := r.em.Unlock(req.Uid, req.Passphrase); err != nil {
+		return nil, err
+	}
+
+	return &proto.UnlockResponse{}, nil
+}
+
+// Lock wipes the EOTS private key and master secret randomness from memory
+func (r *rpcServer) Lock(ctx context.Context, req *proto.LockRequest) (
+	*proto.LockResponse, error) {
+
+	if err := r.em.Lock(req.Uid); err != nil {
+		return nil, err
+	}
+
+	return &proto.LockResponse{}, nil
+}
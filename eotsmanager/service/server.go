@@ -8,6 +8,7 @@ import (
 	"sync/atomic"
 
 	"github.com/babylonchain/finality-provider/metrics"
+	"github.com/babylonchain/finality-provider/rpcauth"
 
 	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/signal"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/babylonchain/finality-provider/eotsmanager"
 	"github.com/babylonchain/finality-provider/eotsmanager/config"
+	"github.com/babylonchain/finality-provider/eotsmanager/types"
 )
 
 // Server is the main daemon construct for the EOTS manager server. It handles
@@ -39,7 +41,7 @@ func NewEOTSManagerServer(cfg *config.Config, l *zap.Logger, em eotsmanager.EOTS
 	return &Server{
 		cfg:         cfg,
 		logger:      l,
-		rpcServer:   newRPCServer(em),
+		rpcServer:   newRPCServer(em, cfg.DisableKeyRecord),
 		db:          db,
 		interceptor: sig,
 		quit:        make(chan struct{}, 1),
@@ -81,7 +83,13 @@ func (s *Server) RunUntilShutdown() error {
 	}
 	defer lis.Close()
 
-	grpcServer := grpc.NewServer()
+	grpcOpts, err := rpcauth.ServerOptions(s.cfg.RPCAuth)
+	if err != nil {
+		return fmt.Errorf("failed to set up the RPC server security: %w", err)
+	}
+
+	grpcOpts = append(grpcOpts, types.StatusErrorServerInterceptor())
+	grpcServer := grpc.NewServer(grpcOpts...)
 	defer grpcServer.Stop()
 
 	if err := s.rpcServer.RegisterWithGrpcServer(grpcServer); err != nil {
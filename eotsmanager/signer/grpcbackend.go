@@ -0,0 +1,172 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	bbntypes "github.com/babylonchain/babylon/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"google.golang.org/grpc"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/signer/proto"
+	eotstypes "github.com/babylonchain/finality-provider/eotsmanager/types"
+	"github.com/babylonchain/finality-provider/rpcauth"
+)
+
+var _ Backend = &GRPCBackend{}
+
+// GRPCBackend is a signer backend which forwards the operations to an
+// external signer plugin over the EOTSSigner gRPC protocol
+type GRPCBackend struct {
+	client  proto.EOTSSignerClient
+	conn    *grpc.ClientConn
+	timeout time.Duration
+}
+
+// NewGRPCBackend connects to the signer plugin at the given address following the given
+// TLS and authentication config. As the passphrases and mnemonics are sent to the plugin,
+// the connection to a plugin which is not on a loopback address requires TLS
+func NewGRPCBackend(remoteAddr string, timeout time.Duration, authCfg *rpcauth.ClientConfig) (*GRPCBackend, error) {
+	if (authCfg == nil || !authCfg.TLSEnabled()) && !rpcauth.IsLoopbackAddress(remoteAddr) {
+		return nil, fmt.Errorf("the connection to the signer plugin at %s requires TLS as it is not a loopback address", remoteAddr)
+	}
+
+	opts, err := rpcauth.DialOptions(authCfg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid auth config of the signer plugin: %w", err)
+	}
+
+	opts = append(opts, eotstypes.StatusErrorClientInterceptor())
+	conn, err := grpc.Dial(remoteAddr, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build gRPC connection to %s: %w", remoteAddr, err)
+	}
+
+	gb := &GRPCBackend{
+		client:  proto.NewEOTSSignerClient(conn),
+		conn:    conn,
+		timeout: timeout,
+	}
+
+	if err := gb.Ping(); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("the signer plugin is not responding: %w", err)
+	}
+
+	return gb, nil
+}
+
+func (gb *GRPCBackend) Ping() error {
+	ctx, cancel := gb.newContext()
+	defer cancel()
+
+	_, err := gb.client.Ping(ctx, &proto.PingRequest{})
+
+	return err
+}
+
+func (gb *GRPCBackend) CreateKey(name, passphrase, hdPath, mnemonic string) (*bbntypes.BIP340PubKey, error) {
+	ctx, cancel := gb.newContext()
+	defer cancel()
+
+	req := &proto.CreateKeyRequest{
+		KeyName:    name,
+		Passphrase: passphrase,
+		HdPath:     hdPath,
+		Mnemonic:   mnemonic,
+	}
+	res, err := gb.client.CreateKey(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return bbntypes.NewBIP340PubKey(res.Pk)
+}
+
+func (gb *GRPCBackend) PubKey(name string) (*bbntypes.BIP340PubKey, error) {
+	ctx, cancel := gb.newContext()
+	defer cancel()
+
+	res, err := gb.client.PubKey(ctx, &proto.PubKeyRequest{KeyName: name})
+	if err != nil {
+		return nil, err
+	}
+
+	return bbntypes.NewBIP340PubKey(res.Pk)
+}
+
+func (gb *GRPCBackend) MasterPublicRand(name string, chainID []byte, version eotstypes.MasterRandVersion, passphrase string) (string, error) {
+	ctx, cancel := gb.newContext()
+	defer cancel()
+
+	req := &proto.MasterPublicRandRequest{
+		KeyName:    name,
+		ChainId:    chainID,
+		Passphrase: passphrase,
+		Version:    uint32(version),
+	}
+	res, err := gb.client.MasterPublicRand(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	// the plugins predating the versions ignore the requested
+	// version and return the v1 master public randomness
+	if returned := eotstypes.MasterPubRandVersion(res.MasterPubRand); returned != version {
+		return "", fmt.Errorf("the signer plugin returned the master public randomness of v%d instead of v%d", returned, version)
+	}
+
+	return res.MasterPubRand, nil
+}
+
+func (gb *GRPCBackend) SignEOTS(name string, chainID []byte, msg []byte, height uint64, version eotstypes.MasterRandVersion, passphrase string) (*btcec.ModNScalar, error) {
+	ctx, cancel := gb.newContext()
+	defer cancel()
+
+	req := &proto.SignEOTSRequest{
+		KeyName:    name,
+		ChainId:    chainID,
+		Msg:        msg,
+		Height:     height,
+		Passphrase: passphrase,
+		Version:    uint32(version),
+	}
+	res, err := gb.client.SignEOTS(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var s btcec.ModNScalar
+	if overflow := s.SetByteSlice(res.Sig); overflow {
+		return nil, fmt.Errorf("invalid EOTS signature returned by the signer plugin")
+	}
+
+	return &s, nil
+}
+
+func (gb *GRPCBackend) SignSchnorrSig(name string, msg []byte, passphrase string) (*schnorr.Signature, error) {
+	ctx, cancel := gb.newContext()
+	defer cancel()
+
+	req := &proto.SignSchnorrSigRequest{
+		KeyName:    name,
+		Msg:        msg,
+		Passphrase: passphrase,
+	}
+	res, err := gb.client.SignSchnorrSig(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return schnorr.ParseSignature(res.Sig)
+}
+
+func (gb *GRPCBackend) Close() error {
+	return gb.conn.Close()
+}
+
+func (gb *GRPCBackend) newContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), gb.timeout)
+}
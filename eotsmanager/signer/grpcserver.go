@@ -0,0 +1,147 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"google.golang.org/grpc"
+
+	"github.com/babylonchain/finality-provider/eotsmanager/signer/proto"
+	eotstypes "github.com/babylonchain/finality-provider/eotsmanager/types"
+	"github.com/babylonchain/finality-provider/rpcauth"
+)
+
+// PluginServer serves a signer backend over the EOTSSigner gRPC protocol,
+// which turns any backend into a signer plugin reachable by GRPCBackend
+type PluginServer struct {
+	proto.UnimplementedEOTSSignerServer
+
+	backend Backend
+}
+
+func NewPluginServer(backend Backend) *PluginServer {
+	return &PluginServer{
+		backend: backend,
+	}
+}
+
+// RegisterWithGrpcServer registers the PluginServer with the passed root gRPC
+// server.
+func (s *PluginServer) RegisterWithGrpcServer(grpcServer *grpc.Server) error {
+	proto.RegisterEOTSSignerServer(grpcServer, s)
+	return nil
+}
+
+// Plugin runs a signer backend as an in-process signer plugin, e.g., the
+// software backend as the reference plugin
+type Plugin struct {
+	grpcServer *grpc.Server
+	listener   net.Listener
+}
+
+// StartPlugin serves the given signer backend at the given address following
+// the given TLS and authentication config until the returned plugin is stopped
+func StartPlugin(backend Backend, listenAddr string, authCfg *rpcauth.ServerConfig) (*Plugin, error) {
+	opts, err := rpcauth.ServerOptions(authCfg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid auth config of the signer plugin: %w", err)
+	}
+
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", listenAddr, err)
+	}
+
+	opts = append(opts, eotstypes.StatusErrorServerInterceptor())
+	grpcServer := grpc.NewServer(opts...)
+	if err := NewPluginServer(backend).RegisterWithGrpcServer(grpcServer); err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("failed to register the signer plugin server: %w", err)
+	}
+
+	go func() {
+		// the error is returned once the plugin is stopped
+		_ = grpcServer.Serve(listener)
+	}()
+
+	return &Plugin{
+		grpcServer: grpcServer,
+		listener:   listener,
+	}, nil
+}
+
+// Address returns the address the plugin listens to
+func (p *Plugin) Address() string {
+	return p.listener.Addr().String()
+}
+
+func (p *Plugin) Stop() {
+	p.grpcServer.Stop()
+}
+
+func (s *PluginServer) Ping(ctx context.Context, req *proto.PingRequest) (*proto.PingResponse, error) {
+	return &proto.PingResponse{}, nil
+}
+
+// CreateKey generates an EOTS key and keeps it in the signer
+func (s *PluginServer) CreateKey(ctx context.Context, req *proto.CreateKeyRequest) (
+	*proto.CreateKeyResponse, error) {
+
+	pk, err := s.backend.CreateKey(req.KeyName, req.Passphrase, req.HdPath, req.Mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.CreateKeyResponse{Pk: pk.MustMarshal()}, nil
+}
+
+// PubKey returns the public key of an EOTS key
+func (s *PluginServer) PubKey(ctx context.Context, req *proto.PubKeyRequest) (
+	*proto.PubKeyResponse, error) {
+
+	pk, err := s.backend.PubKey(req.KeyName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.PubKeyResponse{Pk: pk.MustMarshal()}, nil
+}
+
+// MasterPublicRand returns the master public randomness of an EOTS key for a consumer chain
+func (s *PluginServer) MasterPublicRand(ctx context.Context, req *proto.MasterPublicRandRequest) (
+	*proto.MasterPublicRandResponse, error) {
+
+	mpr, err := s.backend.MasterPublicRand(req.KeyName, req.ChainId, eotstypes.RequestedMasterRandVersion(req.Version), req.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.MasterPublicRandResponse{MasterPubRand: mpr}, nil
+}
+
+// SignEOTS signs an EOTS with an EOTS key and the secret randomness
+// derived for a consumer chain at a height
+func (s *PluginServer) SignEOTS(ctx context.Context, req *proto.SignEOTSRequest) (
+	*proto.SignEOTSResponse, error) {
+
+	sig, err := s.backend.SignEOTS(req.KeyName, req.ChainId, req.Msg, req.Height, eotstypes.RequestedMasterRandVersion(req.Version), req.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	sigBytes := sig.Bytes()
+	return &proto.SignEOTSResponse{Sig: sigBytes[:]}, nil
+}
+
+// SignSchnorrSig signs a Schnorr sig with an EOTS key
+func (s *PluginServer) SignSchnorrSig(ctx context.Context, req *proto.SignSchnorrSigRequest) (
+	*proto.SignSchnorrSigResponse, error) {
+
+	sig, err := s.backend.SignSchnorrSig(req.KeyName, req.Msg, req.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.SignSchnorrSigResponse{Sig: sig.Serialize()}, nil
+}
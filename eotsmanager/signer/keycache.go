@@ -0,0 +1,223 @@
+package signer
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/babylonchain/babylon/crypto/eots"
+	"github.com/btcsuite/btcd/btcec/v2"
+
+	eotstypes "github.com/babylonchain/finality-provider/eotsmanager/types"
+	fpkeyring "github.com/babylonchain/finality-provider/keyring"
+)
+
+// ErrKeyCacheDisabled is returned when a key is explicitly unlocked
+// but the key cache is disabled
+var ErrKeyCacheDisabled = errors.New("the key cache is disabled")
+
+// unlockedKey is an EOTS private key loaded from the keyring together with
+// the master randomness pairs derived from it
+type unlockedKey struct {
+	privKey *btcec.PrivateKey
+	// randPairs are the master randomness pairs indexed by chain ID
+	randPairs map[string]*masterRandPair
+	// expiry wipes the key once the cache TTL is reached
+	expiry *time.Timer
+}
+
+type masterRandPair struct {
+	msr *eots.MasterSecretRand
+	mpr *eots.MasterPublicRand
+	// msrV2 and mprV2 are only derived on the first use of v2
+	msrV2 *eotstypes.MasterSecretRandV2
+	mprV2 *eotstypes.MasterPublicRandV2
+}
+
+// masterRandPair returns the master randomness pair of the given chain,
+// which is only derived on the first use
+func (k *unlockedKey) masterRandPair(chainID []byte) (*masterRandPair, error) {
+	if pair, ok := k.randPairs[string(chainID)]; ok {
+		return pair, nil
+	}
+
+	msr, mpr, err := fpkeyring.GenerateMasterRandPair(k.privKey.Serialize(), chainID)
+	if err != nil {
+		return nil, err
+	}
+	pair := &masterRandPair{msr: msr, mpr: mpr}
+	k.randPairs[string(chainID)] = pair
+
+	return pair, nil
+}
+
+// masterPubRand returns the encoded master public randomness of the given chain and version
+func (k *unlockedKey) masterPubRand(chainID []byte, version eotstypes.MasterRandVersion) (string, error) {
+	pair, err := k.masterRandPair(chainID)
+	if err != nil {
+		return "", err
+	}
+
+	if version == eotstypes.MasterRandV1 {
+		return pair.mpr.MarshalBase58(), nil
+	}
+
+	if err := k.deriveMasterRandPairV2(chainID, pair); err != nil {
+		return "", err
+	}
+
+	return pair.mprV2.Marshal(), nil
+}
+
+// secretRand returns the secret randomness of the given chain and version at the given height.
+// It refuses the heights at which the randomness of the version would wrap around
+func (k *unlockedKey) secretRand(chainID []byte, version eotstypes.MasterRandVersion, height uint64) (*eots.PrivateRand, error) {
+	if err := version.CheckHeight(height); err != nil {
+		return nil, err
+	}
+
+	pair, err := k.masterRandPair(chainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get master secret randomness: %w", err)
+	}
+
+	var sr *eots.PrivateRand
+	if version == eotstypes.MasterRandV1 {
+		// the height is checked against the 32-bit bound above
+		sr, _, err = pair.msr.DeriveRandPair(uint32(height))
+	} else {
+		if err := k.deriveMasterRandPairV2(chainID, pair); err != nil {
+			return nil, fmt.Errorf("failed to get master secret randomness: %w", err)
+		}
+		sr, _, err = pair.msrV2.DeriveRandPair(height)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret randomness: %w", err)
+	}
+
+	return sr, nil
+}
+
+func (k *unlockedKey) deriveMasterRandPairV2(chainID []byte, pair *masterRandPair) error {
+	if pair.msrV2 != nil {
+		return nil
+	}
+
+	msr, mpr, err := fpkeyring.GenerateMasterRandPairV2(k.privKey.Serialize(), chainID)
+	if err != nil {
+		return err
+	}
+	pair.msrV2, pair.mprV2 = msr, mpr
+
+	return nil
+}
+
+// wipe zeroes the private key and drops the master randomness
+func (k *unlockedKey) wipe() {
+	if k.expiry != nil {
+		k.expiry.Stop()
+	}
+	k.privKey.Zero()
+	k.randPairs = nil
+}
+
+// Unlock loads the key at the given name from the keyring and keeps it in
+// memory until the cache TTL is reached or the key is locked. Unlocking an
+// unlocked key reloads it and restarts its TTL
+func (sb *SoftwareBackend) Unlock(name, passphrase string) error {
+	if sb.cacheTTL <= 0 {
+		return ErrKeyCacheDisabled
+	}
+
+	sb.cacheMu.Lock()
+	defer sb.cacheMu.Unlock()
+
+	privKey, err := sb.loadPrivKey(name, passphrase)
+	if err != nil {
+		return err
+	}
+
+	sb.lock(name)
+	sb.cacheKey(name, privKey)
+
+	return nil
+}
+
+// Lock wipes the key at the given name from memory
+func (sb *SoftwareBackend) Lock(name string) {
+	sb.cacheMu.Lock()
+	defer sb.cacheMu.Unlock()
+
+	sb.lock(name)
+}
+
+// LockAll wipes all the keys from memory
+func (sb *SoftwareBackend) LockAll() {
+	sb.cacheMu.Lock()
+	defer sb.cacheMu.Unlock()
+
+	for name := range sb.cache {
+		sb.lock(name)
+	}
+}
+
+// withUnlockedKey runs f with the key at the given name, which is loaded
+// from the keyring if it is not in cache. The key is cached if the cache is
+// enabled, otherwise it is wiped once f returns
+func (sb *SoftwareBackend) withUnlockedKey(name, passphrase string, f func(k *unlockedKey) error) error {
+	sb.cacheMu.Lock()
+	defer sb.cacheMu.Unlock()
+
+	if k, ok := sb.cache[name]; ok {
+		return f(k)
+	}
+
+	privKey, err := sb.loadPrivKey(name, passphrase)
+	if err != nil {
+		return err
+	}
+
+	if sb.cacheTTL <= 0 {
+		k := &unlockedKey{
+			privKey:   privKey,
+			randPairs: make(map[string]*masterRandPair),
+		}
+		defer k.wipe()
+
+		return f(k)
+	}
+
+	return f(sb.cacheKey(name, privKey))
+}
+
+// cacheKey caches the given private key at the given name until the cache TTL
+// is reached. cacheMu must be held
+func (sb *SoftwareBackend) cacheKey(name string, privKey *btcec.PrivateKey) *unlockedKey {
+	k := &unlockedKey{
+		privKey:   privKey,
+		randPairs: make(map[string]*masterRandPair),
+	}
+	k.expiry = time.AfterFunc(sb.cacheTTL, func() {
+		sb.cacheMu.Lock()
+		defer sb.cacheMu.Unlock()
+
+		// the key might have been locked and unlocked again in the meantime
+		if sb.cache[name] == k {
+			sb.lock(name)
+		}
+	})
+	sb.cache[name] = k
+
+	return k
+}
+
+// lock wipes the key at the given name if it is in cache. cacheMu must be held
+func (sb *SoftwareBackend) lock(name string) {
+	k, ok := sb.cache[name]
+	if !ok {
+		return
+	}
+
+	k.wipe()
+	delete(sb.cache, name)
+}
@@ -0,0 +1,310 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: signer.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	EOTSSigner_Ping_FullMethodName             = "/signer.EOTSSigner/Ping"
+	EOTSSigner_CreateKey_FullMethodName        = "/signer.EOTSSigner/CreateKey"
+	EOTSSigner_PubKey_FullMethodName           = "/signer.EOTSSigner/PubKey"
+	EOTSSigner_MasterPublicRand_FullMethodName = "/signer.EOTSSigner/MasterPublicRand"
+	EOTSSigner_SignEOTS_FullMethodName         = "/signer.EOTSSigner/SignEOTS"
+	EOTSSigner_SignSchnorrSig_FullMethodName   = "/signer.EOTSSigner/SignSchnorrSig"
+)
+
+// EOTSSignerClient is the client API for EOTSSigner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EOTSSignerClient interface {
+	// Ping checks whether the signer is reachable
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	// CreateKey generates an EOTS key and keeps it in the signer
+	CreateKey(ctx context.Context, in *CreateKeyRequest, opts ...grpc.CallOption) (*CreateKeyResponse, error)
+	// PubKey returns the public key of an EOTS key
+	PubKey(ctx context.Context, in *PubKeyRequest, opts ...grpc.CallOption) (*PubKeyResponse, error)
+	// MasterPublicRand returns the master public randomness of an EOTS key
+	// for a consumer chain
+	MasterPublicRand(ctx context.Context, in *MasterPublicRandRequest, opts ...grpc.CallOption) (*MasterPublicRandResponse, error)
+	// SignEOTS signs an EOTS with an EOTS key and the secret randomness
+	// derived for a consumer chain at a height
+	SignEOTS(ctx context.Context, in *SignEOTSRequest, opts ...grpc.CallOption) (*SignEOTSResponse, error)
+	// SignSchnorrSig signs a Schnorr sig with an EOTS key
+	SignSchnorrSig(ctx context.Context, in *SignSchnorrSigRequest, opts ...grpc.CallOption) (*SignSchnorrSigResponse, error)
+}
+
+type eOTSSignerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEOTSSignerClient(cc grpc.ClientConnInterface) EOTSSignerClient {
+	return &eOTSSignerClient{cc}
+}
+
+func (c *eOTSSignerClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	out := new(PingResponse)
+	err := c.cc.Invoke(ctx, EOTSSigner_Ping_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSSignerClient) CreateKey(ctx context.Context, in *CreateKeyRequest, opts ...grpc.CallOption) (*CreateKeyResponse, error) {
+	out := new(CreateKeyResponse)
+	err := c.cc.Invoke(ctx, EOTSSigner_CreateKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSSignerClient) PubKey(ctx context.Context, in *PubKeyRequest, opts ...grpc.CallOption) (*PubKeyResponse, error) {
+	out := new(PubKeyResponse)
+	err := c.cc.Invoke(ctx, EOTSSigner_PubKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSSignerClient) MasterPublicRand(ctx context.Context, in *MasterPublicRandRequest, opts ...grpc.CallOption) (*MasterPublicRandResponse, error) {
+	out := new(MasterPublicRandResponse)
+	err := c.cc.Invoke(ctx, EOTSSigner_MasterPublicRand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSSignerClient) SignEOTS(ctx context.Context, in *SignEOTSRequest, opts ...grpc.CallOption) (*SignEOTSResponse, error) {
+	out := new(SignEOTSResponse)
+	err := c.cc.Invoke(ctx, EOTSSigner_SignEOTS_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eOTSSignerClient) SignSchnorrSig(ctx context.Context, in *SignSchnorrSigRequest, opts ...grpc.CallOption) (*SignSchnorrSigResponse, error) {
+	out := new(SignSchnorrSigResponse)
+	err := c.cc.Invoke(ctx, EOTSSigner_SignSchnorrSig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EOTSSignerServer is the server API for EOTSSigner service.
+// All implementations must embed UnimplementedEOTSSignerServer
+// for forward compatibility
+type EOTSSignerServer interface {
+	// Ping checks whether the signer is reachable
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	// CreateKey generates an EOTS key and keeps it in the signer
+	CreateKey(context.Context, *CreateKeyRequest) (*CreateKeyResponse, error)
+	// PubKey returns the public key of an EOTS key
+	PubKey(context.Context, *PubKeyRequest) (*PubKeyResponse, error)
+	// MasterPublicRand returns the master public randomness of an EOTS key
+	// for a consumer chain
+	MasterPublicRand(context.Context, *MasterPublicRandRequest) (*MasterPublicRandResponse, error)
+	// SignEOTS signs an EOTS with an EOTS key and the secret randomness
+	// derived for a consumer chain at a height
+	SignEOTS(context.Context, *SignEOTSRequest) (*SignEOTSResponse, error)
+	// SignSchnorrSig signs a Schnorr sig with an EOTS key
+	SignSchnorrSig(context.Context, *SignSchnorrSigRequest) (*SignSchnorrSigResponse, error)
+	mustEmbedUnimplementedEOTSSignerServer()
+}
+
+// UnimplementedEOTSSignerServer must be embedded to have forward compatible implementations.
+type UnimplementedEOTSSignerServer struct {
+}
+
+func (UnimplementedEOTSSignerServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (UnimplementedEOTSSignerServer) CreateKey(context.Context, *CreateKeyRequest) (*CreateKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKey not implemented")
+}
+func (UnimplementedEOTSSignerServer) PubKey(context.Context, *PubKeyRequest) (*PubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKey not implemented")
+}
+func (UnimplementedEOTSSignerServer) MasterPublicRand(context.Context, *MasterPublicRandRequest) (*MasterPublicRandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MasterPublicRand not implemented")
+}
+func (UnimplementedEOTSSignerServer) SignEOTS(context.Context, *SignEOTSRequest) (*SignEOTSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignEOTS not implemented")
+}
+func (UnimplementedEOTSSignerServer) SignSchnorrSig(context.Context, *SignSchnorrSigRequest) (*SignSchnorrSigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignSchnorrSig not implemented")
+}
+func (UnimplementedEOTSSignerServer) mustEmbedUnimplementedEOTSSignerServer() {}
+
+// UnsafeEOTSSignerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EOTSSignerServer will
+// result in compilation errors.
+type UnsafeEOTSSignerServer interface {
+	mustEmbedUnimplementedEOTSSignerServer()
+}
+
+func RegisterEOTSSignerServer(s grpc.ServiceRegistrar, srv EOTSSignerServer) {
+	s.RegisterService(&EOTSSigner_ServiceDesc, srv)
+}
+
+func _EOTSSigner_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSSignerServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSSigner_Ping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSSignerServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSSigner_CreateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSSignerServer).CreateKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSSigner_CreateKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSSignerServer).CreateKey(ctx, req.(*CreateKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSSigner_PubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PubKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSSignerServer).PubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSSigner_PubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSSignerServer).PubKey(ctx, req.(*PubKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSSigner_MasterPublicRand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MasterPublicRandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSSignerServer).MasterPublicRand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSSigner_MasterPublicRand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSSignerServer).MasterPublicRand(ctx, req.(*MasterPublicRandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSSigner_SignEOTS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignEOTSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSSignerServer).SignEOTS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSSigner_SignEOTS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSSignerServer).SignEOTS(ctx, req.(*SignEOTSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EOTSSigner_SignSchnorrSig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignSchnorrSigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EOTSSignerServer).SignSchnorrSig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EOTSSigner_SignSchnorrSig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EOTSSignerServer).SignSchnorrSig(ctx, req.(*SignSchnorrSigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EOTSSigner_ServiceDesc is the grpc.ServiceDesc for EOTSSigner service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EOTSSigner_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "signer.EOTSSigner",
+	HandlerType: (*EOTSSignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Ping",
+			Handler:    _EOTSSigner_Ping_Handler,
+		},
+		{
+			MethodName: "CreateKey",
+			Handler:    _EOTSSigner_CreateKey_Handler,
+		},
+		{
+			MethodName: "PubKey",
+			Handler:    _EOTSSigner_PubKey_Handler,
+		},
+		{
+			MethodName: "MasterPublicRand",
+			Handler:    _EOTSSigner_MasterPublicRand_Handler,
+		},
+		{
+			MethodName: "SignEOTS",
+			Handler:    _EOTSSigner_SignEOTS_Handler,
+		},
+		{
+			MethodName: "SignSchnorrSig",
+			Handler:    _EOTSSigner_SignSchnorrSig_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "signer.proto",
+}
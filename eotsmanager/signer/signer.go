@@ -0,0 +1,62 @@
+package signer
+
+import (
+	bbntypes "github.com/babylonchain/babylon/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+
+	eotstypes "github.com/babylonchain/finality-provider/eotsmanager/types"
+)
+
+// Backend performs the operations of the EOTS manager that require the EOTS
+// private keys. Keys are identified by their names. Implementations can keep
+// the keys in-process (SoftwareBackend) or in an external process reached
+// over the EOTSSigner gRPC plugin protocol (GRPCBackend)
+type Backend interface {
+	// CreateKey generates a key pair at the given name and keeps it in the backend.
+	// The key is derived from the given mnemonic, or from a newly generated one if
+	// the mnemonic is empty. The key pair is formatted by BIP-340 (Schnorr Signatures)
+	// It fails if there is an existing key with the same name
+	CreateKey(name, passphrase, hdPath, mnemonic string) (*bbntypes.BIP340PubKey, error)
+
+	// PubKey returns the public key of the key at the given name
+	PubKey(name string) (*bbntypes.BIP340PubKey, error)
+
+	// MasterPublicRand returns the encoded master public randomness of the given version
+	// of the key at the given name for the given chain
+	// NOTE: the master randomness pair is deterministically generated based on the EOTS key and chainID
+	MasterPublicRand(name string, chainID []byte, version eotstypes.MasterRandVersion, passphrase string) (string, error)
+
+	// SignEOTS signs an EOTS using the key at the given name and the corresponding
+	// secret randomness of the given chain at the given height
+	// NOTE: the backend does not protect against double signing, which is
+	// the responsibility of the EOTS manager
+	// It refuses to sign above the maximum height of the master randomness version,
+	// where the randomness would be reused
+	SignEOTS(name string, chainID []byte, msg []byte, height uint64, version eotstypes.MasterRandVersion, passphrase string) (*btcec.ModNScalar, error)
+
+	// SignSchnorrSig signs a Schnorr signature using the key at the given name
+	SignSchnorrSig(name string, msg []byte, passphrase string) (*schnorr.Signature, error)
+
+	Close() error
+}
+
+// PrivKeyExporter is implemented by the backends which hold the EOTS
+// private keys in-process and are able to export them
+type PrivKeyExporter interface {
+	// PrivKey returns the private key of the key at the given name
+	PrivKey(name, passphrase string) (*btcec.PrivateKey, error)
+}
+
+// KeyCache is implemented by the backends which keep the unlocked
+// EOTS keys and master secret randomness in memory
+type KeyCache interface {
+	// Unlock loads the key at the given name into memory until it expires or is locked
+	Unlock(name, passphrase string) error
+
+	// Lock wipes the key at the given name from memory
+	Lock(name string)
+
+	// LockAll wipes all the keys from memory
+	LockAll()
+}
@@ -0,0 +1,258 @@
+package signer
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/babylonchain/babylon/crypto/eots"
+	bbntypes "github.com/babylonchain/babylon/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/go-bip39"
+
+	"github.com/babylonchain/finality-provider/codec"
+	eotstypes "github.com/babylonchain/finality-provider/eotsmanager/types"
+)
+
+const (
+	secp256k1Type       = "secp256k1"
+	MnemonicEntropySize = 256
+)
+
+var (
+	_ Backend         = &SoftwareBackend{}
+	_ PrivKeyExporter = &SoftwareBackend{}
+	_ KeyCache        = &SoftwareBackend{}
+)
+
+// SoftwareBackend is the reference signer backend which keeps
+// the EOTS keys in a Cosmos keyring and signs in-process
+type SoftwareBackend struct {
+	kr keyring.Keyring
+	// input is to send passphrase to kr
+	input *strings.Reader
+	// inputMu protects input, which is shared by all the keyring operations
+	inputMu sync.Mutex
+
+	// cacheTTL is how long an unlocked key is kept in cache, 0 disables the cache
+	cacheTTL time.Duration
+	// cache keeps the unlocked keys indexed by key name
+	cache map[string]*unlockedKey
+	// cacheMu protects cache and is held while an unlocked key is in use,
+	// so that a key is never wiped in the middle of a signing operation
+	cacheMu sync.Mutex
+}
+
+// NewSoftwareBackend creates a software backend using the keyring under the given
+// home directory. The unlocked keys and master secret randomness are kept in memory
+// for keyCacheTTL, or wiped right after each operation if keyCacheTTL is 0
+func NewSoftwareBackend(homeDir, keyringBackend string, keyCacheTTL time.Duration) (*SoftwareBackend, error) {
+	inputReader := strings.NewReader("")
+
+	kr, err := keyring.New(
+		"eots-manager",
+		keyringBackend,
+		homeDir,
+		inputReader,
+		codec.MakeCodec(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize keyring: %w", err)
+	}
+
+	return &SoftwareBackend{
+		kr:       kr,
+		input:    inputReader,
+		cacheTTL: keyCacheTTL,
+		cache:    make(map[string]*unlockedKey),
+	}, nil
+}
+
+func NewMnemonic() (string, error) {
+	// read entropy seed straight from tmcrypto.Rand and convert to mnemonic
+	entropySeed, err := bip39.NewEntropy(MnemonicEntropySize)
+	if err != nil {
+		return "", err
+	}
+
+	mnemonic, err := bip39.NewMnemonic(entropySeed)
+	if err != nil {
+		return "", err
+	}
+
+	return mnemonic, nil
+}
+
+func (sb *SoftwareBackend) CreateKey(name, passphrase, hdPath, mnemonic string) (*bbntypes.BIP340PubKey, error) {
+	sb.inputMu.Lock()
+	defer sb.inputMu.Unlock()
+
+	if _, err := sb.kr.Key(name); err == nil {
+		return nil, eotstypes.ErrFinalityProviderAlreadyExisted
+	}
+
+	if mnemonic == "" {
+		var err error
+		mnemonic, err = NewMnemonic()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	keyringAlgos, _ := sb.kr.SupportedAlgorithms()
+	algo, err := keyring.NewSigningAlgoFromString(secp256k1Type, keyringAlgos)
+	if err != nil {
+		return nil, err
+	}
+
+	// we need to repeat the passphrase to mock the re-entry
+	// as when creating an account, passphrase will be asked twice
+	// by the keyring
+	sb.input.Reset(passphrase + "\n" + passphrase)
+	record, err := sb.kr.NewAccount(name, mnemonic, passphrase, hdPath, algo)
+	if err != nil {
+		return nil, err
+	}
+
+	return loadBIP340PubKeyFromKeyringRecord(record)
+}
+
+func (sb *SoftwareBackend) PubKey(name string) (*bbntypes.BIP340PubKey, error) {
+	k, err := sb.kr.Key(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load keyring record for key %s: %w", name, err)
+	}
+
+	return loadBIP340PubKeyFromKeyringRecord(k)
+}
+
+// MasterPublicRand returns the master public randomness of the given version
+// deterministically generated from the secret key and chain ID
+func (sb *SoftwareBackend) MasterPublicRand(name string, chainID []byte, version eotstypes.MasterRandVersion, passphrase string) (string, error) {
+	if err := version.Validate(); err != nil {
+		return "", err
+	}
+
+	var mpr string
+	err := sb.withUnlockedKey(name, passphrase, func(k *unlockedKey) error {
+		var err error
+		mpr, err = k.masterPubRand(chainID, version)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return mpr, nil
+}
+
+func (sb *SoftwareBackend) SignEOTS(name string, chainID []byte, msg []byte, height uint64, version eotstypes.MasterRandVersion, passphrase string) (*btcec.ModNScalar, error) {
+	if err := version.Validate(); err != nil {
+		return nil, err
+	}
+	var sig *btcec.ModNScalar
+	err := sb.withUnlockedKey(name, passphrase, func(k *unlockedKey) error {
+		sr, err := k.secretRand(chainID, version, height)
+		if err != nil {
+			return err
+		}
+
+		sig, err = eots.Sign(k.privKey, sr, msg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sig, nil
+}
+
+func (sb *SoftwareBackend) SignSchnorrSig(name string, msg []byte, passphrase string) (*schnorr.Signature, error) {
+	var sig *schnorr.Signature
+	err := sb.withUnlockedKey(name, passphrase, func(k *unlockedKey) error {
+		var err error
+		sig, err = schnorr.Sign(k.privKey, msg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sig, nil
+}
+
+// PrivKey returns a copy of the private key, so that wiping the
+// cached key does not affect the returned one
+func (sb *SoftwareBackend) PrivKey(name, passphrase string) (*btcec.PrivateKey, error) {
+	var privKey *btcec.PrivateKey
+	err := sb.withUnlockedKey(name, passphrase, func(k *unlockedKey) error {
+		privKey, _ = btcec.PrivKeyFromBytes(k.privKey.Serialize())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return privKey, nil
+}
+
+// TODO: we ignore passPhrase in local implementation for now
+func (sb *SoftwareBackend) loadPrivKey(name, passphrase string) (*btcec.PrivateKey, error) {
+	sb.inputMu.Lock()
+	defer sb.inputMu.Unlock()
+
+	sb.input.Reset(passphrase)
+	k, err := sb.kr.Key(name)
+	if errors.Is(err, keyring.ErrMaxPassPhraseAttempts) {
+		// the encrypted keyring refuses the passphrase
+		return nil, fmt.Errorf("%w: %v", eotstypes.ErrIncorrectPassphrase, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get EOTS private key: %w", err)
+	}
+
+	return eotsPrivKeyFromRecord(k)
+}
+
+func (sb *SoftwareBackend) Close() error {
+	sb.LockAll()
+	return nil
+}
+
+func loadBIP340PubKeyFromKeyringRecord(record *keyring.Record) (*bbntypes.BIP340PubKey, error) {
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	var eotsPk *bbntypes.BIP340PubKey
+	switch v := pubKey.(type) {
+	case *secp256k1.PubKey:
+		pk, err := btcec.ParsePubKey(v.Key)
+		if err != nil {
+			return nil, err
+		}
+		eotsPk = bbntypes.NewBIP340PubKeyFromBTCPK(pk)
+		return eotsPk, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type in keyring")
+	}
+}
+
+func eotsPrivKeyFromRecord(k *keyring.Record) (*btcec.PrivateKey, error) {
+	privKeyCached := k.GetLocal().PrivKey.GetCachedValue()
+
+	var privKey *btcec.PrivateKey
+	switch v := privKeyCached.(type) {
+	case *secp256k1.PrivKey:
+		privKey, _ = btcec.PrivKeyFromBytes(v.Key)
+		return privKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type in keyring")
+	}
+}
@@ -1,16 +1,38 @@
 package store
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcwallet/walletdb"
 	"github.com/lightningnetwork/lnd/kvdb"
+
+	"github.com/babylonchain/finality-provider/migration"
 )
 
 var (
 	eotsBucketName = []byte("fpKeyNames")
+
+	// signingHistoryBucketName stores the EOTS signatures produced so far
+	// key: fpPk || chainID || height (big endian)
+	// value: msgHash || signature
+	signingHistoryBucketName = []byte("signingHistory")
+
+	// masterRandVersionBucketName stores the versions of the master randomness
+	// created for the finality providers, which were all v1 before the bucket
+	// key: fpPk || chainID
+	// value: version (big endian)
+	masterRandVersionBucketName = []byte("masterRandVersions")
+)
+
+const (
+	fpPkSize    = schnorr.PubKeyBytesLen
+	msgHashSize = 32
+	heightSize  = 8
+	versionSize = 4
 )
 
 type EOTSStore struct {
@@ -23,17 +45,38 @@ func NewEOTSStore(db kvdb.Backend) (*EOTSStore, error) {
 		return nil, err
 	}
 
+	// the db should be migrated before the store is used
+	if err := migration.CheckVersion(db, migrations); err != nil {
+		return nil, err
+	}
+
 	return s, nil
 }
 
+// initBuckets creates the buckets of a new db, which is at the latest schema version,
+// while the buckets of an existing db are created by the migrations
 func (s *EOTSStore) initBuckets() error {
 	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		if tx.ReadWriteBucket(eotsBucketName) != nil {
+			return nil
+		}
+
 		_, err := tx.CreateTopLevelBucket(eotsBucketName)
 		if err != nil {
 			return err
 		}
 
-		return nil
+		_, err = tx.CreateTopLevelBucket(signingHistoryBucketName)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateTopLevelBucket(masterRandVersionBucketName)
+		if err != nil {
+			return err
+		}
+
+		return migration.SetVersion(tx, migration.LatestVersion(migrations))
 	})
 }
 
@@ -93,3 +136,274 @@ func (s *EOTSStore) GetEOTSKeyName(pk []byte) (string, error) {
 
 	return keyName, nil
 }
+
+// SigningRecord is the record of an EOTS signature produced
+// by a finality provider for a given chain at a given height
+type SigningRecord struct {
+	MsgHash   []byte
+	Signature []byte
+}
+
+// SaveSigningRecord persists the hash of the message signed by the given finality provider
+// for the given chain at the given height, together with the produced signature.
+// It fails with ErrConflictingSigningRecord if a different message has already been
+// recorded at the same height
+func (s *EOTSStore) SaveSigningRecord(
+	fpPk []byte,
+	chainID []byte,
+	height uint64,
+	msgHash []byte,
+	sig []byte,
+) error {
+	if len(msgHash) != msgHashSize {
+		return fmt.Errorf("invalid message hash length %d, expected %d", len(msgHash), msgHashSize)
+	}
+
+	key := signingRecordKey(fpPk, chainID, height)
+
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		historyBucket := tx.ReadWriteBucket(signingHistoryBucketName)
+		if historyBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		if v := historyBucket.Get(key); v != nil {
+			if !bytes.Equal(v[:msgHashSize], msgHash) {
+				return ErrConflictingSigningRecord
+			}
+		}
+
+		return historyBucket.Put(key, encodeSigningRecord(msgHash, sig))
+	})
+}
+
+// SigningHistoryEntry is an entry of the signing history which identifies
+// the message signed by a finality provider for a given chain at a given height
+type SigningHistoryEntry struct {
+	FpPk    []byte
+	ChainID []byte
+	Height  uint64
+	MsgHash []byte
+}
+
+// GetSigningHistory returns all the entries of the signing history,
+// ordered by finality provider, chain, and height
+func (s *EOTSStore) GetSigningHistory() ([]*SigningHistoryEntry, error) {
+	var entries []*SigningHistoryEntry
+
+	err := s.db.View(func(tx kvdb.RTx) error {
+		historyBucket := tx.ReadBucket(signingHistoryBucketName)
+		if historyBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		return historyBucket.ForEach(func(k, v []byte) error {
+			if len(k) <= fpPkSize+heightSize || len(v) < msgHashSize {
+				return ErrCorruptedEOTSDb
+			}
+
+			entry := &SigningHistoryEntry{
+				FpPk:    make([]byte, fpPkSize),
+				ChainID: make([]byte, len(k)-fpPkSize-heightSize),
+				Height:  binary.BigEndian.Uint64(k[len(k)-heightSize:]),
+				MsgHash: make([]byte, msgHashSize),
+			}
+			copy(entry.FpPk, k[:fpPkSize])
+			copy(entry.ChainID, k[fpPkSize:len(k)-heightSize])
+			copy(entry.MsgHash, v[:msgHashSize])
+			entries = append(entries, entry)
+
+			return nil
+		})
+	}, func() {
+		entries = nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// MergeSigningHistory adds the given entries to the signing history and returns
+// the number of entries that were not recorded before. The entries are merged
+// atomically: if any of them conflicts with a recorded message, nothing is written
+// and ErrConflictingSigningRecord is returned
+func (s *EOTSStore) MergeSigningHistory(entries []*SigningHistoryEntry) (int, error) {
+	var numAdded int
+
+	err := kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		numAdded = 0
+
+		historyBucket := tx.ReadWriteBucket(signingHistoryBucketName)
+		if historyBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		for _, e := range entries {
+			if len(e.FpPk) != fpPkSize {
+				return fmt.Errorf("invalid finality provider public key length %d, expected %d", len(e.FpPk), fpPkSize)
+			}
+			if len(e.ChainID) == 0 {
+				return fmt.Errorf("empty chain ID for finality provider %x at height %d", e.FpPk, e.Height)
+			}
+			if len(e.MsgHash) != msgHashSize {
+				return fmt.Errorf("invalid message hash length %d, expected %d", len(e.MsgHash), msgHashSize)
+			}
+
+			key := signingRecordKey(e.FpPk, e.ChainID, e.Height)
+			if v := historyBucket.Get(key); v != nil {
+				if !bytes.Equal(v[:msgHashSize], e.MsgHash) {
+					return fmt.Errorf("%w: finality provider %x on chain %s at height %d",
+						ErrConflictingSigningRecord, e.FpPk, e.ChainID, e.Height)
+				}
+				continue
+			}
+
+			// the signature is unknown for imported entries
+			if err := historyBucket.Put(key, encodeSigningRecord(e.MsgHash, nil)); err != nil {
+				return err
+			}
+			numAdded++
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return numAdded, nil
+}
+
+// GetSigningRecord returns the record of the message signed by the given finality provider
+// for the given chain at the given height
+func (s *EOTSStore) GetSigningRecord(fpPk []byte, chainID []byte, height uint64) (*SigningRecord, error) {
+	var record *SigningRecord
+	key := signingRecordKey(fpPk, chainID, height)
+
+	err := s.db.View(func(tx kvdb.RTx) error {
+		historyBucket := tx.ReadBucket(signingHistoryBucketName)
+		if historyBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		v := historyBucket.Get(key)
+		if v == nil {
+			return ErrSigningRecordNotFound
+		}
+
+		var err error
+		record, err = decodeSigningRecord(v)
+		return err
+	}, func() {})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func signingRecordKey(fpPk []byte, chainID []byte, height uint64) []byte {
+	key := make([]byte, 0, len(fpPk)+len(chainID)+heightSize)
+	key = append(key, fpPk...)
+	key = append(key, chainID...)
+	key = binary.BigEndian.AppendUint64(key, height)
+
+	return key
+}
+
+func encodeSigningRecord(msgHash []byte, sig []byte) []byte {
+	value := make([]byte, 0, len(msgHash)+len(sig))
+	value = append(value, msgHash...)
+	value = append(value, sig...)
+
+	return value
+}
+
+func decodeSigningRecord(v []byte) (*SigningRecord, error) {
+	if len(v) < msgHashSize {
+		return nil, ErrCorruptedEOTSDb
+	}
+
+	// copy the value as it is only valid within the db transaction
+	record := &SigningRecord{
+		MsgHash: make([]byte, msgHashSize),
+	}
+	copy(record.MsgHash, v[:msgHashSize])
+	if len(v) > msgHashSize {
+		record.Signature = make([]byte, len(v)-msgHashSize)
+		copy(record.Signature, v[msgHashSize:])
+	}
+
+	return record, nil
+}
+
+// SaveMasterRandVersion records the version of the master randomness created by the
+// given finality provider for the given chain. It fails with ErrConflictingMasterRandVersion
+// if a different version has already been recorded
+func (s *EOTSStore) SaveMasterRandVersion(fpPk []byte, chainID []byte, version uint32) error {
+	key := masterRandVersionKey(fpPk, chainID)
+
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		versionBucket := tx.ReadWriteBucket(masterRandVersionBucketName)
+		if versionBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		if v := versionBucket.Get(key); v != nil {
+			if len(v) != versionSize {
+				return ErrCorruptedEOTSDb
+			}
+			if recorded := binary.BigEndian.Uint32(v); recorded != version {
+				return fmt.Errorf("%w: v%d is recorded, v%d is requested", ErrConflictingMasterRandVersion, recorded, version)
+			}
+
+			return nil
+		}
+
+		return versionBucket.Put(key, binary.BigEndian.AppendUint32(nil, version))
+	})
+}
+
+// GetMasterRandVersion returns the version of the master randomness of the given finality
+// provider for the given chain, or ErrMasterRandVersionNotFound if none is recorded
+func (s *EOTSStore) GetMasterRandVersion(fpPk []byte, chainID []byte) (uint32, error) {
+	var version uint32
+	key := masterRandVersionKey(fpPk, chainID)
+
+	err := s.db.View(func(tx kvdb.RTx) error {
+		versionBucket := tx.ReadBucket(masterRandVersionBucketName)
+		if versionBucket == nil {
+			return ErrCorruptedEOTSDb
+		}
+
+		v := versionBucket.Get(key)
+		if v == nil {
+			return ErrMasterRandVersionNotFound
+		}
+		if len(v) != versionSize {
+			return ErrCorruptedEOTSDb
+		}
+
+		version = binary.BigEndian.Uint32(v)
+		return nil
+	}, func() {})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func masterRandVersionKey(fpPk []byte, chainID []byte) []byte {
+	key := make([]byte, 0, len(fpPk)+len(chainID))
+	key = append(key, fpPk...)
+	key = append(key, chainID...)
+
+	return key
+}
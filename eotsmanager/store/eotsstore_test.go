@@ -63,3 +63,142 @@ func FuzzEOTSStore(f *testing.F) {
 		require.ErrorIs(t, err, store.ErrEOTSKeyNameNotFound)
 	})
 }
+
+// FuzzSigningRecord tests save and get signing records properly
+func FuzzSigningRecord(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		homePath := t.TempDir()
+		cfg := config.DefaultDBConfigWithHomePath(homePath)
+
+		dbBackend, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+
+		vs, err := store.NewEOTSStore(dbBackend)
+		require.NoError(t, err)
+
+		defer func() {
+			dbBackend.Close()
+			err := os.RemoveAll(homePath)
+			require.NoError(t, err)
+		}()
+
+		_, btcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fpPk := schnorr.SerializePubKey(btcPk)
+		chainID := datagen.GenRandomByteArray(r, 10)
+		height := datagen.RandomInt(r, 1000)
+		msgHash := datagen.GenRandomByteArray(r, 32)
+		sig := datagen.GenRandomByteArray(r, 32)
+
+		// no record before signing
+		_, err = vs.GetSigningRecord(fpPk, chainID, height)
+		require.ErrorIs(t, err, store.ErrSigningRecordNotFound)
+
+		err = vs.SaveSigningRecord(fpPk, chainID, height, msgHash, sig)
+		require.NoError(t, err)
+
+		record, err := vs.GetSigningRecord(fpPk, chainID, height)
+		require.NoError(t, err)
+		require.Equal(t, msgHash, record.MsgHash)
+		require.Equal(t, sig, record.Signature)
+
+		// saving the same message again is allowed
+		err = vs.SaveSigningRecord(fpPk, chainID, height, msgHash, sig)
+		require.NoError(t, err)
+
+		// saving a different message at the same height is refused
+		err = vs.SaveSigningRecord(fpPk, chainID, height, datagen.GenRandomByteArray(r, 32), sig)
+		require.ErrorIs(t, err, store.ErrConflictingSigningRecord)
+
+		// records are separated by chain ID and height
+		_, err = vs.GetSigningRecord(fpPk, datagen.GenRandomByteArray(r, 11), height)
+		require.ErrorIs(t, err, store.ErrSigningRecordNotFound)
+		_, err = vs.GetSigningRecord(fpPk, chainID, height+1)
+		require.ErrorIs(t, err, store.ErrSigningRecordNotFound)
+	})
+}
+
+// FuzzMergeSigningHistory tests merging the signing history of
+// one EOTS store into another
+func FuzzMergeSigningHistory(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		srcStore := newTestEOTSStore(t)
+		dstStore := newTestEOTSStore(t)
+
+		_, btcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fpPk := schnorr.SerializePubKey(btcPk)
+		chainID := []byte(testutil.GenRandomHexStr(r, 10))
+		startHeight := datagen.RandomInt(r, 1000)
+		numHeights := int(datagen.RandomInt(r, 10)) + 1
+		for i := 0; i < numHeights; i++ {
+			err := srcStore.SaveSigningRecord(fpPk, chainID, startHeight+uint64(i),
+				datagen.GenRandomByteArray(r, 32), datagen.GenRandomByteArray(r, 32))
+			require.NoError(t, err)
+		}
+
+		history, err := srcStore.GetSigningHistory()
+		require.NoError(t, err)
+		require.Len(t, history, numHeights)
+		for i, e := range history {
+			require.Equal(t, fpPk, e.FpPk)
+			require.Equal(t, chainID, e.ChainID)
+			require.Equal(t, startHeight+uint64(i), e.Height)
+		}
+
+		numAdded, err := dstStore.MergeSigningHistory(history)
+		require.NoError(t, err)
+		require.Equal(t, numHeights, numAdded)
+
+		// merged records carry the message hash but not the signature
+		record, err := dstStore.GetSigningRecord(fpPk, chainID, startHeight)
+		require.NoError(t, err)
+		require.Equal(t, history[0].MsgHash, record.MsgHash)
+		require.Empty(t, record.Signature)
+
+		// merging the same history again adds nothing
+		numAdded, err = dstStore.MergeSigningHistory(history)
+		require.NoError(t, err)
+		require.Zero(t, numAdded)
+
+		// a conflicting entry aborts the whole merge
+		newEntry := &store.SigningHistoryEntry{
+			FpPk:    fpPk,
+			ChainID: chainID,
+			Height:  startHeight + uint64(numHeights),
+			MsgHash: datagen.GenRandomByteArray(r, 32),
+		}
+		conflictingEntry := &store.SigningHistoryEntry{
+			FpPk:    fpPk,
+			ChainID: chainID,
+			Height:  startHeight,
+			MsgHash: datagen.GenRandomByteArray(r, 32),
+		}
+		_, err = dstStore.MergeSigningHistory([]*store.SigningHistoryEntry{newEntry, conflictingEntry})
+		require.ErrorIs(t, err, store.ErrConflictingSigningRecord)
+		_, err = dstStore.GetSigningRecord(fpPk, chainID, newEntry.Height)
+		require.ErrorIs(t, err, store.ErrSigningRecordNotFound)
+	})
+}
+
+func newTestEOTSStore(t *testing.T) *store.EOTSStore {
+	homePath := t.TempDir()
+	cfg := config.DefaultDBConfigWithHomePath(homePath)
+
+	dbBackend, err := cfg.GetDbBackend()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		dbBackend.Close()
+	})
+
+	es, err := store.NewEOTSStore(dbBackend)
+	require.NoError(t, err)
+
+	return es
+}
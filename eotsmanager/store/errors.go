@@ -11,4 +11,16 @@ var (
 
 	// ErrEOTSKeyNameNotFound The EOTS key name we try to fetch is not found in db
 	ErrEOTSKeyNameNotFound = errors.New("EOTS key name not found")
+
+	// ErrSigningRecordNotFound No EOTS signature has been recorded at the given height
+	ErrSigningRecordNotFound = errors.New("EOTS signing record not found")
+
+	// ErrConflictingSigningRecord A different message has already been signed at the given height
+	ErrConflictingSigningRecord = errors.New("a different message has already been signed at the same height")
+
+	// ErrMasterRandVersionNotFound No master randomness version has been recorded for the finality provider and chain
+	ErrMasterRandVersionNotFound = errors.New("master randomness version not found")
+
+	// ErrConflictingMasterRandVersion A different master randomness version has been recorded for the finality provider and chain
+	ErrConflictingMasterRandVersion = errors.New("a different master randomness version has already been created")
 )
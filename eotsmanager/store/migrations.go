@@ -0,0 +1,32 @@
+package store
+
+import (
+	"github.com/lightningnetwork/lnd/kvdb"
+
+	"github.com/babylonchain/finality-provider/migration"
+)
+
+// migrations upgrade the schema of the EOTS store in the order of version
+var migrations = []migration.Migration{
+	{
+		Version:     1,
+		Description: "create the bucket of the EOTS signing history",
+		Migrate: func(tx kvdb.RwTx) error {
+			_, err := tx.CreateTopLevelBucket(signingHistoryBucketName)
+			return err
+		},
+	},
+	{
+		Version:     2,
+		Description: "create the bucket of the master randomness versions",
+		Migrate: func(tx kvdb.RwTx) error {
+			_, err := tx.CreateTopLevelBucket(masterRandVersionBucketName)
+			return err
+		},
+	},
+}
+
+// Migrations returns the migrations of the EOTS store
+func Migrations() []migration.Migration {
+	return migrations
+}
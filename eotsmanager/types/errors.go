@@ -1,7 +1,28 @@
 package types
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrFinalityProviderAlreadyExisted = errors.New("the finality provider has already existed")
+	ErrHeightOutOfRange               = errors.New("the height exceeds the maximum EOTS height of the master randomness")
+	ErrUnknownMasterRandVersion       = errors.New("unknown master randomness version")
+	// ErrIncorrectPassphrase is returned when the passphrase cannot decrypt the EOTS key
+	ErrIncorrectPassphrase = errors.New("the passphrase cannot unlock the EOTS key")
 )
+
+// DoubleSignError is returned when an EOTS signature is requested for a message
+// at a height where a different message has already been signed. Producing the
+// signature would leak the EOTS private key of the finality provider
+type DoubleSignError struct {
+	FpPk    []byte
+	ChainID []byte
+	Height  uint64
+}
+
+func (e *DoubleSignError) Error() string {
+	return fmt.Sprintf("refused to sign a different message for finality provider %x on chain %s at height %d: double signing would leak the EOTS private key",
+		e.FpPk, e.ChainID, e.Height)
+}
@@ -0,0 +1,246 @@
+package types
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/babylonchain/babylon/crypto/eots"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil/base58"
+)
+
+// MasterRandVersion is the version of the master randomness, which
+// defines how the EOTS randomness of each height is derived from it
+type MasterRandVersion uint32
+
+const (
+	// MasterRandV1 is the master randomness of Babylon, which derives the
+	// randomness at 32-bit heights. Its master public randomness is a plain
+	// base58 string, which is the format the consumer chains verify today
+	MasterRandV1 MasterRandVersion = 1
+	// MasterRandV2 derives the randomness at 64-bit heights. Its master public
+	// randomness is prefixed by "v2:" so that it is not taken for a v1 one
+	MasterRandV2 MasterRandVersion = 2
+
+	// MaxEOTSHeightV1 is the highest height of the v1 randomness. Above it, heights
+	// would wrap around and reuse the randomness of lower heights, which leaks the
+	// EOTS private key
+	MaxEOTSHeightV1 = math.MaxUint32
+
+	masterPubRandV2Prefix = "v2:"
+	chainCodeSize         = 32
+)
+
+// masterRandV2Key domain-separates the v2 master randomness from the v1
+// one, which is derived from the same seed
+var masterRandV2Key = []byte("EOTS master randomness v2")
+
+func (v MasterRandVersion) Validate() error {
+	if v != MasterRandV1 && v != MasterRandV2 {
+		return fmt.Errorf("%w: %d", ErrUnknownMasterRandVersion, v)
+	}
+
+	return nil
+}
+
+// RequestedMasterRandVersion returns the master randomness version of a gRPC
+// request, where the clients predating the versions leave it unset for v1
+func RequestedMasterRandVersion(version uint32) MasterRandVersion {
+	if version == 0 {
+		return MasterRandV1
+	}
+
+	return MasterRandVersion(version)
+}
+
+// MaxHeight returns the highest height at which the randomness can be derived
+func (v MasterRandVersion) MaxHeight() uint64 {
+	if v == MasterRandV1 {
+		return MaxEOTSHeightV1
+	}
+
+	return math.MaxUint64
+}
+
+// CheckHeight returns ErrHeightOutOfRange if no randomness of the
+// version can be safely derived at the given height
+func (v MasterRandVersion) CheckHeight(height uint64) error {
+	if height > v.MaxHeight() {
+		return fmt.Errorf("%w: height %d, maximum %d of master randomness v%d", ErrHeightOutOfRange, height, v.MaxHeight(), v)
+	}
+
+	return nil
+}
+
+// MasterPubRandVersion returns the version of the given master public randomness
+func MasterPubRandVersion(mpr string) MasterRandVersion {
+	if strings.HasPrefix(mpr, masterPubRandV2Prefix) {
+		return MasterRandV2
+	}
+
+	return MasterRandV1
+}
+
+// DerivePubRand derives the public randomness at the given height from
+// the master public randomness of any version
+func DerivePubRand(mpr string, height uint64) (*eots.PublicRand, error) {
+	version := MasterPubRandVersion(mpr)
+	if err := version.CheckHeight(height); err != nil {
+		return nil, err
+	}
+
+	if version == MasterRandV2 {
+		mprV2, err := NewMasterPublicRandV2FromString(mpr)
+		if err != nil {
+			return nil, err
+		}
+
+		return mprV2.DerivePubRand(height)
+	}
+
+	mprV1, err := eots.NewMasterPublicRandFromBase58(mpr)
+	if err != nil {
+		return nil, err
+	}
+
+	return mprV1.DerivePubRand(uint32(height))
+}
+
+// MasterSecretRandV2 is the v2 master secret randomness
+type MasterSecretRandV2 struct {
+	k   btcec.ModNScalar
+	mpr *MasterPublicRandV2
+}
+
+// MasterPublicRandV2 is the v2 master public randomness, i.e., the point of
+// the master secret randomness and the chain code of the derivation
+type MasterPublicRandV2 struct {
+	point     *btcec.PublicKey
+	chainCode [chainCodeSize]byte
+}
+
+// NewMasterRandPairV2FromSeed deterministically generates a pair of v2
+// master secret/public randomness from the given seed
+func NewMasterRandPairV2FromSeed(seed [32]byte) (*MasterSecretRandV2, *MasterPublicRandV2, error) {
+	mac := hmac.New(sha512.New, masterRandV2Key)
+	mac.Write(seed[:])
+	sum := mac.Sum(nil)
+
+	var k btcec.ModNScalar
+	if overflow := k.SetByteSlice(sum[:32]); overflow || k.IsZero() {
+		return nil, nil, fmt.Errorf("the seed does not give a valid master secret randomness")
+	}
+
+	mpr := &MasterPublicRandV2{point: scalarBasePoint(&k)}
+	copy(mpr.chainCode[:], sum[32:])
+
+	return &MasterSecretRandV2{k: k, mpr: mpr}, mpr, nil
+}
+
+// DeriveRandPair derives the pair of secret/public randomness at the given height
+func (msr *MasterSecretRandV2) DeriveRandPair(height uint64) (*eots.PrivateRand, *eots.PublicRand, error) {
+	t, err := msr.mpr.tweak(height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sr eots.PrivateRand
+	sr.Add2(&msr.k, t)
+	if sr.IsZero() {
+		return nil, nil, fmt.Errorf("no valid randomness at height %d", height)
+	}
+
+	var r btcec.JacobianPoint
+	btcec.ScalarBaseMultNonConst(&sr, &r)
+	r.ToAffine()
+
+	var pr eots.PublicRand
+	pr.Set(&r.X)
+
+	return &sr, &pr, nil
+}
+
+// DerivePubRand derives the public randomness at the given height
+func (mpr *MasterPublicRandV2) DerivePubRand(height uint64) (*eots.PublicRand, error) {
+	t, err := mpr.tweak(height)
+	if err != nil {
+		return nil, err
+	}
+
+	var tG, p, r btcec.JacobianPoint
+	btcec.ScalarBaseMultNonConst(t, &tG)
+	mpr.point.AsJacobian(&p)
+	btcec.AddNonConst(&p, &tG, &r)
+	r.ToAffine()
+	if (r.X.IsZero() && r.Y.IsZero()) || r.Z.IsZero() {
+		return nil, fmt.Errorf("no valid randomness at height %d", height)
+	}
+
+	var pr eots.PublicRand
+	pr.Set(&r.X)
+
+	return &pr, nil
+}
+
+// tweak returns the offset of the randomness at the given height from the master
+// randomness. As in the non-hardened BIP-32 derivation which v1 is based on, it is
+// computed from the master public randomness only, with 64-bit heights as indices
+func (mpr *MasterPublicRandV2) tweak(height uint64) (*btcec.ModNScalar, error) {
+	var heightBytes [8]byte
+	binary.BigEndian.PutUint64(heightBytes[:], height)
+
+	mac := hmac.New(sha256.New, mpr.chainCode[:])
+	mac.Write(mpr.point.SerializeCompressed())
+	mac.Write(heightBytes[:])
+
+	var t btcec.ModNScalar
+	if overflow := t.SetByteSlice(mac.Sum(nil)); overflow {
+		return nil, fmt.Errorf("no valid randomness at height %d", height)
+	}
+
+	return &t, nil
+}
+
+// Marshal encodes the master public randomness as "v2:" followed by
+// the base58 of the compressed point and the chain code
+func (mpr *MasterPublicRandV2) Marshal() string {
+	data := append(mpr.point.SerializeCompressed(), mpr.chainCode[:]...)
+
+	return masterPubRandV2Prefix + base58.Encode(data)
+}
+
+// NewMasterPublicRandV2FromString decodes a master public randomness encoded by Marshal
+func NewMasterPublicRandV2FromString(s string) (*MasterPublicRandV2, error) {
+	encoded, ok := strings.CutPrefix(s, masterPubRandV2Prefix)
+	if !ok {
+		return nil, fmt.Errorf("the master public randomness is not of v2")
+	}
+
+	data := base58.Decode(encoded)
+	if len(data) != btcec.PubKeyBytesLenCompressed+chainCodeSize {
+		return nil, fmt.Errorf("invalid length %d of the v2 master public randomness", len(data))
+	}
+
+	point, err := btcec.ParsePubKey(data[:btcec.PubKeyBytesLenCompressed])
+	if err != nil {
+		return nil, fmt.Errorf("invalid v2 master public randomness: %w", err)
+	}
+
+	mpr := &MasterPublicRandV2{point: point}
+	copy(mpr.chainCode[:], data[btcec.PubKeyBytesLenCompressed:])
+
+	return mpr, nil
+}
+
+func scalarBasePoint(k *btcec.ModNScalar) *btcec.PublicKey {
+	var p btcec.JacobianPoint
+	btcec.ScalarBaseMultNonConst(k, &p)
+	p.ToAffine()
+
+	return btcec.NewPublicKey(&p.X, &p.Y)
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// statusErrDomain is the domain of the errors sent over gRPC by
+	// the EOTS manager and the signer plugins
+	statusErrDomain = "eotsmanager"
+	// reasonIncorrectPassphrase identifies ErrIncorrectPassphrase over gRPC
+	reasonIncorrectPassphrase = "INCORRECT_PASSPHRASE"
+)
+
+// ToStatusError converts ErrIncorrectPassphrase to a gRPC status error which
+// FromStatusError converts back, so that the clients can tell an incorrect
+// passphrase from the other errors. Other errors are returned as they are
+func ToStatusError(err error) error {
+	if !errors.Is(err, ErrIncorrectPassphrase) {
+		return err
+	}
+
+	st, detailsErr := status.New(codes.Unauthenticated, err.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason: reasonIncorrectPassphrase,
+		Domain: statusErrDomain,
+	})
+	if detailsErr != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return st.Err()
+}
+
+// FromStatusError converts the gRPC status error of ToStatusError back
+// to ErrIncorrectPassphrase. Other errors are returned as they are
+func FromStatusError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.Unauthenticated {
+		return err
+	}
+
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if ok && info.Domain == statusErrDomain && info.Reason == reasonIncorrectPassphrase {
+			return fmt.Errorf("%w: %s", ErrIncorrectPassphrase, st.Message())
+		}
+	}
+
+	return err
+}
+
+// StatusErrorServerInterceptor converts the errors returned by the handlers with ToStatusError
+func StatusErrorServerInterceptor() grpc.ServerOption {
+	return grpc.ChainUnaryInterceptor(func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		res, err := handler(ctx, req)
+		return res, ToStatusError(err)
+	})
+}
+
+// StatusErrorClientInterceptor converts the errors of the calls with FromStatusError
+func StatusErrorClientInterceptor() grpc.DialOption {
+	return grpc.WithChainUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return FromStatusError(invoker(ctx, method, req, reply, cc, opts...))
+	})
+}
@@ -26,18 +26,18 @@ var GetDaemonInfoCmd = cli.Command{
 	ShortName: "gi",
 	Usage:     "Get information of the running daemon.",
 	Action:    getInfo,
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		cli.StringFlag{
 			Name:  fpdDaemonAddressFlag,
 			Usage: "The RPC server address of fpd",
 			Value: defaultFpdDaemonAddress,
 		},
-	},
+	}, rpcAuthFlags...),
 }
 
 func getInfo(ctx *cli.Context) error {
 	daemonAddress := ctx.String(fpdDaemonAddressFlag)
-	client, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress)
+	client, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress, rpcAuthConfigFromFlags(ctx))
 	if err != nil {
 		return err
 	}
@@ -58,7 +58,7 @@ var CreateFpDaemonCmd = cli.Command{
 	Name:      "create-finality-provider",
 	ShortName: "cfp",
 	Usage:     "Create a finality provider object and save it in database.",
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		cli.StringFlag{
 			Name:  fpdDaemonAddressFlag,
 			Usage: "The RPC server address of fpd",
@@ -118,7 +118,7 @@ var CreateFpDaemonCmd = cli.Command{
 			Usage: "Other optional details",
 			Value: "",
 		},
-	},
+	}, rpcAuthFlags...),
 	Action: createFpDaemon,
 }
 
@@ -140,7 +140,7 @@ func createFpDaemon(ctx *cli.Context) error {
 		return fmt.Errorf("not able to load key name: %w", err)
 	}
 
-	client, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress)
+	client, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress, rpcAuthConfigFromFlags(ctx))
 	if err != nil {
 		return err
 	}
@@ -183,18 +183,18 @@ var LsFpDaemonCmd = cli.Command{
 	ShortName: "ls",
 	Usage:     "List finality providers stored in the database.",
 	Action:    lsFpDaemon,
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		cli.StringFlag{
 			Name:  fpdDaemonAddressFlag,
 			Usage: "The RPC server address of fpd",
 			Value: defaultFpdDaemonAddress,
 		},
-	},
+	}, rpcAuthFlags...),
 }
 
 func lsFpDaemon(ctx *cli.Context) error {
 	daemonAddress := ctx.String(fpdDaemonAddressFlag)
-	rpcClient, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress)
+	rpcClient, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress, rpcAuthConfigFromFlags(ctx))
 	if err != nil {
 		return err
 	}
@@ -214,7 +214,7 @@ var FpInfoDaemonCmd = cli.Command{
 	Name:      "finality-provider-info",
 	ShortName: "fpi",
 	Usage:     "Show the information of the finality provider.",
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		cli.StringFlag{
 			Name:  fpdDaemonAddressFlag,
 			Usage: "The RPC server address of fpd",
@@ -225,13 +225,13 @@ var FpInfoDaemonCmd = cli.Command{
 			Usage:    "The hex string of the BTC public key",
 			Required: true,
 		},
-	},
+	}, rpcAuthFlags...),
 	Action: fpInfoDaemon,
 }
 
 func fpInfoDaemon(ctx *cli.Context) error {
 	daemonAddress := ctx.String(fpdDaemonAddressFlag)
-	rpcClient, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress)
+	rpcClient, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress, rpcAuthConfigFromFlags(ctx))
 	if err != nil {
 		return err
 	}
@@ -252,12 +252,107 @@ func fpInfoDaemon(ctx *cli.Context) error {
 	return nil
 }
 
+var VotesDaemonCmd = cli.Command{
+	Name:  "votes",
+	Usage: "Show the votes of the finality provider recorded in the vote journal, starting from the given height.",
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name:  fpdDaemonAddressFlag,
+			Usage: "The RPC server address of fpd",
+			Value: defaultFpdDaemonAddress,
+		},
+		cli.StringFlag{
+			Name:     fpBTCPkFlag,
+			Usage:    "The hex string of the BTC public key",
+			Required: true,
+		},
+		cli.Uint64Flag{
+			Name:  startHeightFlag,
+			Usage: "The lowest height of the shown votes; use the next height of the previous output to show the next page",
+		},
+		cli.UintFlag{
+			Name:  limitFlag,
+			Usage: "The maximum number of the shown votes; the limit of fpd is used if it is zero",
+		},
+	}, rpcAuthFlags...),
+	Action: votesDaemon,
+}
+
+func votesDaemon(ctx *cli.Context) error {
+	daemonAddress := ctx.String(fpdDaemonAddressFlag)
+	rpcClient, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress, rpcAuthConfigFromFlags(ctx))
+	if err != nil {
+		return err
+	}
+	defer cleanUp()
+
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(ctx.String(fpBTCPkFlag))
+	if err != nil {
+		return err
+	}
+
+	resp, err := rpcClient.QueryVotes(
+		context.Background(),
+		fpPk,
+		ctx.Uint64(startHeightFlag),
+		uint32(ctx.Uint(limitFlag)),
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var LivenessDaemonCmd = cli.Command{
+	Name:  "liveness",
+	Usage: "Show the uptime and the missed heights of the finality provider over the latest processed heights.",
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name:  fpdDaemonAddressFlag,
+			Usage: "The RPC server address of fpd",
+			Value: defaultFpdDaemonAddress,
+		},
+		cli.StringFlag{
+			Name:     fpBTCPkFlag,
+			Usage:    "The hex string of the BTC public key",
+			Required: true,
+		},
+	}, rpcAuthFlags...),
+	Action: livenessDaemon,
+}
+
+func livenessDaemon(ctx *cli.Context) error {
+	daemonAddress := ctx.String(fpdDaemonAddressFlag)
+	rpcClient, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress, rpcAuthConfigFromFlags(ctx))
+	if err != nil {
+		return err
+	}
+	defer cleanUp()
+
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(ctx.String(fpBTCPkFlag))
+	if err != nil {
+		return err
+	}
+
+	resp, err := rpcClient.QueryLiveness(context.Background(), fpPk)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp.Liveness)
+
+	return nil
+}
+
 var RegisterFpDaemonCmd = cli.Command{
 	Name:      "register-finality-provider",
 	ShortName: "rfp",
 	Usage:     "Register a created finality provider to Babylon.",
 	UsageText: fmt.Sprintf("register-finality-provider --%s [btc-pk]", fpBTCPkFlag),
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		cli.StringFlag{
 			Name:  fpdDaemonAddressFlag,
 			Usage: "The RPC server address of fpd",
@@ -273,7 +368,11 @@ var RegisterFpDaemonCmd = cli.Command{
 			Usage: "The pass phrase used to encrypt the keys",
 			Value: defaultPassphrase,
 		},
-	},
+		cli.BoolFlag{
+			Name:  autoStartFlag,
+			Usage: "Start the finality provider once the epoch in which it is registered is BTC timestamped",
+		},
+	}, rpcAuthFlags...),
 	Action: registerFp,
 }
 
@@ -285,13 +384,13 @@ func registerFp(ctx *cli.Context) error {
 	}
 
 	daemonAddress := ctx.String(fpdDaemonAddressFlag)
-	rpcClient, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress)
+	rpcClient, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress, rpcAuthConfigFromFlags(ctx))
 	if err != nil {
 		return err
 	}
 	defer cleanUp()
 
-	res, err := rpcClient.RegisterFinalityProvider(context.Background(), fpPk, ctx.String(passphraseFlag))
+	res, err := rpcClient.RegisterFinalityProvider(context.Background(), fpPk, ctx.String(passphraseFlag), ctx.Bool(autoStartFlag))
 	if err != nil {
 		return err
 	}
@@ -308,7 +407,7 @@ var AddFinalitySigDaemonCmd = cli.Command{
 	ShortName: "afs",
 	Usage:     "Send a finality signature to the consumer chain. This command should only be used for presentation/testing purposes",
 	UsageText: fmt.Sprintf("add-finality-sig --%s [btc_pk_hex]", fpBTCPkFlag),
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		cli.StringFlag{
 			Name:  fpdDaemonAddressFlag,
 			Usage: "The RPC server address of fpd",
@@ -329,13 +428,13 @@ var AddFinalitySigDaemonCmd = cli.Command{
 			Usage: "The last commit hash of the chain block",
 			Value: defaultAppHashStr,
 		},
-	},
+	}, rpcAuthFlags...),
 	Action: addFinalitySig,
 }
 
 func addFinalitySig(ctx *cli.Context) error {
 	daemonAddress := ctx.String(fpdDaemonAddressFlag)
-	rpcClient, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress)
+	rpcClient, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress, rpcAuthConfigFromFlags(ctx))
 	if err != nil {
 		return err
 	}
@@ -32,7 +32,7 @@ var ExportFinalityProvider = cli.Command{
 	Usage:     "It exports the finality provider by the given BTC public key.",
 	Description: `Fetches the finality provider from the database and exports it
 	by printing the json structure on the stdout.`,
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		cli.StringFlag{
 			Name:  fpdDaemonAddressFlag,
 			Usage: "The RPC server address of fpd",
@@ -68,13 +68,13 @@ var ExportFinalityProvider = cli.Command{
 			Usage: "The hd path used to derive the private key",
 			Value: defaultHdPath,
 		},
-	},
+	}, rpcAuthFlags...),
 	Action: exportFp,
 }
 
 func exportFp(ctx *cli.Context) error {
 	daemonAddress := ctx.String(fpdDaemonAddressFlag)
-	client, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress)
+	client, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress, rpcAuthConfigFromFlags(ctx))
 	if err != nil {
 		return fmt.Errorf("failled to connect to daemon addr %s: %w", daemonAddress, err)
 	}
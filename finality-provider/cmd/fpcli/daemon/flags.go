@@ -1,18 +1,29 @@
 package daemon
 
+import (
+	"github.com/urfave/cli"
+
+	"github.com/babylonchain/finality-provider/rpcauth"
+)
+
 const (
-	fpdDaemonAddressFlag = "daemon-address"
-	keyNameFlag          = "key-name"
-	homeFlag             = "home"
-	fpBTCPkFlag          = "btc-pk"
-	blockHeightFlag      = "height"
-	appHashFlag          = "app-hash"
-	passphraseFlag       = "passphrase"
-	hdPathFlag           = "hd-path"
-	chainIdFlag          = "chain-id"
-	signedFlag           = "signed"
-	defaultPassphrase    = ""
-	defaultHdPath        = ""
+	fpdDaemonAddressFlag  = "daemon-address"
+	keyNameFlag           = "key-name"
+	homeFlag              = "home"
+	fpBTCPkFlag           = "btc-pk"
+	blockHeightFlag       = "height"
+	appHashFlag           = "app-hash"
+	passphraseFlag        = "passphrase"
+	hdPathFlag            = "hd-path"
+	chainIdFlag           = "chain-id"
+	signedFlag            = "signed"
+	passphraseFdFlag      = "passphrase-fd"
+	passphraseFileEnvFlag = "passphrase-file-env"
+	autoStartFlag         = "auto-start"
+	startHeightFlag       = "start-height"
+	limitFlag             = "limit"
+	defaultPassphrase     = ""
+	defaultHdPath         = ""
 
 	// flags for description
 	monikerFlag         = "moniker"
@@ -22,3 +33,57 @@ const (
 	detailsFlag         = "details"
 	commissionRateFlag  = "commission"
 )
+
+const (
+	// flags for the transport security and authentication of the RPC connection
+	tlsCACertFlag     = "tls-ca-cert"
+	tlsServerNameFlag = "tls-server-name"
+	tlsCertFlag       = "tls-cert"
+	tlsKeyFlag        = "tls-key"
+	authTokenFileFlag = "auth-token-file"
+)
+
+var rpcAuthFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  tlsCACertFlag,
+		Usage: "Path to the CA certificate verifying the fpd certificate; TLS is disabled if empty",
+	},
+	cli.StringFlag{
+		Name:  tlsServerNameFlag,
+		Usage: "The server name expected in the fpd certificate; the host of the daemon address is used if empty",
+	},
+	cli.StringFlag{
+		Name:  tlsCertFlag,
+		Usage: "Path to the TLS client certificate for mutual TLS",
+	},
+	cli.StringFlag{
+		Name:  tlsKeyFlag,
+		Usage: "Path to the TLS client private key for mutual TLS",
+	},
+	cli.StringFlag{
+		Name:  authTokenFileFlag,
+		Usage: "Path to the file containing the bearer token sent to fpd; requires TLS",
+	},
+}
+
+// passphraseSourceFlags select where the passphrase of the EOTS key is read from
+var passphraseSourceFlags = []cli.Flag{
+	cli.UintFlag{
+		Name:  passphraseFdFlag,
+		Usage: "The file descriptor to read the passphrase from, e.g., 3 with 3<secret",
+	},
+	cli.StringFlag{
+		Name:  passphraseFileEnvFlag,
+		Usage: "The environment variable holding the path to the file containing the passphrase",
+	},
+}
+
+func rpcAuthConfigFromFlags(ctx *cli.Context) *rpcauth.ClientConfig {
+	return &rpcauth.ClientConfig{
+		TLSCACertPath: ctx.String(tlsCACertFlag),
+		TLSServerName: ctx.String(tlsServerNameFlag),
+		TLSCertPath:   ctx.String(tlsCertFlag),
+		TLSKeyPath:    ctx.String(tlsKeyFlag),
+		AuthTokenPath: ctx.String(authTokenFileFlag),
+	}
+}
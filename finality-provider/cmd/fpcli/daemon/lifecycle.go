@@ -0,0 +1,109 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+
+	bbntypes "github.com/babylonchain/babylon/types"
+	"github.com/urfave/cli"
+
+	dc "github.com/babylonchain/finality-provider/finality-provider/service/client"
+)
+
+var fpLifecycleFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  fpdDaemonAddressFlag,
+		Usage: "The RPC server address of fpd",
+		Value: defaultFpdDaemonAddress,
+	},
+	cli.StringFlag{
+		Name:     fpBTCPkFlag,
+		Usage:    "The hex string of the finality provider BTC public key",
+		Required: true,
+	},
+}
+
+var StopFpDaemonCmd = cli.Command{
+	Name:      "stop-finality-provider",
+	ShortName: "stop",
+	Usage:     "Stop a running finality provider, which is started again by resume or the restart of fpd.",
+	UsageText: fmt.Sprintf("stop-finality-provider --%s [btc-pk]", fpBTCPkFlag),
+	Flags:     append(append([]cli.Flag{}, fpLifecycleFlags...), rpcAuthFlags...),
+	Action:    stopFp,
+}
+
+var PauseFpDaemonCmd = cli.Command{
+	Name:      "pause-finality-provider",
+	ShortName: "pause",
+	Usage:     "Pause a finality provider from signing blocks, while it keeps processing them.",
+	UsageText: fmt.Sprintf("pause-finality-provider --%s [btc-pk]", fpBTCPkFlag),
+	Flags:     append(append([]cli.Flag{}, fpLifecycleFlags...), rpcAuthFlags...),
+	Action:    pauseFp,
+}
+
+var ResumeFpDaemonCmd = cli.Command{
+	Name:      "resume-finality-provider",
+	ShortName: "resume",
+	Usage:     "Resume signing blocks of a paused finality provider, and start it if it is stopped.",
+	UsageText: fmt.Sprintf("resume-finality-provider --%s [btc-pk]", fpBTCPkFlag),
+	Description: `If the stopped finality provider has an encrypted EOTS key, the passphrase is
+	read from the file descriptor given by --passphrase-fd, or from the secret file whose
+	path is in the environment variable given by --passphrase-file-env.`,
+	Flags:  append(append(append([]cli.Flag{}, fpLifecycleFlags...), passphraseSourceFlags...), rpcAuthFlags...),
+	Action: resumeFp,
+}
+
+func stopFp(ctx *cli.Context) error {
+	return runFpLifecycleCmd(ctx, func(client *dc.FinalityProviderServiceGRpcClient, fpPk *bbntypes.BIP340PubKey) error {
+		_, err := client.StopFinalityProvider(context.Background(), fpPk)
+		return err
+	})
+}
+
+func pauseFp(ctx *cli.Context) error {
+	return runFpLifecycleCmd(ctx, func(client *dc.FinalityProviderServiceGRpcClient, fpPk *bbntypes.BIP340PubKey) error {
+		_, err := client.PauseFinalityProvider(context.Background(), fpPk)
+		return err
+	})
+}
+
+func resumeFp(ctx *cli.Context) error {
+	passphrase, _, err := readPassphraseFromSecret(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read the passphrase: %w", err)
+	}
+
+	return runFpLifecycleCmd(ctx, func(client *dc.FinalityProviderServiceGRpcClient, fpPk *bbntypes.BIP340PubKey) error {
+		_, err := client.ResumeFinalityProvider(context.Background(), fpPk, passphrase)
+		return err
+	})
+}
+
+// runFpLifecycleCmd runs the lifecycle action on the finality provider given by the flags
+// and prints the updated information of the finality provider
+func runFpLifecycleCmd(ctx *cli.Context, action func(*dc.FinalityProviderServiceGRpcClient, *bbntypes.BIP340PubKey) error) error {
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(ctx.String(fpBTCPkFlag))
+	if err != nil {
+		return fmt.Errorf("invalid BTC public key: %w", err)
+	}
+
+	daemonAddress := ctx.String(fpdDaemonAddressFlag)
+	client, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress, rpcAuthConfigFromFlags(ctx))
+	if err != nil {
+		return err
+	}
+	defer cleanUp()
+
+	if err := action(client, fpPk); err != nil {
+		return err
+	}
+
+	res, err := client.QueryFinalityProviderInfo(context.Background(), fpPk)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(res)
+
+	return nil
+}
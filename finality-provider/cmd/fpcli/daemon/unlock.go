@@ -0,0 +1,134 @@
+package daemon
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	bbntypes "github.com/babylonchain/babylon/types"
+	"github.com/urfave/cli"
+	"golang.org/x/term"
+
+	dc "github.com/babylonchain/finality-provider/finality-provider/service/client"
+)
+
+// UnlockFpDaemonCmd starts a LOCKED finality provider with the passphrase of its EOTS key
+// the passphrase is never taken from the command line, where it would be exposed to
+// other users of the host through the process list and the shell history
+var UnlockFpDaemonCmd = cli.Command{
+	Name:      "unlock-finality-provider",
+	ShortName: "unlock",
+	Usage:     "Unlock a LOCKED finality provider with the passphrase of its EOTS key.",
+	UsageText: fmt.Sprintf("unlock-finality-provider --%s [btc-pk]", fpBTCPkFlag),
+	Description: `The passphrase is read from the file descriptor given by --passphrase-fd,
+	or from the secret file whose path is in the environment variable given by
+	--passphrase-file-env. Otherwise, it is prompted on the terminal.`,
+	Flags: append(append([]cli.Flag{
+		cli.StringFlag{
+			Name:  fpdDaemonAddressFlag,
+			Usage: "The RPC server address of fpd",
+			Value: defaultFpdDaemonAddress,
+		},
+		cli.StringFlag{
+			Name:     fpBTCPkFlag,
+			Usage:    "The hex string of the finality provider BTC public key",
+			Required: true,
+		},
+	}, passphraseSourceFlags...), rpcAuthFlags...),
+	Action: unlockFp,
+}
+
+func unlockFp(ctx *cli.Context) error {
+	fpPkStr := ctx.String(fpBTCPkFlag)
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(fpPkStr)
+	if err != nil {
+		return fmt.Errorf("invalid BTC public key: %w", err)
+	}
+
+	passphrase, err := readPassphrase(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read the passphrase: %w", err)
+	}
+
+	daemonAddress := ctx.String(fpdDaemonAddressFlag)
+	rpcClient, cleanUp, err := dc.NewFinalityProviderServiceGRpcClient(daemonAddress, rpcAuthConfigFromFlags(ctx))
+	if err != nil {
+		return err
+	}
+	defer cleanUp()
+
+	if _, err := rpcClient.UnlockFinalityProvider(context.Background(), fpPk, passphrase); err != nil {
+		return err
+	}
+
+	res, err := rpcClient.QueryFinalityProviderInfo(context.Background(), fpPk)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(res)
+
+	return nil
+}
+
+// readPassphrase reads the passphrase from the file descriptor, the secret file
+// referenced by the environment variable, or the terminal prompt, in that order
+func readPassphrase(ctx *cli.Context) (string, error) {
+	passphrase, ok, err := readPassphraseFromSecret(ctx)
+	if err != nil || ok {
+		return passphrase, err
+	}
+
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", fmt.Errorf("the stdin is not a terminal, use --%s or --%s instead", passphraseFdFlag, passphraseFileEnvFlag)
+	}
+	fmt.Fprint(os.Stderr, "Enter the passphrase of the EOTS key: ")
+	prompted, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", err
+	}
+
+	return string(prompted), nil
+}
+
+// readPassphraseFromSecret reads the passphrase from the file descriptor or
+// the secret file referenced by the environment variable if any of them is set
+func readPassphraseFromSecret(ctx *cli.Context) (string, bool, error) {
+	if ctx.IsSet(passphraseFdFlag) && ctx.IsSet(passphraseFileEnvFlag) {
+		return "", false, fmt.Errorf("only one of --%s and --%s can be set", passphraseFdFlag, passphraseFileEnvFlag)
+	}
+
+	if ctx.IsSet(passphraseFdFlag) {
+		f := os.NewFile(uintptr(ctx.Uint(passphraseFdFlag)), "passphrase-fd")
+		if f == nil {
+			return "", false, fmt.Errorf("invalid file descriptor %d", ctx.Uint(passphraseFdFlag))
+		}
+		defer f.Close()
+
+		line, err := bufio.NewReader(f).ReadString('\n')
+		if err != nil && err != io.EOF {
+			return "", false, err
+		}
+		return strings.TrimRight(line, "\r\n"), true, nil
+	}
+
+	if ctx.IsSet(passphraseFileEnvFlag) {
+		envName := ctx.String(passphraseFileEnvFlag)
+		path := os.Getenv(envName)
+		if path == "" {
+			return "", false, fmt.Errorf("the environment variable %s is not set", envName)
+		}
+		secret, err := os.ReadFile(path)
+		if err != nil {
+			return "", false, err
+		}
+		return strings.TrimRight(string(secret), "\r\n"), true, nil
+	}
+
+	return "", false, nil
+}
@@ -23,7 +23,13 @@ func main() {
 		dcli.CreateFpDaemonCmd,
 		dcli.LsFpDaemonCmd,
 		dcli.FpInfoDaemonCmd,
+		dcli.VotesDaemonCmd,
+		dcli.LivenessDaemonCmd,
 		dcli.RegisterFpDaemonCmd,
+		dcli.UnlockFpDaemonCmd,
+		dcli.StopFpDaemonCmd,
+		dcli.PauseFpDaemonCmd,
+		dcli.ResumeFpDaemonCmd,
 		dcli.AddFinalitySigDaemonCmd,
 		dcli.ExportFinalityProvider,
 	)
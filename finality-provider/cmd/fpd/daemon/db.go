@@ -0,0 +1,97 @@
+package daemon
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/urfave/cli"
+	"go.uber.org/zap"
+
+	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
+	"github.com/babylonchain/finality-provider/finality-provider/store"
+	"github.com/babylonchain/finality-provider/migration"
+	"github.com/babylonchain/finality-provider/util"
+)
+
+var DBCommands = []cli.Command{
+	{
+		Name:     "db",
+		Usage:    "Command sets of managing the finality-provider database.",
+		Category: "Database",
+		Subcommands: []cli.Command{
+			MigrateDBCmd,
+		},
+	},
+}
+
+var MigrateDBCmd = cli.Command{
+	Name:  "migrate",
+	Usage: "Migrate the finality-provider database to the latest schema version.",
+	Description: `Apply the pending migrations of the database in order. The database is
+	copied to a backup file next to it before each migration. The migrations are also
+	applied when fpd is started, which should be stopped before migrating`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  homeFlag,
+			Usage: "The path to the finality-provider home directory",
+			Value: fpcfg.DefaultFpdDir,
+		},
+		cli.BoolFlag{
+			Name:  dryRunFlag,
+			Usage: "Apply the pending migrations without saving the changes, to check that they succeed",
+		},
+	},
+	Action: migrateDB,
+}
+
+func migrateDB(ctx *cli.Context) error {
+	homePath, err := filepath.Abs(ctx.String(homeFlag))
+	if err != nil {
+		return err
+	}
+	homePath = util.CleanAndExpandPath(homePath)
+
+	cfg, err := fpcfg.LoadConfig(homePath)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	dbBackend, err := cfg.DatabaseConfig.GetDbBackend()
+	if err != nil {
+		return fmt.Errorf("failed to create db backend: %w", err)
+	}
+	defer dbBackend.Close()
+
+	var res *migration.Result
+	if ctx.Bool(dryRunFlag) {
+		res, err = migration.DryRun(dbBackend, store.Migrations())
+	} else {
+		res, err = migration.Run(dbBackend, store.Migrations(), migration.BackupPath(cfg.DatabaseConfig.DBPath, cfg.DatabaseConfig.DBFileName))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to migrate the db: %w", err)
+	}
+
+	printRespJSON(res)
+
+	return nil
+}
+
+// migrateDBOnStart applies the pending migrations of the
+// database before the finality-provider app is loaded
+func migrateDBOnStart(db kvdb.Backend, cfg *fpcfg.DBConfig, logger *zap.Logger) error {
+	res, err := migration.Run(db, store.Migrations(), migration.BackupPath(cfg.DBPath, cfg.DBFileName))
+	if err != nil {
+		return fmt.Errorf("failed to migrate the db: %w", err)
+	}
+
+	if len(res.Applied) > 0 {
+		logger.Info("the db is migrated",
+			zap.Uint32("from_version", res.FromVersion),
+			zap.Uint32("to_version", res.ToVersion),
+			zap.Strings("backups", res.Backups))
+	}
+
+	return nil
+}
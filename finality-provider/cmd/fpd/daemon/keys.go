@@ -112,7 +112,7 @@ func addKey(ctx *cli.Context) error {
 		return fmt.Errorf("failed to create the chain key: %w", err)
 	}
 
-	printRespJSON(
+	printRespJSONKeys(
 		KeyOutput{
 			Name:     keyName,
 			Address:  keyInfo.AccAddress.String(),
@@ -141,6 +141,16 @@ func printRespJSON(resp interface{}) {
 		return
 	}
 
+	fmt.Printf("%s\n", jsonBytes)
+}
+
+func printRespJSONKeys(resp interface{}) {
+	jsonBytes, err := json.MarshalIndent(resp, "", "    ")
+	if err != nil {
+		fmt.Println("unable to decode response: ", err)
+		return
+	}
+
 	fmt.Printf("New key for the consumer chain is created "+
 		"(mnemonic should be kept in a safe place for recovery):\n%s\n", jsonBytes)
 }
@@ -1,6 +1,7 @@
 package daemon
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"path/filepath"
@@ -75,6 +76,10 @@ func start(ctx *cli.Context) error {
 		return fmt.Errorf("failed to create db backend: %w", err)
 	}
 
+	if err := migrateDBOnStart(dbBackend, cfg.DatabaseConfig, logger); err != nil {
+		return err
+	}
+
 	fpApp, err := loadApp(ctx, logger, cfg, dbBackend)
 	if err != nil {
 		return fmt.Errorf("failed to load app: %w", err)
@@ -107,7 +112,7 @@ func loadApp(
 	}
 
 	// sync finality-provider status
-	if err := fpApp.SyncFinalityProviderStatus(); err != nil {
+	if err := fpApp.SyncFinalityProviderStatus(context.Background()); err != nil {
 		return nil, fmt.Errorf("failed to sync finality-provider status: %w", err)
 	}
 
@@ -133,10 +138,10 @@ func startApp(
 			return fmt.Errorf("invalid finality-provider public key %s: %w", fpPkStr, err)
 		}
 
-		if err := fpApp.StartHandlingFinalityProvider(fpPk, ctx.String(passphraseFlag)); err != nil {
+		if err := fpApp.StartHandlingFinalityProviderWhenFinalized(context.Background(), fpPk, ctx.String(passphraseFlag)); err != nil {
 			return fmt.Errorf("failed to start the finality-provider instance %s: %w", fpPkStr, err)
 		}
 	}
 
-	return fpApp.StartHandlingAll()
+	return fpApp.StartHandlingAll(context.Background())
 }
@@ -0,0 +1,42 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	defaultBatchWindow  = 500 * time.Millisecond
+	defaultMaxBatchSize = uint32(50)
+)
+
+// SubmissionAggregatorConfig is the config of the aggregator which packs the
+// finality signatures of all the finality providers at the same height into
+// a single transaction
+type SubmissionAggregatorConfig struct {
+	Enabled      bool          `long:"enabled" description:"Whether to submit the finality signatures of all the finality providers at the same height in a single transaction"`
+	BatchWindow  time.Duration `long:"batchwindow" description:"The duration to wait for the finality signatures of the other finality providers after the first one of a height is received"`
+	MaxBatchSize uint32        `long:"maxbatchsize" description:"The maximum number of finality signatures in a single transaction, which is submitted without waiting for the batch window once reached"`
+}
+
+func DefaultSubmissionAggregatorConfig() SubmissionAggregatorConfig {
+	return SubmissionAggregatorConfig{
+		Enabled:      false,
+		BatchWindow:  defaultBatchWindow,
+		MaxBatchSize: defaultMaxBatchSize,
+	}
+}
+
+func (cfg *SubmissionAggregatorConfig) Validate() error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.BatchWindow < 0 {
+		return fmt.Errorf("batch window should not be negative")
+	}
+	if cfg.MaxBatchSize == 0 {
+		return fmt.Errorf("max batch size should be positive")
+	}
+
+	return nil
+}
@@ -1,9 +1,16 @@
 package config
 
 import (
+	"fmt"
 	"time"
 
 	bbncfg "github.com/babylonchain/babylon/client/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	defaultMaxGasPrices   = "0.02ubbn"
+	defaultGasPriceBlocks = 10
 )
 
 type BBNConfig struct {
@@ -21,6 +28,14 @@ type BBNConfig struct {
 	BlockTimeout   time.Duration `long:"block-timeout" description:"block timeout when waiting for block events"`
 	OutputFormat   string        `long:"output-format" description:"default output when printint responses"`
 	SignModeStr    string        `long:"sign-mode" description:"sign mode to use"`
+	// the gas prices are between GasPrices and MaxGasPrices if DynamicGasPrices is set
+	DynamicGasPrices bool   `long:"dynamic-gas-prices" description:"flag to set the gas prices to the median gas price of the transactions in the recent blocks"`
+	MaxGasPrices     string `long:"max-gas-prices" description:"maximum gas prices when using the dynamic gas prices"`
+	GasPriceBlocks   uint64 `long:"gas-price-blocks" description:"number of the recent blocks to compute the dynamic gas prices from"`
+	// the finality signatures are submitted on behalf of the Granter through x/authz
+	// if it is set, and the fees are paid by the FeeGranter through x/feegrant if it is set
+	Granter    string `long:"granter" description:"address of the account which grants the key the permission to submit finality signatures through x/authz"`
+	FeeGranter string `long:"fee-granter" description:"address of the account which grants the key a fee allowance through x/feegrant"`
 }
 
 func DefaultBBNConfig() BBNConfig {
@@ -42,7 +57,56 @@ func DefaultBBNConfig() BBNConfig {
 		BlockTimeout: 1 * time.Minute,
 		OutputFormat: dc.OutputFormat,
 		SignModeStr:  dc.SignModeStr,
+
+		MaxGasPrices:   defaultMaxGasPrices,
+		GasPriceBlocks: defaultGasPriceBlocks,
+	}
+}
+
+// GasPriceBounds returns the minimum and the maximum gas prices
+// of the dynamic gas prices, which are in the same denom
+func (bc *BBNConfig) GasPriceBounds() (sdk.DecCoin, sdk.DecCoin, error) {
+	minPrice, err := sdk.ParseDecCoin(bc.GasPrices)
+	if err != nil {
+		return sdk.DecCoin{}, sdk.DecCoin{}, fmt.Errorf("invalid gas prices %s: %w", bc.GasPrices, err)
+	}
+	maxPrice, err := sdk.ParseDecCoin(bc.MaxGasPrices)
+	if err != nil {
+		return sdk.DecCoin{}, sdk.DecCoin{}, fmt.Errorf("invalid max gas prices %s: %w", bc.MaxGasPrices, err)
+	}
+	if minPrice.Denom != maxPrice.Denom {
+		return sdk.DecCoin{}, sdk.DecCoin{}, fmt.Errorf("the gas prices and the max gas prices should be in the same denom")
 	}
+	if maxPrice.IsLT(minPrice) {
+		return sdk.DecCoin{}, sdk.DecCoin{}, fmt.Errorf("the max gas prices should not be less than the gas prices")
+	}
+
+	return minPrice, maxPrice, nil
+}
+
+func (bc *BBNConfig) Validate() error {
+	if bc.Granter != "" {
+		if _, err := sdk.GetFromBech32(bc.Granter, bc.AccountPrefix); err != nil {
+			return fmt.Errorf("invalid granter address %s: %w", bc.Granter, err)
+		}
+	}
+	if bc.FeeGranter != "" {
+		if _, err := sdk.GetFromBech32(bc.FeeGranter, bc.AccountPrefix); err != nil {
+			return fmt.Errorf("invalid fee granter address %s: %w", bc.FeeGranter, err)
+		}
+	}
+
+	if !bc.DynamicGasPrices {
+		return nil
+	}
+	if bc.GasPriceBlocks == 0 {
+		return fmt.Errorf("the number of blocks of the dynamic gas prices should be positive")
+	}
+	if _, _, err := bc.GasPriceBounds(); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 func BBNConfigToBabylonConfig(bc *BBNConfig) bbncfg.BabylonConfig {
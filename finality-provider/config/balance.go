@@ -0,0 +1,66 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	defaultBalanceMonitorInterval = 1 * time.Minute
+	defaultLowBalance             = "10000000ubbn"
+	defaultCriticalBalance        = "1000000ubbn"
+)
+
+// BalanceMonitorConfig is the config of the monitor of the balance of
+// the account paying for the transactions of the finality providers
+type BalanceMonitorConfig struct {
+	Interval        time.Duration `long:"interval" description:"The interval between each check of the balance of the account paying for the transactions, which is disabled if the value is 0"`
+	LowBalance      string        `long:"lowbalance" description:"The balance below which a warning is logged, e.g., 10000000ubbn"`
+	CriticalBalance string        `long:"criticalbalance" description:"The balance below which an error is logged as the finality providers are about to stop voting, e.g., 1000000ubbn"`
+}
+
+func DefaultBalanceMonitorConfig() BalanceMonitorConfig {
+	return BalanceMonitorConfig{
+		Interval:        defaultBalanceMonitorInterval,
+		LowBalance:      defaultLowBalance,
+		CriticalBalance: defaultCriticalBalance,
+	}
+}
+
+// Thresholds returns the low and the critical balances
+func (cfg *BalanceMonitorConfig) Thresholds() (sdk.Coin, sdk.Coin, error) {
+	low, err := sdk.ParseCoinNormalized(cfg.LowBalance)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("invalid low balance %s: %w", cfg.LowBalance, err)
+	}
+	critical, err := sdk.ParseCoinNormalized(cfg.CriticalBalance)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("invalid critical balance %s: %w", cfg.CriticalBalance, err)
+	}
+
+	return low, critical, nil
+}
+
+func (cfg *BalanceMonitorConfig) Validate() error {
+	if cfg.Interval < 0 {
+		return fmt.Errorf("interval should not be negative")
+	}
+	if cfg.Interval == 0 {
+		return nil
+	}
+
+	low, critical, err := cfg.Thresholds()
+	if err != nil {
+		return err
+	}
+	if low.Denom != critical.Denom {
+		return fmt.Errorf("the low balance and the critical balance should be in the same denom")
+	}
+	if low.IsLT(critical) {
+		return fmt.Errorf("the low balance should not be less than the critical balance")
+	}
+
+	return nil
+}
@@ -12,7 +12,9 @@ import (
 	"github.com/jessevdk/go-flags"
 
 	eotscfg "github.com/babylonchain/finality-provider/eotsmanager/config"
+	eotstypes "github.com/babylonchain/finality-provider/eotsmanager/types"
 	"github.com/babylonchain/finality-provider/metrics"
+	"github.com/babylonchain/finality-provider/rpcauth"
 	"github.com/babylonchain/finality-provider/util"
 )
 
@@ -29,14 +31,18 @@ const (
 	defaultMinRandHeightGap        = 20
 	defaultStatusUpdateInterval    = 20 * time.Second
 	defaultRandomInterval          = 30 * time.Second
-	defaultSubmitRetryInterval     = 1 * time.Second
 	defaultFastSyncInterval        = 10 * time.Second
 	defaultFastSyncLimit           = 10
 	defaultFastSyncGap             = 3
-	defaultMaxSubmissionRetries    = 20
 	defaultBitcoinNetwork          = "signet"
 	defaultDataDirname             = "data"
 	defaultMaxNumFinalityProviders = 3
+	defaultMaxRestartAttempts      = 5
+	defaultRestartBackoff          = 30 * time.Second
+	defaultMaxRestartBackoff       = 10 * time.Minute
+	defaultAutoStartInterval       = 30 * time.Second
+	defaultMasterRandVersion       = 1
+	opStackL2ChainName             = "opstackl2"
 )
 
 var (
@@ -55,19 +61,24 @@ var (
 type Config struct {
 	LogLevel string `long:"loglevel" description:"Logging level for all subsystems" choice:"trace" choice:"debug" choice:"info" choice:"warn" choice:"error" choice:"fatal"`
 	// ChainName and ChainID (if any) of the chain config identify a consumer chain
-	ChainName                string        `long:"chainname" description:"the name of the consumer chain" choice:"babylon"`
+	ChainName                string        `long:"chainname" description:"the name of the consumer chain" choice:"babylon" choice:"opstackl2"`
 	NumPubRand               uint64        `long:"numPubRand" description:"The number of Schnorr public randomness for each commitment"`
 	NumPubRandMax            uint64        `long:"numpubrandmax" description:"The upper bound of the number of Schnorr public randomness for each commitment"`
 	MinRandHeightGap         uint64        `long:"minrandheightgap" description:"The minimum gap between the last committed rand height and the current Babylon block height"`
 	StatusUpdateInterval     time.Duration `long:"statusupdateinterval" description:"The interval between each update of finality-provider status"`
 	RandomnessCommitInterval time.Duration `long:"randomnesscommitinterval" description:"The interval between each attempt to commit public randomness"`
-	SubmissionRetryInterval  time.Duration `long:"submissionretryinterval" description:"The interval between each attempt to submit finality signature or public randomness after a failure"`
-	MaxSubmissionRetries     uint64        `long:"maxsubmissionretries" description:"The maximum number of retries to submit finality signature or public randomness"`
+	SubmissionRetryInterval  time.Duration `long:"submissionretryinterval" description:"Deprecated: use the initial and max delays of the submission retry policy instead; if set, the fixed interval between each attempt to submit finality signature after a failure"`
+	MaxSubmissionRetries     uint64        `long:"maxsubmissionretries" description:"Deprecated: use the attempts of the submission retry policy instead; if set, the maximum number of retries to submit finality signature"`
 	FastSyncInterval         time.Duration `long:"fastsyncinterval" description:"The interval between each try of fast sync, which is disabled if the value is 0"`
 	FastSyncLimit            uint64        `long:"fastsynclimit" description:"The maximum number of blocks to catch up for each fast sync"`
 	FastSyncGap              uint64        `long:"fastsyncgap" description:"The block gap that will trigger the fast sync"`
 	EOTSManagerAddress       string        `long:"eotsmanageraddress" description:"The address of the remote EOTS manager; Empty if the EOTS manager is running locally"`
 	MaxNumFinalityProviders  uint32        `long:"maxnumfinalityproviders" description:"The maximum number of finality-provider instances running concurrently within the daemon"`
+	MaxRestartAttempts       uint32        `long:"maxrestartattempts" description:"The maximum number of attempts to restart a finality-provider instance stopped by a critical error, which is disabled if the value is 0"`
+	RestartBackoff           time.Duration `long:"restartbackoff" description:"The delay before the first restart of a finality-provider instance stopped by a critical error, which doubles after each failed attempt"`
+	MaxRestartBackoff        time.Duration `long:"maxrestartbackoff" description:"The upper bound of the delay between restarts of a finality-provider instance"`
+	AutoStartInterval        time.Duration `long:"autostartinterval" description:"The interval between each attempt to start the finality providers waiting for their registered epochs to be BTC timestamped, which is disabled if the value is 0"`
+	MasterRandVersion        uint32        `long:"masterrandversion" description:"The version of the master randomness of the newly created finality providers; 1 derives the randomness at 32-bit heights and is the one verified by Babylon, 2 derives it at 64-bit heights and requires the consumer chain to support it"`
 
 	BitcoinNetwork string `long:"bitcoinnetwork" description:"Bitcoin network to run on" choise:"mainnet" choice:"regtest" choice:"testnet" choice:"simnet" choice:"signet"`
 
@@ -75,12 +86,34 @@ type Config struct {
 
 	PollerConfig *ChainPollerConfig `group:"chainpollerconfig" namespace:"chainpollerconfig"`
 
+	QueryRetry *RetryPolicy `group:"queryretry" namespace:"queryretry"`
+
+	SubmissionRetry *RetryPolicy `group:"submissionretry" namespace:"submissionretry"`
+
+	RandomnessCommitRetry *RetryPolicy `group:"randomnesscommitretry" namespace:"randomnesscommitretry"`
+
+	BalanceMonitor *BalanceMonitorConfig `group:"balancemonitor" namespace:"balancemonitor"`
+
+	SubmissionAggregator *SubmissionAggregatorConfig `group:"submissionaggregator" namespace:"submissionaggregator"`
+
+	VoteJournal *VoteJournalConfig `group:"votejournal" namespace:"votejournal"`
+
+	Liveness *LivenessConfig `group:"liveness" namespace:"liveness"`
+
+	VoteVerifier *VoteVerifierConfig `group:"voteverifier" namespace:"voteverifier"`
+
 	DatabaseConfig *DBConfig `group:"dbconfig" namespace:"dbconfig"`
 
 	BabylonConfig *BBNConfig `group:"babylon" namespace:"babylon"`
 
+	OPStackL2Config *OPStackL2Config `group:"opstackl2" namespace:"opstackl2"`
+
+	EOTSManagerAuth *rpcauth.ClientConfig `group:"eotsmanagerauth" namespace:"eotsmanagerauth"`
+
 	RpcListener string `long:"rpclistener" description:"the listener for RPC connections, e.g., 127.0.0.1:1234"`
 
+	RPCAuth *rpcauth.ServerConfig `group:"rpcauth" namespace:"rpcauth"`
+
 	Metrics *metrics.Config `group:"metrics" namespace:"metrics"`
 }
 
@@ -89,28 +122,51 @@ func DefaultConfigWithHome(homePath string) Config {
 	bbnCfg.Key = defaultFinalityProviderKeyName
 	bbnCfg.KeyDirectory = homePath
 	pollerCfg := DefaultChainPollerConfig()
+	opStackL2Cfg := DefaultOPStackL2Config()
+	queryRetry := DefaultQueryRetryPolicy()
+	submissionRetry := DefaultSubmissionRetryPolicy()
+	randomnessCommitRetry := DefaultRandomnessCommitRetryPolicy()
+	balanceMonitorCfg := DefaultBalanceMonitorConfig()
+	aggregatorCfg := DefaultSubmissionAggregatorConfig()
+	voteJournalCfg := DefaultVoteJournalConfig()
+	livenessCfg := DefaultLivenessConfig()
+	voteVerifierCfg := DefaultVoteVerifierConfig()
 	cfg := Config{
 		ChainName:                defaultChainName,
 		LogLevel:                 defaultLogLevel,
 		DatabaseConfig:           DefaultDBConfigWithHomePath(homePath),
 		BabylonConfig:            &bbnCfg,
+		OPStackL2Config:          &opStackL2Cfg,
 		PollerConfig:             &pollerCfg,
+		QueryRetry:               &queryRetry,
+		SubmissionRetry:          &submissionRetry,
+		RandomnessCommitRetry:    &randomnessCommitRetry,
+		BalanceMonitor:           &balanceMonitorCfg,
+		SubmissionAggregator:     &aggregatorCfg,
+		VoteJournal:              &voteJournalCfg,
+		Liveness:                 &livenessCfg,
+		VoteVerifier:             &voteVerifierCfg,
 		NumPubRand:               defaultNumPubRand,
 		NumPubRandMax:            defaultNumPubRandMax,
 		MinRandHeightGap:         defaultMinRandHeightGap,
 		StatusUpdateInterval:     defaultStatusUpdateInterval,
 		RandomnessCommitInterval: defaultRandomInterval,
-		SubmissionRetryInterval:  defaultSubmitRetryInterval,
 		FastSyncInterval:         defaultFastSyncInterval,
 		FastSyncLimit:            defaultFastSyncLimit,
 		FastSyncGap:              defaultFastSyncGap,
-		MaxSubmissionRetries:     defaultMaxSubmissionRetries,
 		BitcoinNetwork:           defaultBitcoinNetwork,
 		BTCNetParams:             defaultBTCNetParams,
 		EOTSManagerAddress:       defaultEOTSManagerAddress,
 		RpcListener:              DefaultRpcListener,
 		MaxNumFinalityProviders:  defaultMaxNumFinalityProviders,
+		MaxRestartAttempts:       defaultMaxRestartAttempts,
+		RestartBackoff:           defaultRestartBackoff,
+		MaxRestartBackoff:        defaultMaxRestartBackoff,
+		AutoStartInterval:        defaultAutoStartInterval,
+		MasterRandVersion:        defaultMasterRandVersion,
 		Metrics:                  metrics.DefaultFpConfig(),
+		EOTSManagerAuth:          rpcauth.DefaultClientConfig(),
+		RPCAuth:                  rpcauth.DefaultServerConfig(),
 	}
 
 	if err := cfg.Validate(); err != nil {
@@ -157,8 +213,10 @@ func LoadConfig(homePath string) (*Config, error) {
 			"not exist in %s", cfgFile)
 	}
 
-	// Next, load any additional configuration options from the file.
-	var cfg Config
+	// Next, load any additional configuration options from the file, which
+	// overwrite the defaults, so that the options and sections missing from
+	// the config files created by previous releases keep their defaults
+	cfg := DefaultConfigWithHome(homePath)
 	fileParser := flags.NewParser(&cfg, flags.Default)
 	err := flags.NewIniParser(fileParser).ParseFile(cfgFile)
 	if err != nil {
@@ -198,11 +256,127 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("invalid network: %v", cfg.BitcoinNetwork)
 	}
 
+	if err := eotstypes.MasterRandVersion(cfg.MasterRandVersion).Validate(); err != nil {
+		return fmt.Errorf("invalid master randomness version: %w", err)
+	}
+
 	_, err := net.ResolveTCPAddr("tcp", cfg.RpcListener)
 	if err != nil {
 		return fmt.Errorf("invalid RPC listener address %s, %w", cfg.RpcListener, err)
 	}
 
+	if cfg.MaxRestartAttempts > 0 {
+		if cfg.RestartBackoff <= 0 {
+			return fmt.Errorf("restart backoff should be positive")
+		}
+		if cfg.MaxRestartBackoff < cfg.RestartBackoff {
+			return fmt.Errorf("max restart backoff should not be less than the restart backoff")
+		}
+	}
+
+	if err := cfg.PollerConfig.Validate(); err != nil {
+		return fmt.Errorf("invalid poller config: %w", err)
+	}
+
+	if cfg.QueryRetry == nil {
+		return fmt.Errorf("empty query retry policy")
+	}
+
+	if err := cfg.QueryRetry.Validate(); err != nil {
+		return fmt.Errorf("invalid query retry policy: %w", err)
+	}
+
+	if cfg.SubmissionRetry == nil {
+		return fmt.Errorf("empty submission retry policy")
+	}
+
+	// the deprecated submission options of the config files created
+	// before the submission retry policy still take effect through it
+	if cfg.SubmissionRetryInterval < 0 {
+		return fmt.Errorf("submission retry interval should not be negative")
+	}
+	if cfg.SubmissionRetryInterval > 0 {
+		cfg.SubmissionRetry.InitialDelay = cfg.SubmissionRetryInterval
+		cfg.SubmissionRetry.MaxDelay = cfg.SubmissionRetryInterval
+	}
+	if cfg.MaxSubmissionRetries > 0 {
+		cfg.SubmissionRetry.Attempts = uint(cfg.MaxSubmissionRetries) + 1
+	}
+
+	if err := cfg.SubmissionRetry.Validate(); err != nil {
+		return fmt.Errorf("invalid submission retry policy: %w", err)
+	}
+
+	if cfg.RandomnessCommitRetry == nil {
+		return fmt.Errorf("empty randomness commit retry policy")
+	}
+
+	if err := cfg.RandomnessCommitRetry.Validate(); err != nil {
+		return fmt.Errorf("invalid randomness commit retry policy: %w", err)
+	}
+
+	if cfg.BalanceMonitor == nil {
+		return fmt.Errorf("empty balance monitor config")
+	}
+
+	if err := cfg.BalanceMonitor.Validate(); err != nil {
+		return fmt.Errorf("invalid balance monitor config: %w", err)
+	}
+
+	// the dynamic gas prices are updated at each balance check
+	if cfg.BabylonConfig != nil && cfg.BabylonConfig.DynamicGasPrices && cfg.BalanceMonitor.Interval == 0 {
+		return fmt.Errorf("the balance monitor should be enabled to update the dynamic gas prices")
+	}
+
+	if cfg.SubmissionAggregator == nil {
+		return fmt.Errorf("empty submission aggregator config")
+	}
+
+	if err := cfg.SubmissionAggregator.Validate(); err != nil {
+		return fmt.Errorf("invalid submission aggregator config: %w", err)
+	}
+
+	if cfg.VoteJournal == nil {
+		return fmt.Errorf("empty vote journal config")
+	}
+
+	if err := cfg.VoteJournal.Validate(); err != nil {
+		return fmt.Errorf("invalid vote journal config: %w", err)
+	}
+
+	if cfg.Liveness == nil {
+		return fmt.Errorf("empty liveness config")
+	}
+
+	if err := cfg.Liveness.Validate(); err != nil {
+		return fmt.Errorf("invalid liveness config: %w", err)
+	}
+
+	if cfg.VoteVerifier == nil {
+		return fmt.Errorf("empty vote verifier config")
+	}
+
+	if err := cfg.VoteVerifier.Validate(); err != nil {
+		return fmt.Errorf("invalid vote verifier config: %w", err)
+	}
+
+	if cfg.BabylonConfig == nil {
+		return fmt.Errorf("empty Babylon config")
+	}
+
+	if err := cfg.BabylonConfig.Validate(); err != nil {
+		return fmt.Errorf("invalid Babylon config: %w", err)
+	}
+
+	if cfg.ChainName == opStackL2ChainName {
+		if cfg.OPStackL2Config == nil {
+			return fmt.Errorf("empty OP-stack L2 config")
+		}
+		if err := cfg.OPStackL2Config.Validate(); err != nil {
+			return fmt.Errorf("invalid OP-stack L2 config: %w", err)
+		}
+	}
+
 	if cfg.Metrics == nil {
 		return fmt.Errorf("empty metrics config")
 	}
@@ -211,6 +385,22 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("invalid metrics config")
 	}
 
+	if cfg.EOTSManagerAuth == nil {
+		return fmt.Errorf("empty EOTS manager auth config")
+	}
+
+	if err := cfg.EOTSManagerAuth.Validate(); err != nil {
+		return fmt.Errorf("invalid EOTS manager auth config: %w", err)
+	}
+
+	if cfg.RPCAuth == nil {
+		return fmt.Errorf("empty RPC auth config")
+	}
+
+	if err := cfg.RPCAuth.Validate(); err != nil {
+		return fmt.Errorf("invalid RPC auth config: %w", err)
+	}
+
 	// All good, return the sanitized result.
 	return nil
 }
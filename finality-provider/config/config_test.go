@@ -0,0 +1,121 @@
+package config_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/finality-provider/finality-provider/config"
+)
+
+// TestLoadConfigWithMissingSections tests that the options and sections missing
+// from the config files created by previous releases keep their defaults
+func TestLoadConfigWithMissingSections(t *testing.T) {
+	homePath := t.TempDir()
+	cfgContent := `[Application Options]
+LogLevel = debug
+ChainName = babylon
+StatusUpdateInterval = 30s
+
+[chainpollerconfig]
+BufferSize = 500
+PollInterval = 5s
+
+[babylon]
+Key = my-finality-provider
+ChainID = chain-test
+`
+	err := os.WriteFile(config.ConfigFile(homePath), []byte(cfgContent), 0600)
+	require.NoError(t, err)
+
+	cfg, err := config.LoadConfig(homePath)
+	require.NoError(t, err)
+	require.Equal(t, "debug", cfg.LogLevel)
+	require.Equal(t, 30*time.Second, cfg.StatusUpdateInterval)
+	require.Equal(t, uint32(500), cfg.PollerConfig.BufferSize)
+	require.Equal(t, 5*time.Second, cfg.PollerConfig.PollInterval)
+	require.Equal(t, "my-finality-provider", cfg.BabylonConfig.Key)
+	require.Equal(t, "chain-test", cfg.BabylonConfig.ChainID)
+
+	// the options missing from the existing sections keep their defaults
+	defaultCfg := config.DefaultConfigWithHome(homePath)
+	require.Equal(t, config.ChainPollerModePolling, cfg.PollerConfig.Mode)
+	require.Equal(t, defaultCfg.PollerConfig.MaxReorgDepth, cfg.PollerConfig.MaxReorgDepth)
+	require.Equal(t, defaultCfg.BabylonConfig.RPCAddr, cfg.BabylonConfig.RPCAddr)
+
+	// the missing sections keep their defaults
+	require.Equal(t, defaultCfg.QueryRetry, cfg.QueryRetry)
+	require.Equal(t, defaultCfg.SubmissionRetry, cfg.SubmissionRetry)
+	require.Equal(t, defaultCfg.RandomnessCommitRetry, cfg.RandomnessCommitRetry)
+	require.Equal(t, defaultCfg.BalanceMonitor, cfg.BalanceMonitor)
+	require.Equal(t, defaultCfg.SubmissionAggregator, cfg.SubmissionAggregator)
+	require.Equal(t, defaultCfg.VoteJournal, cfg.VoteJournal)
+	require.Equal(t, defaultCfg.Liveness, cfg.Liveness)
+	require.Equal(t, defaultCfg.VoteVerifier, cfg.VoteVerifier)
+	require.Equal(t, defaultCfg.DatabaseConfig, cfg.DatabaseConfig)
+}
+
+// TestChainPollerConfigEmptyMode tests that the poller polls the chain
+// if the mode is not set
+func TestChainPollerConfigEmptyMode(t *testing.T) {
+	cfg := config.DefaultChainPollerConfig()
+	cfg.Mode = ""
+	err := cfg.Validate()
+	require.NoError(t, err)
+	require.Equal(t, config.ChainPollerModePolling, cfg.Mode)
+
+	cfg.Mode = "unknown"
+	err = cfg.Validate()
+	require.Error(t, err)
+}
+
+// TestChainPollerConfigMaxReorgDepth tests that the poller
+// cannot be configured without tracking any block
+func TestChainPollerConfigMaxReorgDepth(t *testing.T) {
+	cfg := config.DefaultChainPollerConfig()
+	cfg.MaxReorgDepth = 0
+	err := cfg.Validate()
+	require.Error(t, err)
+}
+
+// TestDynamicGasPricesWithoutBalanceMonitor tests that the dynamic gas
+// prices cannot be enabled without the balance monitor updating them
+func TestDynamicGasPricesWithoutBalanceMonitor(t *testing.T) {
+	cfg := config.DefaultConfigWithHome(t.TempDir())
+	cfg.BabylonConfig.DynamicGasPrices = true
+	cfg.BabylonConfig.MaxGasPrices = "0.02ubbn"
+	err := cfg.Validate()
+	require.NoError(t, err)
+
+	cfg.BalanceMonitor.Interval = 0
+	err = cfg.Validate()
+	require.Error(t, err)
+}
+
+// TestBBNConfigGranters tests that the granter addresses
+// should be Babylon addresses if they are set
+func TestBBNConfigGranters(t *testing.T) {
+	cfg := config.DefaultBBNConfig()
+	err := cfg.Validate()
+	require.NoError(t, err)
+
+	addr := sdk.MustBech32ifyAddressBytes(cfg.AccountPrefix, make([]byte, 20))
+	cfg.Granter = addr
+	cfg.FeeGranter = addr
+	err = cfg.Validate()
+	require.NoError(t, err)
+
+	// the addresses with another account prefix are rejected
+	otherAddr := sdk.MustBech32ifyAddressBytes("cosmos", make([]byte, 20))
+	cfg.Granter = otherAddr
+	err = cfg.Validate()
+	require.Error(t, err)
+
+	cfg.Granter = addr
+	cfg.FeeGranter = "invalid"
+	err = cfg.Validate()
+	require.Error(t, err)
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	defaultJournalPruneInterval   = 1 * time.Hour
+	defaultJournalRetentionPeriod = 30 * 24 * time.Hour
+	defaultJournalQueryLimit      = uint32(100)
+)
+
+// VoteJournalConfig is the config of the vote journal, which records the
+// votes of the finality providers and their inclusion status for auditability
+type VoteJournalConfig struct {
+	PruneInterval    time.Duration `long:"pruneinterval" description:"The interval between pruning the vote journal; pruning is disabled if it is zero"`
+	RetentionHeights uint64        `long:"retentionheights" description:"The number of heights below the last voted height of which the votes are kept; all the heights are kept if it is zero"`
+	RetentionPeriod  time.Duration `long:"retentionperiod" description:"The duration for which the votes are kept since submitted; the votes are kept forever if it is zero"`
+	QueryLimit       uint32        `long:"querylimit" description:"The maximum number of votes returned by a single query of the vote journal"`
+}
+
+func DefaultVoteJournalConfig() VoteJournalConfig {
+	return VoteJournalConfig{
+		PruneInterval:    defaultJournalPruneInterval,
+		RetentionHeights: 0,
+		RetentionPeriod:  defaultJournalRetentionPeriod,
+		QueryLimit:       defaultJournalQueryLimit,
+	}
+}
+
+func (cfg *VoteJournalConfig) Validate() error {
+	if cfg.PruneInterval < 0 {
+		return fmt.Errorf("prune interval should not be negative")
+	}
+	if cfg.RetentionPeriod < 0 {
+		return fmt.Errorf("retention period should not be negative")
+	}
+	if cfg.QueryLimit == 0 {
+		return fmt.Errorf("query limit should be positive")
+	}
+
+	return nil
+}
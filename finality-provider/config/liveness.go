@@ -0,0 +1,37 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	defaultLivenessInterval   = 1 * time.Minute
+	defaultLivenessWindowSize = uint64(1000)
+)
+
+// LivenessConfig is the config of the liveness tracker, which checks whether the
+// votes of the finality providers are recorded by the consumer chain at the
+// heights where they have voting power
+type LivenessConfig struct {
+	Interval   time.Duration `long:"interval" description:"The interval between the checks of the liveness; the liveness is not tracked if it is zero"`
+	WindowSize uint64        `long:"windowsize" description:"The number of the latest processed heights over which the liveness is tracked"`
+}
+
+func DefaultLivenessConfig() LivenessConfig {
+	return LivenessConfig{
+		Interval:   defaultLivenessInterval,
+		WindowSize: defaultLivenessWindowSize,
+	}
+}
+
+func (cfg *LivenessConfig) Validate() error {
+	if cfg.Interval < 0 {
+		return fmt.Errorf("interval should not be negative")
+	}
+	if cfg.WindowSize == 0 {
+		return fmt.Errorf("window size should be positive")
+	}
+
+	return nil
+}
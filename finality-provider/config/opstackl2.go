@@ -0,0 +1,54 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	defaultOPStackL2RPCAddress = "http://127.0.0.1:8545"
+	defaultOPStackL2Timeout    = 20 * time.Second
+)
+
+// OPStackL2Config is the config of an OP-stack L2 consumer chain
+// L2 blocks are read from the Ethereum JSON-RPC endpoint of an L2 node while
+// finality signatures are submitted to a CosmWasm finality contract deployed on Babylon
+// using the key and node configured in the Babylon config
+type OPStackL2Config struct {
+	RPCAddress              string        `long:"rpc-address" description:"the Ethereum JSON-RPC address of the OP-stack L2 node to connect to"`
+	FinalityContractAddress string        `long:"finality-contract-address" description:"the address of the finality contract to submit finality signatures to"`
+	Timeout                 time.Duration `long:"timeout" description:"client timeout when doing queries"`
+}
+
+func DefaultOPStackL2Config() OPStackL2Config {
+	return OPStackL2Config{
+		RPCAddress: defaultOPStackL2RPCAddress,
+		Timeout:    defaultOPStackL2Timeout,
+	}
+}
+
+func (cfg *OPStackL2Config) Validate() error {
+	u, err := url.Parse(cfg.RPCAddress)
+	if err != nil {
+		return fmt.Errorf("invalid OP-stack L2 RPC address %s: %w", cfg.RPCAddress, err)
+	}
+	switch u.Scheme {
+	case "http", "https", "ws", "wss":
+	default:
+		return fmt.Errorf("invalid OP-stack L2 RPC address %s: the scheme should be http, https, ws or wss", cfg.RPCAddress)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("invalid OP-stack L2 RPC address %s: the host is not specified", cfg.RPCAddress)
+	}
+
+	if cfg.FinalityContractAddress == "" {
+		return fmt.Errorf("the finality contract address is not specified")
+	}
+
+	if cfg.Timeout <= 0 {
+		return fmt.Errorf("the timeout should be positive")
+	}
+
+	return nil
+}
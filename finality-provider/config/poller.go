@@ -1,11 +1,27 @@
 package config
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// ChainPollerModePolling polls the blocks of the consumer chain at the poll interval
+	ChainPollerModePolling = "polling"
+	// ChainPollerModeSubscription receives the blocks of the consumer chain from
+	// the new block events and falls back to polling if the subscription is lost
+	ChainPollerModeSubscription = "subscription"
+)
 
 var (
-	defaultBufferSize        = uint32(1000)
-	defaultPollingInterval   = 20 * time.Second
-	defaultStaticStartHeight = uint64(1)
+	defaultBufferSize              = uint32(1000)
+	defaultPollingInterval         = 20 * time.Second
+	defaultStaticStartHeight       = uint64(1)
+	defaultSubscriptionTimeout     = 1 * time.Minute
+	defaultMaxReorgDepth           = uint64(100)
+	defaultMaxFailedCycles         = uint32(20)
+	defaultPollerRestartBackoff    = 30 * time.Second
+	defaultPollerMaxRestartBackoff = 10 * time.Minute
 )
 
 type ChainPollerConfig struct {
@@ -13,6 +29,12 @@ type ChainPollerConfig struct {
 	PollInterval                   time.Duration `long:"pollinterval" description:"The interval between each polling of Babylon blocks"`
 	StaticChainScanningStartHeight uint64        `long:"staticchainscanningstartheight" description:"The static height from which we start polling the chain"`
 	AutoChainScanningMode          bool          `long:"autochainscanningmode" description:"Automatically discover the height from which to start polling the chain"`
+	Mode                           string        `long:"mode" description:"The mode to receive the blocks, either polling the chain at the poll interval or subscribing to the new block events of the chain" choice:"polling" choice:"subscription"`
+	SubscriptionTimeout            time.Duration `long:"subscriptiontimeout" description:"The maximum duration without any new block event before the poller falls back to polling the chain, only used in the subscription mode"`
+	MaxReorgDepth                  uint64        `long:"maxreorgdepth" description:"The number of the latest polled blocks whose hashes are tracked to detect chain reorgs, which is the maximum depth of a detectable reorg"`
+	MaxFailedCycles                uint32        `long:"maxfailedcycles" description:"The maximum number of consecutive polling cycles failing to retrieve any block before the poller reports an error to the finality providers and restarts"`
+	RestartBackoff                 time.Duration `long:"restartbackoff" description:"The delay before the poller polls the chain again after reaching the max failed cycles, which doubles after each restart without retrieving any block"`
+	MaxRestartBackoff              time.Duration `long:"maxrestartbackoff" description:"The upper bound of the delay before the poller polls the chain again after reaching the max failed cycles"`
 }
 
 func DefaultChainPollerConfig() ChainPollerConfig {
@@ -21,5 +43,36 @@ func DefaultChainPollerConfig() ChainPollerConfig {
 		PollInterval:                   defaultPollingInterval,
 		StaticChainScanningStartHeight: defaultStaticStartHeight,
 		AutoChainScanningMode:          true,
+		Mode:                           ChainPollerModePolling,
+		SubscriptionTimeout:            defaultSubscriptionTimeout,
+		MaxReorgDepth:                  defaultMaxReorgDepth,
+		MaxFailedCycles:                defaultMaxFailedCycles,
+		RestartBackoff:                 defaultPollerRestartBackoff,
+		MaxRestartBackoff:              defaultPollerMaxRestartBackoff,
+	}
+}
+
+func (cfg *ChainPollerConfig) Validate() error {
+	switch cfg.Mode {
+	case "":
+		// the config files created before the mode was introduced poll the chain
+		cfg.Mode = ChainPollerModePolling
+	case ChainPollerModePolling:
+	case ChainPollerModeSubscription:
+		if cfg.SubscriptionTimeout <= 0 {
+			return fmt.Errorf("subscription timeout should be positive")
+		}
+	default:
+		return fmt.Errorf("invalid poller mode: %v", cfg.Mode)
 	}
+
+	if cfg.MaxReorgDepth == 0 {
+		return fmt.Errorf("max reorg depth should be positive")
+	}
+
+	if cfg.RestartBackoff <= 0 || cfg.MaxRestartBackoff < cfg.RestartBackoff {
+		return fmt.Errorf("the restart backoff should be positive and no more than the max restart backoff")
+	}
+
+	return nil
 }
@@ -0,0 +1,94 @@
+package config
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+var (
+	defaultQueryRetryAttempts          = uint(5)
+	defaultQueryRetryInitialDelay      = 400 * time.Millisecond
+	defaultQueryRetryMaxDelay          = 10 * time.Second
+	defaultSubmissionRetryAttempts     = uint(20)
+	defaultSubmissionRetryInitialDelay = 1 * time.Second
+	defaultSubmissionRetryMaxDelay     = 30 * time.Second
+	defaultRandCommitRetryAttempts     = uint(3)
+	defaultRandCommitRetryInitialDelay = 1 * time.Second
+	defaultRandCommitRetryMaxDelay     = 10 * time.Second
+	defaultRetryMaxJitter              = 100 * time.Millisecond
+)
+
+// RetryPolicy defines how a failed request is retried, the delay between
+// attempts grows exponentially from the initial delay up to the max delay
+// with a random jitter
+type RetryPolicy struct {
+	Attempts     uint          `long:"attempts" description:"The maximum number of attempts of a request"`
+	InitialDelay time.Duration `long:"initialdelay" description:"The delay after the first failed attempt, which doubles after each failed attempt"`
+	MaxDelay     time.Duration `long:"maxdelay" description:"The upper bound of the delay between attempts"`
+	MaxJitter    time.Duration `long:"maxjitter" description:"The upper bound of the random jitter added to each delay, which is disabled if the value is 0"`
+}
+
+// DefaultQueryRetryPolicy returns the retry policy of the queries to the consumer chain
+func DefaultQueryRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		Attempts:     defaultQueryRetryAttempts,
+		InitialDelay: defaultQueryRetryInitialDelay,
+		MaxDelay:     defaultQueryRetryMaxDelay,
+		MaxJitter:    defaultRetryMaxJitter,
+	}
+}
+
+// DefaultSubmissionRetryPolicy returns the retry policy of the finality signature submissions
+func DefaultSubmissionRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		Attempts:     defaultSubmissionRetryAttempts,
+		InitialDelay: defaultSubmissionRetryInitialDelay,
+		MaxDelay:     defaultSubmissionRetryMaxDelay,
+		MaxJitter:    defaultRetryMaxJitter,
+	}
+}
+
+// DefaultRandomnessCommitRetryPolicy returns the retry policy of the commitments of
+// public randomness, which are committed along with the finality provider registration
+func DefaultRandomnessCommitRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		Attempts:     defaultRandCommitRetryAttempts,
+		InitialDelay: defaultRandCommitRetryInitialDelay,
+		MaxDelay:     defaultRandCommitRetryMaxDelay,
+		MaxJitter:    defaultRetryMaxJitter,
+	}
+}
+
+// Delay returns the delay before the next attempt after the given number of failed attempts
+func (p *RetryPolicy) Delay(failedAttempts uint) time.Duration {
+	delay := p.InitialDelay
+	for i := uint(1); i < failedAttempts && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if p.MaxJitter > 0 {
+		delay += time.Duration(rand.Int63n(int64(p.MaxJitter)))
+	}
+
+	return delay
+}
+
+func (p *RetryPolicy) Validate() error {
+	if p.Attempts == 0 {
+		return fmt.Errorf("attempts should be positive")
+	}
+	if p.InitialDelay < 0 {
+		return fmt.Errorf("initial delay should not be negative")
+	}
+	if p.MaxDelay < p.InitialDelay {
+		return fmt.Errorf("max delay should not be less than the initial delay")
+	}
+	if p.MaxJitter < 0 {
+		return fmt.Errorf("max jitter should not be negative")
+	}
+
+	return nil
+}
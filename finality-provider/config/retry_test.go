@@ -0,0 +1,59 @@
+package config_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/finality-provider/finality-provider/config"
+)
+
+// TestRetryPolicyDelay tests that the delay doubles after each failed
+// attempt up to the max delay, with a jitter below the max jitter
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := config.RetryPolicy{
+		Attempts:     10,
+		InitialDelay: time.Second,
+		MaxDelay:     5 * time.Second,
+	}
+	require.Equal(t, time.Second, policy.Delay(1))
+	require.Equal(t, 2*time.Second, policy.Delay(2))
+	require.Equal(t, 4*time.Second, policy.Delay(3))
+	require.Equal(t, 5*time.Second, policy.Delay(4))
+	require.Equal(t, 5*time.Second, policy.Delay(100))
+
+	policy.MaxJitter = 100 * time.Millisecond
+	for i := uint(1); i <= 10; i++ {
+		delay := policy.Delay(i)
+		require.GreaterOrEqual(t, delay, time.Second)
+		require.Less(t, delay, policy.MaxDelay+policy.MaxJitter)
+	}
+}
+
+// TestDeprecatedSubmissionRetryOptions tests that the submission options
+// of the config files created before the submission retry policy are still
+// loaded and take effect through the policy
+func TestDeprecatedSubmissionRetryOptions(t *testing.T) {
+	homePath := t.TempDir()
+	cfg := config.DefaultConfigWithHome(homePath)
+	defaultPolicy := *cfg.SubmissionRetry
+	err := cfg.Validate()
+	require.NoError(t, err)
+	require.Equal(t, defaultPolicy, *cfg.SubmissionRetry)
+
+	cfgContent := `[Application Options]
+ChainName = babylon
+SubmissionRetryInterval = 3s
+MaxSubmissionRetries = 4
+`
+	err = os.WriteFile(config.ConfigFile(homePath), []byte(cfgContent), 0600)
+	require.NoError(t, err)
+
+	loadedCfg, err := config.LoadConfig(homePath)
+	require.NoError(t, err)
+	require.Equal(t, uint(5), loadedCfg.SubmissionRetry.Attempts)
+	require.Equal(t, 3*time.Second, loadedCfg.SubmissionRetry.InitialDelay)
+	require.Equal(t, 3*time.Second, loadedCfg.SubmissionRetry.MaxDelay)
+}
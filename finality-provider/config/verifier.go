@@ -0,0 +1,40 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	defaultVoteVerifierInterval         = 20 * time.Second
+	defaultVoteVerifierBatchSize        = uint32(100)
+	defaultVoteVerifierMaxResubmissions = uint32(3)
+)
+
+// VoteVerifierConfig is the config of the verifier, which checks whether the
+// included votes are recorded by the consumer chain and resubmits the ones
+// which are not while their blocks are not finalized
+type VoteVerifierConfig struct {
+	Interval         time.Duration `long:"interval" description:"The interval between the verifications of the included votes; the votes are not verified if it is zero"`
+	BatchSize        uint32        `long:"batchsize" description:"The maximum number of votes of a finality provider verified at each interval"`
+	MaxResubmissions uint32        `long:"maxresubmissions" description:"The maximum number of times an unconfirmed vote is resubmitted"`
+}
+
+func DefaultVoteVerifierConfig() VoteVerifierConfig {
+	return VoteVerifierConfig{
+		Interval:         defaultVoteVerifierInterval,
+		BatchSize:        defaultVoteVerifierBatchSize,
+		MaxResubmissions: defaultVoteVerifierMaxResubmissions,
+	}
+}
+
+func (cfg *VoteVerifierConfig) Validate() error {
+	if cfg.Interval < 0 {
+		return fmt.Errorf("interval should not be negative")
+	}
+	if cfg.BatchSize == 0 {
+		return fmt.Errorf("batch size should be positive")
+	}
+
+	return nil
+}
@@ -53,5 +53,23 @@ func NewFinalityProviderInfo(sfp *FinalityProvider) (*FinalityProviderInfo, erro
 		MasterPubRand:   sfp.MasterPubRand,
 		LastVotedHeight: sfp.LastVotedHeight,
 		Status:          sfp.Status.String(),
+		ErrorReason:     sfp.ErrorReason,
+		IsPaused:        sfp.Paused,
+	}, nil
+}
+
+func NewVoteInfo(vr *VoteRecord) (*VoteInfo, error) {
+	btcPk, err := bbn.NewBIP340PubKey(vr.BtcPk)
+	if err != nil {
+		return nil, err
+	}
+	return &VoteInfo{
+		BtcPkHex:     btcPk.MarshalHex(),
+		Height:       vr.Height,
+		BlockHashHex: hex.EncodeToString(vr.BlockHash),
+		SignatureHex: hex.EncodeToString(vr.Signature),
+		TxHash:       vr.TxHash,
+		SubmittedAt:  vr.SubmittedAt,
+		Status:       vr.Status.String(),
 	}, nil
 }
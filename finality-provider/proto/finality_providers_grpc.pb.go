@@ -23,8 +23,14 @@ const (
 	FinalityProviders_CreateFinalityProvider_FullMethodName    = "/proto.FinalityProviders/CreateFinalityProvider"
 	FinalityProviders_RegisterFinalityProvider_FullMethodName  = "/proto.FinalityProviders/RegisterFinalityProvider"
 	FinalityProviders_AddFinalitySignature_FullMethodName      = "/proto.FinalityProviders/AddFinalitySignature"
+	FinalityProviders_UnlockFinalityProvider_FullMethodName    = "/proto.FinalityProviders/UnlockFinalityProvider"
+	FinalityProviders_StopFinalityProvider_FullMethodName      = "/proto.FinalityProviders/StopFinalityProvider"
+	FinalityProviders_PauseFinalityProvider_FullMethodName     = "/proto.FinalityProviders/PauseFinalityProvider"
+	FinalityProviders_ResumeFinalityProvider_FullMethodName    = "/proto.FinalityProviders/ResumeFinalityProvider"
 	FinalityProviders_QueryFinalityProvider_FullMethodName     = "/proto.FinalityProviders/QueryFinalityProvider"
 	FinalityProviders_QueryFinalityProviderList_FullMethodName = "/proto.FinalityProviders/QueryFinalityProviderList"
+	FinalityProviders_QueryVotes_FullMethodName                = "/proto.FinalityProviders/QueryVotes"
+	FinalityProviders_QueryLiveness_FullMethodName             = "/proto.FinalityProviders/QueryLiveness"
 	FinalityProviders_SignMessageFromChainKey_FullMethodName   = "/proto.FinalityProviders/SignMessageFromChainKey"
 )
 
@@ -42,10 +48,27 @@ type FinalityProvidersClient interface {
 	// AddFinalitySignature sends a transactions to the consumer chain to add a Finality
 	// signature for a block
 	AddFinalitySignature(ctx context.Context, in *AddFinalitySignatureRequest, opts ...grpc.CallOption) (*AddFinalitySignatureResponse, error)
+	// UnlockFinalityProvider starts a LOCKED finality provider
+	// with the passphrase of its EOTS key
+	UnlockFinalityProvider(ctx context.Context, in *UnlockFinalityProviderRequest, opts ...grpc.CallOption) (*UnlockFinalityProviderResponse, error)
+	// StopFinalityProvider stops the running finality provider, which is
+	// started again by ResumeFinalityProvider or the restart of the daemon
+	StopFinalityProvider(ctx context.Context, in *StopFinalityProviderRequest, opts ...grpc.CallOption) (*StopFinalityProviderResponse, error)
+	// PauseFinalityProvider stops the finality provider from signing blocks
+	// while it keeps processing them, until it is resumed
+	PauseFinalityProvider(ctx context.Context, in *PauseFinalityProviderRequest, opts ...grpc.CallOption) (*PauseFinalityProviderResponse, error)
+	// ResumeFinalityProvider resumes signing blocks of the paused finality provider
+	// and starts it if it is stopped
+	ResumeFinalityProvider(ctx context.Context, in *ResumeFinalityProviderRequest, opts ...grpc.CallOption) (*ResumeFinalityProviderResponse, error)
 	// QueryFinalityProvider queries the finality provider
 	QueryFinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error)
 	// QueryFinalityProviderList queries a list of finality providers
 	QueryFinalityProviderList(ctx context.Context, in *QueryFinalityProviderListRequest, opts ...grpc.CallOption) (*QueryFinalityProviderListResponse, error)
+	// QueryVotes queries the votes of the finality provider in the vote journal
+	QueryVotes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error)
+	// QueryLiveness queries the liveness of the finality provider
+	// over the latest processed heights
+	QueryLiveness(ctx context.Context, in *QueryLivenessRequest, opts ...grpc.CallOption) (*QueryLivenessResponse, error)
 	// SignMessageFromChainKey signs a message from the chain keyring.
 	SignMessageFromChainKey(ctx context.Context, in *SignMessageFromChainKeyRequest, opts ...grpc.CallOption) (*SignMessageFromChainKeyResponse, error)
 }
@@ -94,6 +117,42 @@ func (c *finalityProvidersClient) AddFinalitySignature(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *finalityProvidersClient) UnlockFinalityProvider(ctx context.Context, in *UnlockFinalityProviderRequest, opts ...grpc.CallOption) (*UnlockFinalityProviderResponse, error) {
+	out := new(UnlockFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, FinalityProviders_UnlockFinalityProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finalityProvidersClient) StopFinalityProvider(ctx context.Context, in *StopFinalityProviderRequest, opts ...grpc.CallOption) (*StopFinalityProviderResponse, error) {
+	out := new(StopFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, FinalityProviders_StopFinalityProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finalityProvidersClient) PauseFinalityProvider(ctx context.Context, in *PauseFinalityProviderRequest, opts ...grpc.CallOption) (*PauseFinalityProviderResponse, error) {
+	out := new(PauseFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, FinalityProviders_PauseFinalityProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finalityProvidersClient) ResumeFinalityProvider(ctx context.Context, in *ResumeFinalityProviderRequest, opts ...grpc.CallOption) (*ResumeFinalityProviderResponse, error) {
+	out := new(ResumeFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, FinalityProviders_ResumeFinalityProvider_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *finalityProvidersClient) QueryFinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error) {
 	out := new(QueryFinalityProviderResponse)
 	err := c.cc.Invoke(ctx, FinalityProviders_QueryFinalityProvider_FullMethodName, in, out, opts...)
@@ -112,6 +171,24 @@ func (c *finalityProvidersClient) QueryFinalityProviderList(ctx context.Context,
 	return out, nil
 }
 
+func (c *finalityProvidersClient) QueryVotes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error) {
+	out := new(QueryVotesResponse)
+	err := c.cc.Invoke(ctx, FinalityProviders_QueryVotes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *finalityProvidersClient) QueryLiveness(ctx context.Context, in *QueryLivenessRequest, opts ...grpc.CallOption) (*QueryLivenessResponse, error) {
+	out := new(QueryLivenessResponse)
+	err := c.cc.Invoke(ctx, FinalityProviders_QueryLiveness_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *finalityProvidersClient) SignMessageFromChainKey(ctx context.Context, in *SignMessageFromChainKeyRequest, opts ...grpc.CallOption) (*SignMessageFromChainKeyResponse, error) {
 	out := new(SignMessageFromChainKeyResponse)
 	err := c.cc.Invoke(ctx, FinalityProviders_SignMessageFromChainKey_FullMethodName, in, out, opts...)
@@ -135,10 +212,27 @@ type FinalityProvidersServer interface {
 	// AddFinalitySignature sends a transactions to the consumer chain to add a Finality
 	// signature for a block
 	AddFinalitySignature(context.Context, *AddFinalitySignatureRequest) (*AddFinalitySignatureResponse, error)
+	// UnlockFinalityProvider starts a LOCKED finality provider
+	// with the passphrase of its EOTS key
+	UnlockFinalityProvider(context.Context, *UnlockFinalityProviderRequest) (*UnlockFinalityProviderResponse, error)
+	// StopFinalityProvider stops the running finality provider, which is
+	// started again by ResumeFinalityProvider or the restart of the daemon
+	StopFinalityProvider(context.Context, *StopFinalityProviderRequest) (*StopFinalityProviderResponse, error)
+	// PauseFinalityProvider stops the finality provider from signing blocks
+	// while it keeps processing them, until it is resumed
+	PauseFinalityProvider(context.Context, *PauseFinalityProviderRequest) (*PauseFinalityProviderResponse, error)
+	// ResumeFinalityProvider resumes signing blocks of the paused finality provider
+	// and starts it if it is stopped
+	ResumeFinalityProvider(context.Context, *ResumeFinalityProviderRequest) (*ResumeFinalityProviderResponse, error)
 	// QueryFinalityProvider queries the finality provider
 	QueryFinalityProvider(context.Context, *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error)
 	// QueryFinalityProviderList queries a list of finality providers
 	QueryFinalityProviderList(context.Context, *QueryFinalityProviderListRequest) (*QueryFinalityProviderListResponse, error)
+	// QueryVotes queries the votes of the finality provider in the vote journal
+	QueryVotes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error)
+	// QueryLiveness queries the liveness of the finality provider
+	// over the latest processed heights
+	QueryLiveness(context.Context, *QueryLivenessRequest) (*QueryLivenessResponse, error)
 	// SignMessageFromChainKey signs a message from the chain keyring.
 	SignMessageFromChainKey(context.Context, *SignMessageFromChainKeyRequest) (*SignMessageFromChainKeyResponse, error)
 	mustEmbedUnimplementedFinalityProvidersServer()
@@ -160,12 +254,30 @@ func (UnimplementedFinalityProvidersServer) RegisterFinalityProvider(context.Con
 func (UnimplementedFinalityProvidersServer) AddFinalitySignature(context.Context, *AddFinalitySignatureRequest) (*AddFinalitySignatureResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddFinalitySignature not implemented")
 }
+func (UnimplementedFinalityProvidersServer) UnlockFinalityProvider(context.Context, *UnlockFinalityProviderRequest) (*UnlockFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockFinalityProvider not implemented")
+}
+func (UnimplementedFinalityProvidersServer) StopFinalityProvider(context.Context, *StopFinalityProviderRequest) (*StopFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopFinalityProvider not implemented")
+}
+func (UnimplementedFinalityProvidersServer) PauseFinalityProvider(context.Context, *PauseFinalityProviderRequest) (*PauseFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseFinalityProvider not implemented")
+}
+func (UnimplementedFinalityProvidersServer) ResumeFinalityProvider(context.Context, *ResumeFinalityProviderRequest) (*ResumeFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeFinalityProvider not implemented")
+}
 func (UnimplementedFinalityProvidersServer) QueryFinalityProvider(context.Context, *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryFinalityProvider not implemented")
 }
 func (UnimplementedFinalityProvidersServer) QueryFinalityProviderList(context.Context, *QueryFinalityProviderListRequest) (*QueryFinalityProviderListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryFinalityProviderList not implemented")
 }
+func (UnimplementedFinalityProvidersServer) QueryVotes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryVotes not implemented")
+}
+func (UnimplementedFinalityProvidersServer) QueryLiveness(context.Context, *QueryLivenessRequest) (*QueryLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryLiveness not implemented")
+}
 func (UnimplementedFinalityProvidersServer) SignMessageFromChainKey(context.Context, *SignMessageFromChainKeyRequest) (*SignMessageFromChainKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignMessageFromChainKey not implemented")
 }
@@ -254,6 +366,78 @@ func _FinalityProviders_AddFinalitySignature_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FinalityProviders_UnlockFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockFinalityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinalityProvidersServer).UnlockFinalityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinalityProviders_UnlockFinalityProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinalityProvidersServer).UnlockFinalityProvider(ctx, req.(*UnlockFinalityProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinalityProviders_StopFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopFinalityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinalityProvidersServer).StopFinalityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinalityProviders_StopFinalityProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinalityProvidersServer).StopFinalityProvider(ctx, req.(*StopFinalityProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinalityProviders_PauseFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseFinalityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinalityProvidersServer).PauseFinalityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinalityProviders_PauseFinalityProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinalityProvidersServer).PauseFinalityProvider(ctx, req.(*PauseFinalityProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinalityProviders_ResumeFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeFinalityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinalityProvidersServer).ResumeFinalityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinalityProviders_ResumeFinalityProvider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinalityProvidersServer).ResumeFinalityProvider(ctx, req.(*ResumeFinalityProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FinalityProviders_QueryFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryFinalityProviderRequest)
 	if err := dec(in); err != nil {
@@ -290,6 +474,42 @@ func _FinalityProviders_QueryFinalityProviderList_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FinalityProviders_QueryVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinalityProvidersServer).QueryVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinalityProviders_QueryVotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinalityProvidersServer).QueryVotes(ctx, req.(*QueryVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FinalityProviders_QueryLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FinalityProvidersServer).QueryLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FinalityProviders_QueryLiveness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FinalityProvidersServer).QueryLiveness(ctx, req.(*QueryLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FinalityProviders_SignMessageFromChainKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SignMessageFromChainKeyRequest)
 	if err := dec(in); err != nil {
@@ -331,6 +551,22 @@ var FinalityProviders_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddFinalitySignature",
 			Handler:    _FinalityProviders_AddFinalitySignature_Handler,
 		},
+		{
+			MethodName: "UnlockFinalityProvider",
+			Handler:    _FinalityProviders_UnlockFinalityProvider_Handler,
+		},
+		{
+			MethodName: "StopFinalityProvider",
+			Handler:    _FinalityProviders_StopFinalityProvider_Handler,
+		},
+		{
+			MethodName: "PauseFinalityProvider",
+			Handler:    _FinalityProviders_PauseFinalityProvider_Handler,
+		},
+		{
+			MethodName: "ResumeFinalityProvider",
+			Handler:    _FinalityProviders_ResumeFinalityProvider_Handler,
+		},
 		{
 			MethodName: "QueryFinalityProvider",
 			Handler:    _FinalityProviders_QueryFinalityProvider_Handler,
@@ -339,6 +575,14 @@ var FinalityProviders_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryFinalityProviderList",
 			Handler:    _FinalityProviders_QueryFinalityProviderList_Handler,
 		},
+		{
+			MethodName: "QueryVotes",
+			Handler:    _FinalityProviders_QueryVotes_Handler,
+		},
+		{
+			MethodName: "QueryLiveness",
+			Handler:    _FinalityProviders_QueryLiveness_Handler,
+		},
 		{
 			MethodName: "SignMessageFromChainKey",
 			Handler:    _FinalityProviders_SignMessageFromChainKey_Handler,
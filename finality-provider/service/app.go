@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"strings"
@@ -8,9 +9,11 @@ import (
 	"time"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/avast/retry-go/v4"
 	bbntypes "github.com/babylonchain/babylon/types"
 	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -20,6 +23,7 @@ import (
 	"github.com/babylonchain/finality-provider/clientcontroller"
 	"github.com/babylonchain/finality-provider/eotsmanager"
 	"github.com/babylonchain/finality-provider/eotsmanager/client"
+	eotstypes "github.com/babylonchain/finality-provider/eotsmanager/types"
 	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
 	"github.com/babylonchain/finality-provider/finality-provider/proto"
 	"github.com/babylonchain/finality-provider/finality-provider/store"
@@ -57,14 +61,14 @@ func NewFinalityProviderAppFromConfig(
 	db kvdb.Backend,
 	logger *zap.Logger,
 ) (*FinalityProviderApp, error) {
-	cc, err := clientcontroller.NewClientController(cfg.ChainName, cfg.BabylonConfig, &cfg.BTCNetParams, logger)
+	cc, err := clientcontroller.NewClientController(cfg.ChainName, cfg, logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create rpc client for the consumer chain %s: %v", cfg.ChainName, err)
 	}
 
 	// if the EOTSManagerAddress is empty, run a local EOTS manager;
 	// otherwise connect a remote one with a gRPC client
-	em, err := client.NewEOTSManagerGRpcClient(cfg.EOTSManagerAddress)
+	em, err := client.NewEOTSManagerGRpcClient(cfg.EOTSManagerAddress, cfg.EOTSManagerAuth)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create EOTS manager client: %w", err)
 	}
@@ -149,12 +153,25 @@ func (app *FinalityProviderApp) GetFinalityProviderInfo(fpPk *bbntypes.BIP340Pub
 	return app.fpManager.FinalityProviderInfo(fpPk)
 }
 
+// GetVotes returns a page of the votes of the finality provider in the vote journal
+// from the start height, along with the start height of the next page
+func (app *FinalityProviderApp) GetVotes(fpPk *bbntypes.BIP340PubKey, startHeight uint64, limit uint32) ([]*proto.VoteInfo, uint64, error) {
+	return app.fpManager.Votes(fpPk, startHeight, limit)
+}
+
+// GetLiveness returns the liveness of the finality provider over the latest processed heights
+func (app *FinalityProviderApp) GetLiveness(fpPk *bbntypes.BIP340PubKey) (*proto.LivenessInfo, error) {
+	return app.fpManager.Liveness(fpPk)
+}
+
 // GetFinalityProviderInstance returns the finality-provider instance with the given Babylon public key
 func (app *FinalityProviderApp) GetFinalityProviderInstance(fpPk *bbntypes.BIP340PubKey) (*FinalityProviderInstance, error) {
 	return app.fpManager.GetFinalityProviderInstance(fpPk)
 }
 
-func (app *FinalityProviderApp) RegisterFinalityProvider(fpPkStr string) (*RegisterFinalityProviderResponse, error) {
+// RegisterFinalityProvider registers the finality provider with the given public key
+// to the consumer chain. The registration is canceled once ctx is done
+func (app *FinalityProviderApp) RegisterFinalityProvider(ctx context.Context, fpPkStr string) (*RegisterFinalityProviderResponse, error) {
 	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(fpPkStr)
 	if err != nil {
 		return nil, err
@@ -181,6 +198,7 @@ func (app *FinalityProviderApp) RegisterFinalityProvider(fpPkStr string) (*Regis
 	}
 
 	request := &registerFinalityProviderRequest{
+		ctx:             ctx,
 		bbnPubKey:       fp.ChainPk,
 		btcPubKey:       bbntypes.NewBIP340PubKeyFromBTCPK(fp.BtcPk),
 		pop:             pop,
@@ -191,7 +209,13 @@ func (app *FinalityProviderApp) RegisterFinalityProvider(fpPkStr string) (*Regis
 		successResponse: make(chan *RegisterFinalityProviderResponse, 1),
 	}
 
-	app.registerFinalityProviderRequestChan <- request
+	select {
+	case app.registerFinalityProviderRequestChan <- request:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-app.quit:
+		return nil, fmt.Errorf("finality-provider app is shutting down")
+	}
 
 	select {
 	case err := <-request.errResponse:
@@ -205,12 +229,40 @@ func (app *FinalityProviderApp) RegisterFinalityProvider(fpPkStr string) (*Regis
 
 // StartHandlingFinalityProvider starts a finality-provider instance with the given Babylon public key
 // Note: this should be called right after the finality-provider is registered
-func (app *FinalityProviderApp) StartHandlingFinalityProvider(fpPk *bbntypes.BIP340PubKey, passphrase string) error {
-	return app.fpManager.StartFinalityProvider(fpPk, passphrase)
+func (app *FinalityProviderApp) StartHandlingFinalityProvider(ctx context.Context, fpPk *bbntypes.BIP340PubKey, passphrase string) error {
+	return app.fpManager.StartFinalityProvider(ctx, fpPk, passphrase)
+}
+
+// StartHandlingFinalityProviderWhenFinalized starts handling the finality provider
+// once the epoch in which it is registered is BTC timestamped
+func (app *FinalityProviderApp) StartHandlingFinalityProviderWhenFinalized(ctx context.Context, fpPk *bbntypes.BIP340PubKey, passphrase string) error {
+	return app.fpManager.StartFinalityProviderWhenFinalized(ctx, fpPk, passphrase)
+}
+
+func (app *FinalityProviderApp) StartHandlingAll(ctx context.Context) error {
+	return app.fpManager.StartAll(ctx)
+}
+
+// StopHandlingFinalityProvider stops the running finality-provider instance
+func (app *FinalityProviderApp) StopHandlingFinalityProvider(fpPk *bbntypes.BIP340PubKey) error {
+	return app.fpManager.StopFinalityProvider(fpPk)
+}
+
+// PauseFinalityProvider stops the finality provider from signing blocks
+func (app *FinalityProviderApp) PauseFinalityProvider(fpPk *bbntypes.BIP340PubKey) error {
+	return app.fpManager.PauseFinalityProvider(fpPk)
+}
+
+// ResumeFinalityProvider resumes signing blocks of the finality provider
+// and starts handling it if it is stopped
+func (app *FinalityProviderApp) ResumeFinalityProvider(ctx context.Context, fpPk *bbntypes.BIP340PubKey, passphrase string) error {
+	return app.fpManager.ResumeFinalityProvider(ctx, fpPk, passphrase)
 }
 
-func (app *FinalityProviderApp) StartHandlingAll() error {
-	return app.fpManager.StartAll()
+// UnlockFinalityProvider starts handling the LOCKED finality provider
+// with the passphrase of its EOTS key
+func (app *FinalityProviderApp) UnlockFinalityProvider(ctx context.Context, fpPk *bbntypes.BIP340PubKey, passphrase string) error {
+	return app.fpManager.UnlockFinalityProvider(ctx, fpPk, passphrase)
 }
 
 // NOTE: this is not safe in production, so only used for testing purpose
@@ -224,8 +276,8 @@ func (app *FinalityProviderApp) getFpPrivKey(fpPk []byte) (*btcec.PrivateKey, er
 }
 
 // SyncFinalityProviderStatus syncs the status of the finality-providers
-func (app *FinalityProviderApp) SyncFinalityProviderStatus() error {
-	latestBlock, err := app.cc.QueryBestBlock()
+func (app *FinalityProviderApp) SyncFinalityProviderStatus(ctx context.Context) error {
+	latestBlock, err := app.cc.QueryBestBlock(ctx)
 	if err != nil {
 		return err
 	}
@@ -236,7 +288,7 @@ func (app *FinalityProviderApp) SyncFinalityProviderStatus() error {
 	}
 
 	for _, fp := range fps {
-		vp, err := app.cc.QueryFinalityProviderVotingPower(fp.BtcPk, latestBlock.Height)
+		vp, err := app.cc.QueryFinalityProviderVotingPower(ctx, fp.BtcPk, latestBlock.Height)
 		if err != nil {
 			// if error occured then the finality-provider is not registered in the Babylon chain yet
 			continue
@@ -301,6 +353,14 @@ func (app *FinalityProviderApp) Stop() error {
 			return
 		}
 
+		// the client controller is shared by the finality providers and
+		// the chain poller, so it is only closed once they are all stopped
+		app.logger.Debug("Stopping client controller")
+		if err := app.cc.Close(); err != nil {
+			stopErr = err
+			return
+		}
+
 		app.logger.Debug("Stopping EOTS manager")
 		if err := app.eotsManager.Close(); err != nil {
 			stopErr = err
@@ -411,24 +471,24 @@ func (app *FinalityProviderApp) StoreFinalityProvider(
 	if err != nil {
 		return nil, err
 	}
-	fpRecord, err := app.eotsManager.KeyRecord(fpPk.MustMarshal(), passPhrase)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get finality-provider record: %w", err)
-	}
 
-	// 3. create proof-of-possession
-	pop, err := kr.CreatePop(fpRecord.PrivKey, passPhrase)
+	// 3. create proof-of-possession, where the BTC signature is produced
+	// by the EOTS manager so that the EOTS private key is not exposed
+	pop, err := kr.CreatePopWithSigner(fpPk, passPhrase, func(babylonSig []byte) (*schnorr.Signature, error) {
+		return app.eotsManager.SignPop(fpPk.MustMarshal(), babylonSig, passPhrase)
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create proof-of-possession of the finality provider: %w", err)
 	}
 
-	// 4. Create derive master public randomness
-	_, mpr, err := fpkr.GenerateMasterRandPair(fpRecord.PrivKey.Serialize(), types.MarshalChainID(chainID))
+	// 4. Create derive master public randomness of the configured version
+	mpr, err := app.eotsManager.CreateMasterRandPair(fpPk.MustMarshal(), types.MarshalChainID(chainID),
+		eotstypes.MasterRandVersion(app.config.MasterRandVersion), passPhrase)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get master public randomness of the finality provider: %w", err)
 	}
 
-	if err := app.fps.CreateFinalityProvider(chainPk, fpPk.MustToBTCPK(), description, commission, mpr.MarshalBase58(), keyName, chainID, pop.BabylonSig, pop.BtcSig); err != nil {
+	if err := app.fps.CreateFinalityProvider(chainPk, fpPk.MustToBTCPK(), description, commission, mpr, keyName, chainID, pop.BabylonSig, pop.BtcSig); err != nil {
 		return nil, fmt.Errorf("failed to save finality-provider: %w", err)
 	}
 	app.fpManager.metrics.RecordFpStatus(fpPk.MarshalHex(), proto.FinalityProviderStatus_CREATED)
@@ -517,12 +577,18 @@ func (app *FinalityProviderApp) eventLoop() {
 
 func (app *FinalityProviderApp) registrationLoop() {
 	defer app.wg.Done()
+
+	// quitCtx cancels the in-flight registration once the app is quitting
+	quitCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-app.quit
+		cancel()
+	}()
+
 	for {
 		select {
 		case req := <-app.registerFinalityProviderRequestChan:
-			// we won't do any retries here to not block the loop for more important messages.
-			// Most probably it fails due so some user error so we just return the error to the user.
-			// TODO: need to start passing context here to be able to cancel the request in case of app quiting
 			popBytes, err := req.pop.Marshal()
 			if err != nil {
 				req.errResponse <- err
@@ -534,14 +600,43 @@ func (app *FinalityProviderApp) registrationLoop() {
 				req.errResponse <- err
 				continue
 			}
-			res, registeredEpoch, err := app.cc.RegisterFinalityProvider(
-				req.bbnPubKey.Key,
-				req.btcPubKey.MustToBTCPK(),
-				popBytes,
-				req.commission,
-				desBytes,
-				req.masterPubRand,
+
+			// the request is canceled by either the caller or the app quitting
+			ctx, cancelReq := context.WithCancel(req.ctx)
+			stop := context.AfterFunc(quitCtx, cancelReq)
+			var (
+				res             *types.TxResponse
+				registeredEpoch uint64
 			)
+			// the registration commits the master public randomness, so it is retried
+			// by the randomness commit policy; only the transient errors are retried
+			// as the others, e.g., user errors, would not be fixed by retrying
+			err = doWithRetry(ctx, app.config.RandomnessCommitRetry, randomnessCommitRetryPolicy, app.metrics, func() error {
+				var err error
+				res, registeredEpoch, err = app.cc.RegisterFinalityProvider(
+					ctx,
+					req.bbnPubKey.Key,
+					req.btcPubKey.MustToBTCPK(),
+					popBytes,
+					req.commission,
+					desBytes,
+					req.masterPubRand,
+				)
+				if err != nil && clientcontroller.CategoryOf(err) != clientcontroller.CategoryTransient {
+					return retry.Unrecoverable(err)
+				}
+				return err
+			}, func(n uint, err error) {
+				app.logger.Debug(
+					"failed to register finality-provider",
+					zap.String("pk", req.btcPubKey.MarshalHex()),
+					zap.Uint("attempt", n+1),
+					zap.Uint("max_attempts", app.config.RandomnessCommitRetry.Attempts),
+					zap.Error(err),
+				)
+			})
+			stop()
+			cancelReq()
 
 			if err != nil {
 				app.logger.Error(
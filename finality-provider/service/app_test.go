@@ -1,6 +1,7 @@
 package service_test
 
 import (
+	"context"
 	"math/rand"
 	"os"
 	"path/filepath"
@@ -49,10 +50,10 @@ func FuzzRegisterFinalityProvider(f *testing.F) {
 		randomStartingHeight := uint64(r.Int63n(100) + 1)
 		currentHeight := randomStartingHeight + uint64(r.Int63n(10)+2)
 		mockClientController := testutil.PrepareMockedClientController(t, r, randomStartingHeight, currentHeight)
-		mockClientController.EXPECT().QueryLatestFinalizedBlocks(gomock.Any()).Return(nil, nil).AnyTimes()
-		mockClientController.EXPECT().QueryFinalityProviderVotingPower(gomock.Any(),
+		mockClientController.EXPECT().QueryLatestFinalizedBlocks(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		mockClientController.EXPECT().QueryFinalityProviderVotingPower(gomock.Any(), gomock.Any(),
 			gomock.Any()).Return(uint64(0), nil).AnyTimes()
-		mockClientController.EXPECT().QueryLastFinalizedEpoch().Return(uint64(0), nil).AnyTimes()
+		mockClientController.EXPECT().QueryLastFinalizedEpoch(gomock.Any()).Return(uint64(0), nil).AnyTimes()
 
 		// Create randomized config
 		fpHomeDir := filepath.Join(t.TempDir(), "fp-home")
@@ -100,6 +101,7 @@ func FuzzRegisterFinalityProvider(f *testing.F) {
 		txHash := testutil.GenRandomHexStr(r, 32)
 		mockClientController.EXPECT().
 			RegisterFinalityProvider(
+				gomock.Any(),
 				fp.ChainPk.Key,
 				fp.BtcPk,
 				popBytes,
@@ -108,11 +110,11 @@ func FuzzRegisterFinalityProvider(f *testing.F) {
 				fp.MasterPubRand,
 			).Return(&types.TxResponse{TxHash: txHash}, uint64(0), nil).AnyTimes()
 
-		res, err := app.RegisterFinalityProvider(fp.GetBIP340BTCPK().MarshalHex())
+		res, err := app.RegisterFinalityProvider(context.Background(), fp.GetBIP340BTCPK().MarshalHex())
 		require.NoError(t, err)
 		require.Equal(t, txHash, res.TxHash)
 
-		err = app.StartHandlingFinalityProvider(fp.GetBIP340BTCPK(), passphrase)
+		err = app.StartHandlingFinalityProvider(context.Background(), fp.GetBIP340BTCPK(), passphrase)
 		require.NoError(t, err)
 
 		fpAfterReg, err := app.GetFinalityProviderInstance(fp.GetBIP340BTCPK())
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/zap"
+
+	"github.com/babylonchain/finality-provider/clientcontroller"
+)
+
+// monitorBalance periodically checks the balance of the account paying for the
+// transactions so that it is funded before the finality providers stop voting,
+// and updates the gas prices if the consumer chain supports the dynamic gas prices,
+// which are therefore only updated if the balance monitor is enabled
+func (fpm *FinalityProviderManager) monitorBalance(ctx context.Context) {
+	defer fpm.wg.Done()
+
+	if fpm.config.BalanceMonitor.Interval == 0 {
+		fpm.logger.Info("the balance monitor is disabled")
+		return
+	}
+
+	// the thresholds are parsed once as they are validated along with the config
+	lowBalance, criticalBalance, err := fpm.config.BalanceMonitor.Thresholds()
+	if err != nil {
+		fpm.logger.Error("the balance monitor is disabled due to the invalid thresholds", zap.Error(err))
+		return
+	}
+
+	balanceTicker := time.NewTicker(fpm.config.BalanceMonitor.Interval)
+	defer balanceTicker.Stop()
+
+	for {
+		select {
+		case <-balanceTicker.C:
+			fpm.checkBalance(ctx, lowBalance, criticalBalance)
+			fpm.updateGasPrices(ctx)
+		case <-fpm.quit:
+			return
+		}
+	}
+}
+
+func (fpm *FinalityProviderManager) checkBalance(ctx context.Context, lowBalance, criticalBalance sdk.Coin) {
+	balance, err := fpm.cc.QueryBalance(ctx)
+	if err != nil {
+		fpm.logger.Debug("failed to query the balance", zap.Error(err))
+		return
+	}
+
+	if amount, err := balance.Amount.ToLegacyDec().Float64(); err == nil {
+		fpm.metrics.RecordSignerBalance(amount)
+	}
+
+	if balance.Denom != lowBalance.Denom {
+		fpm.logger.Warn("the balance thresholds are not in the fee denom",
+			zap.String("fee_denom", balance.Denom),
+			zap.String("threshold_denom", lowBalance.Denom))
+		return
+	}
+
+	switch {
+	case balance.Amount.LT(criticalBalance.Amount):
+		fpm.logger.Error("the balance of the account paying for the transactions is critically low, "+
+			"the finality providers will stop voting once it runs out",
+			zap.String("balance", balance.String()),
+			zap.String("critical_balance", criticalBalance.String()))
+	case balance.Amount.LT(lowBalance.Amount):
+		fpm.logger.Warn("the balance of the account paying for the transactions is low",
+			zap.String("balance", balance.String()),
+			zap.String("low_balance", lowBalance.String()))
+	}
+}
+
+func (fpm *FinalityProviderManager) updateGasPrices(ctx context.Context) {
+	updater, ok := fpm.cc.(clientcontroller.GasPriceUpdater)
+	if !ok {
+		return
+	}
+
+	gasPrices, err := updater.UpdateGasPrices(ctx)
+	if err != nil {
+		fpm.logger.Debug("failed to update the gas prices", zap.Error(err))
+		return
+	}
+
+	if gasPrice, err := sdk.ParseDecCoin(gasPrices); err == nil {
+		if price, err := gasPrice.Amount.Float64(); err == nil {
+			fpm.metrics.RecordGasPrice(price)
+		}
+	}
+}
@@ -1,11 +1,12 @@
 package service
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"sync"
 	"time"
 
-	"github.com/avast/retry-go/v4"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
@@ -15,20 +16,8 @@ import (
 	"github.com/babylonchain/finality-provider/types"
 )
 
-var (
-	// TODO: Maybe configurable?
-	RtyAttNum = uint(5)
-	RtyAtt    = retry.Attempts(RtyAttNum)
-	RtyDel    = retry.Delay(time.Millisecond * 400)
-	RtyErr    = retry.LastErrorOnly(true)
-)
-
-const (
-	// TODO: Maybe configurable?
-	maxFailedCycles = 20
-)
-
 type skipHeightRequest struct {
+	sub    *ChainPollerSubscription
 	height uint64
 	resp   chan *skipHeightResponse
 }
@@ -37,57 +26,89 @@ type skipHeightResponse struct {
 	err error
 }
 
+// ReorgEvent notifies the subscriber that the blocks it received from
+// the fork height have been replaced by a chain reorg
+type ReorgEvent struct {
+	// ForkHeight is the lowest height of the replaced blocks
+	ForkHeight uint64
+}
+
+// ChainPoller polls blocks from the consumer chain and broadcasts them to
+// every subscriber, so that the finality-provider instances of the same
+// consumer chain share the queries to the node
 type ChainPoller struct {
 	isStarted *atomic.Bool
 	wg        sync.WaitGroup
 	quit      chan struct{}
+	// cancel cancels the in-flight requests to the consumer chain on stop
+	cancel context.CancelFunc
+
+	cc              clientcontroller.ClientController
+	cfg             *cfg.ChainPollerConfig
+	retryPolicy     *cfg.RetryPolicy
+	metrics         *metrics.FpMetrics
+	skipHeightChan  chan *skipHeightRequest
+	newSubChan      chan struct{}
+	activatedHeight *atomic.Uint64
+	logger          *zap.Logger
+
+	// blockHashes tracks the hashes of the latest polled blocks by height
+	// to detect chain reorgs, which is only accessed by the polling loop
+	blockHashes map[uint64][]byte
+
+	mu   sync.Mutex
+	subs map[*ChainPollerSubscription]struct{}
+}
 
-	cc             clientcontroller.ClientController
-	cfg            *cfg.ChainPollerConfig
-	metrics        *metrics.FpMetrics
-	blockInfoChan  chan *types.BlockInfo
-	skipHeightChan chan *skipHeightRequest
-	nextHeight     uint64
-	logger         *zap.Logger
+// ChainPollerSubscription receives the blocks polled by the chain poller
+// starting from its own next height, which can be skipped independently
+// of the other subscribers
+type ChainPollerSubscription struct {
+	poller        *ChainPoller
+	isSubscribed  *atomic.Bool
+	blockInfoChan chan *types.BlockInfo
+	reorgChan     chan *ReorgEvent
+	errChan       chan error
+	nextHeight    *atomic.Uint64
 }
 
 func NewChainPoller(
 	logger *zap.Logger,
 	cfg *cfg.ChainPollerConfig,
+	retryPolicy *cfg.RetryPolicy,
 	cc clientcontroller.ClientController,
 	metrics *metrics.FpMetrics,
 ) *ChainPoller {
 	return &ChainPoller{
-		isStarted:      atomic.NewBool(false),
-		logger:         logger,
-		cfg:            cfg,
-		cc:             cc,
-		metrics:        metrics,
-		blockInfoChan:  make(chan *types.BlockInfo, cfg.BufferSize),
-		skipHeightChan: make(chan *skipHeightRequest),
-		quit:           make(chan struct{}),
+		isStarted:       atomic.NewBool(false),
+		logger:          logger,
+		cfg:             cfg,
+		retryPolicy:     retryPolicy,
+		cc:              cc,
+		metrics:         metrics,
+		skipHeightChan:  make(chan *skipHeightRequest),
+		newSubChan:      make(chan struct{}, 1),
+		activatedHeight: atomic.NewUint64(0),
+		blockHashes:     make(map[uint64][]byte),
+		subs:            make(map[*ChainPollerSubscription]struct{}),
+		quit:            make(chan struct{}),
 	}
 }
 
-func (cp *ChainPoller) Start(startHeight uint64) error {
+func (cp *ChainPoller) Start() error {
 	if cp.isStarted.Swap(true) {
 		return fmt.Errorf("the poller is already started")
 	}
 
 	cp.logger.Info("starting the chain poller")
 
-	err := cp.validateStartHeight(startHeight)
-	if err != nil {
-		return fmt.Errorf("invalid starting height %d: %w", startHeight, err)
-	}
-
-	cp.nextHeight = startHeight
+	ctx, cancel := context.WithCancel(context.Background())
+	cp.cancel = cancel
 
 	cp.wg.Add(1)
 
-	go cp.pollChain()
+	go cp.pollChain(ctx)
 
-	cp.metrics.RecordPollerStartingHeight(startHeight)
 	cp.logger.Info("the chain poller is successfully started")
 
 	return nil
@@ -99,10 +120,7 @@ func (cp *ChainPoller) Stop() error {
 	}
 
 	cp.logger.Info("stopping the chain poller")
-	err := cp.cc.Close()
-	if err != nil {
-		return err
-	}
+	cp.cancel()
 	close(cp.quit)
 	cp.wg.Wait()
 
@@ -115,67 +133,184 @@ func (cp *ChainPoller) IsRunning() bool {
 	return cp.isStarted.Load()
 }
 
-// Return read only channel for incoming blocks
-// TODO: Handle the case when there is more than one consumer. Currently with more than
-// one consumer blocks most probably will be received out of order to those consumers.
-func (cp *ChainPoller) GetBlockInfoChan() <-chan *types.BlockInfo {
-	return cp.blockInfoChan
+// Subscribe returns a subscription receiving the blocks from the given start height
+func (cp *ChainPoller) Subscribe(ctx context.Context, startHeight uint64) (*ChainPollerSubscription, error) {
+	if !cp.IsRunning() {
+		return nil, fmt.Errorf("the chain poller is stopped")
+	}
+
+	err := cp.validateStartHeight(ctx, startHeight)
+	if err != nil {
+		return nil, fmt.Errorf("invalid starting height %d: %w", startHeight, err)
+	}
+
+	// the blocks lower than the activated height are not needed
+	if activatedHeight := cp.activatedHeight.Load(); startHeight < activatedHeight {
+		startHeight = activatedHeight
+	}
+
+	sub := &ChainPollerSubscription{
+		poller:        cp,
+		isSubscribed:  atomic.NewBool(true),
+		blockInfoChan: make(chan *types.BlockInfo, cp.cfg.BufferSize),
+		reorgChan:     make(chan *ReorgEvent, 1),
+		errChan:       make(chan error, 1),
+		nextHeight:    atomic.NewUint64(startHeight),
+	}
+
+	cp.mu.Lock()
+	cp.subs[sub] = struct{}{}
+	cp.mu.Unlock()
+
+	// notify the poller to retrieve the block for the new subscriber without
+	// waiting for the next cycle
+	select {
+	case cp.newSubChan <- struct{}{}:
+	default:
+	}
+
+	cp.metrics.RecordPollerStartingHeight(startHeight)
+	cp.logger.Info("the chain poller has a new subscriber", zap.Uint64("start_height", startHeight))
+
+	return sub, nil
+}
+
+// Unsubscribe stops broadcasting blocks to the subscription
+func (sub *ChainPollerSubscription) Unsubscribe() {
+	if !sub.isSubscribed.Swap(false) {
+		return
+	}
+
+	sub.poller.mu.Lock()
+	delete(sub.poller.subs, sub)
+	sub.poller.mu.Unlock()
+}
+
+// Return read only channel for incoming blocks of the subscription
+func (sub *ChainPollerSubscription) GetBlockInfoChan() <-chan *types.BlockInfo {
+	return sub.blockInfoChan
+}
+
+// GetReorgChan returns read only channel for the chain reorgs replacing the received blocks
+// no block is received after the reorg event until the event is read
+func (sub *ChainPollerSubscription) GetReorgChan() <-chan *ReorgEvent {
+	return sub.reorgChan
+}
+
+// GetErrChan returns read only channel for the error of the poller, which is sent once the
+// poller fails to retrieve any block for the max failed cycles and restarts with backoff
+func (sub *ChainPollerSubscription) GetErrChan() <-chan error {
+	return sub.errChan
 }
 
-func (cp *ChainPoller) latestBlockWithRetry() (*types.BlockInfo, error) {
+// SkipToHeight skips the blocks of the subscription lower than the given height
+func (sub *ChainPollerSubscription) SkipToHeight(height uint64) error {
+	if !sub.isSubscribed.Load() {
+		return fmt.Errorf("the subscription to the chain poller is closed")
+	}
+
+	return sub.poller.skipToHeight(sub, height)
+}
+
+func (sub *ChainPollerSubscription) NextHeight() uint64 {
+	return sub.nextHeight.Load()
+}
+
+// rewindToHeight drops the buffered blocks so that the blocks from the
+// fork height are received again and notifies the subscriber of the reorg
+func (sub *ChainPollerSubscription) rewindToHeight(forkHeight uint64) {
+	for len(sub.blockInfoChan) > 0 {
+		<-sub.blockInfoChan
+	}
+	sub.nextHeight.Store(forkHeight)
+
+	// the pending reorg event is merged with the new one, which is
+	// sent without blocking as only the poller sends the reorg events
+	event := &ReorgEvent{ForkHeight: forkHeight}
+	select {
+	case pending := <-sub.reorgChan:
+		if pending.ForkHeight < event.ForkHeight {
+			event = pending
+		}
+	default:
+	}
+	sub.reorgChan <- event
+}
+
+func (sub *ChainPollerSubscription) clearChanBufferUpToHeight(upToHeight uint64) {
+	for len(sub.blockInfoChan) > 0 {
+		block := <-sub.blockInfoChan
+		if block.Height+1 >= upToHeight {
+			break
+		}
+	}
+}
+
+func (cp *ChainPoller) subscriptions() []*ChainPollerSubscription {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	subs := make([]*ChainPollerSubscription, 0, len(cp.subs))
+	for sub := range cp.subs {
+		subs = append(subs, sub)
+	}
+
+	return subs
+}
+
+func (cp *ChainPoller) latestBlockWithRetry(ctx context.Context) (*types.BlockInfo, error) {
 	var (
 		latestBlock *types.BlockInfo
 		err         error
 	)
 
-	if err := retry.Do(func() error {
-		latestBlock, err = cp.cc.QueryBestBlock()
+	if err := doWithRetry(ctx, cp.retryPolicy, queryRetryPolicy, cp.metrics, func() error {
+		latestBlock, err = cp.cc.QueryBestBlock(ctx)
 		if err != nil {
 			return err
 		}
 		return nil
-	}, RtyAtt, RtyDel, RtyErr, retry.OnRetry(func(n uint, err error) {
+	}, func(n uint, err error) {
 		cp.logger.Debug(
 			"failed to query the consumer chain for the latest block",
 			zap.Uint("attempt", n+1),
-			zap.Uint("max_attempts", RtyAttNum),
+			zap.Uint("max_attempts", cp.retryPolicy.Attempts),
 			zap.Error(err),
 		)
-	})); err != nil {
+	}); err != nil {
 		return nil, err
 	}
 	return latestBlock, nil
 }
 
-func (cp *ChainPoller) blockWithRetry(height uint64) (*types.BlockInfo, error) {
+func (cp *ChainPoller) blockWithRetry(ctx context.Context, height uint64) (*types.BlockInfo, error) {
 	var (
 		block *types.BlockInfo
 		err   error
 	)
-	if err := retry.Do(func() error {
-		block, err = cp.cc.QueryBlock(height)
+	if err := doWithRetry(ctx, cp.retryPolicy, queryRetryPolicy, cp.metrics, func() error {
+		block, err = cp.cc.QueryBlock(ctx, height)
 		if err != nil {
 			return err
 		}
 		return nil
-	}, RtyAtt, RtyDel, RtyErr, retry.OnRetry(func(n uint, err error) {
+	}, func(n uint, err error) {
 		cp.logger.Debug(
 			"failed to query the consumer chain for the latest block",
 			zap.Uint("attempt", n+1),
-			zap.Uint("max_attempts", RtyAttNum),
+			zap.Uint("max_attempts", cp.retryPolicy.Attempts),
 			zap.Uint64("height", height),
 			zap.Error(err),
 		)
-	})); err != nil {
+	}); err != nil {
 		return nil, err
 	}
 
 	return block, nil
 }
 
-func (cp *ChainPoller) validateStartHeight(startHeight uint64) error {
-	// Infinite retry to get initial latest height
-	// TODO: Add possible cancellation or timeout for starting node
+func (cp *ChainPoller) validateStartHeight(ctx context.Context, startHeight uint64) error {
+	// Infinite retry to get initial latest height until the poller is stopped
 
 	if startHeight == 0 {
 		return fmt.Errorf("start height can't be 0")
@@ -183,8 +318,11 @@ func (cp *ChainPoller) validateStartHeight(startHeight uint64) error {
 
 	var currentBestChainHeight uint64
 	for {
-		lastestBlock, err := cp.latestBlockWithRetry()
+		lastestBlock, err := cp.latestBlockWithRetry(ctx)
 		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
 			cp.logger.Debug("failed to query babylon for the latest status", zap.Error(err))
 			continue
 		}
@@ -202,15 +340,18 @@ func (cp *ChainPoller) validateStartHeight(startHeight uint64) error {
 }
 
 // waitForActivation waits until BTC staking is activated
-func (cp *ChainPoller) waitForActivation() {
-	// ensure that the startHeight is no lower than the activated height
+func (cp *ChainPoller) waitForActivation(ctx context.Context) {
+	// ensure that the next heights are no lower than the activated height
 	for {
-		activatedHeight, err := cp.cc.QueryActivatedHeight()
+		activatedHeight, err := cp.cc.QueryActivatedHeight(ctx)
 		if err != nil {
 			cp.logger.Debug("failed to query the consumer chain for the activated height", zap.Error(err))
 		} else {
-			if cp.nextHeight < activatedHeight {
-				cp.nextHeight = activatedHeight
+			cp.activatedHeight.Store(activatedHeight)
+			for _, sub := range cp.subscriptions() {
+				if sub.NextHeight() < activatedHeight {
+					sub.nextHeight.Store(activatedHeight)
+				}
 			}
 			return
 		}
@@ -218,74 +359,130 @@ func (cp *ChainPoller) waitForActivation() {
 		select {
 		case <-time.After(cp.cfg.PollInterval):
 
+		case <-ctx.Done():
+			return
+
 		case <-cp.quit:
 			return
 		}
 	}
-
 }
 
-func (cp *ChainPoller) pollChain() {
+func (cp *ChainPoller) pollChain(ctx context.Context) {
 	defer cp.wg.Done()
 
-	cp.waitForActivation()
+	cp.waitForActivation(ctx)
 
-	var failedCycles uint32
+	var (
+		failedCycles uint32
+		// newBlockChan receives the new blocks in the subscription mode
+		// and it is nil if the subscription is not available
+		newBlockChan  <-chan *types.BlockInfo
+		unsubscribe   context.CancelFunc
+		lastEventTime time.Time
+		// latestBlock is the latest block received from the subscription,
+		// up to which the missed heights of the subscribers are backfilled
+		latestBlock *types.BlockInfo
+		shouldPoll  = true
+		// the chain is neither polled nor subscribed to until restartAt
+		// once the poller reaches the max failed cycles
+		restartAt      time.Time
+		restartBackoff = cp.cfg.RestartBackoff
+	)
+	defer func() {
+		if unsubscribe != nil {
+			unsubscribe()
+		}
+	}()
 
 	for {
-		// TODO: Handlig of request cancellation, as otherwise shutdown will be blocked
-		// until request is finished
-		blockToRetrieve := cp.nextHeight
-		block, err := cp.blockWithRetry(blockToRetrieve)
-		if err != nil {
-			failedCycles++
-			cp.logger.Debug(
-				"failed to query the consumer chain for the block",
-				zap.Uint32("current_failures", failedCycles),
-				zap.Uint64("block_to_retrieve", blockToRetrieve),
-				zap.Error(err),
-			)
-		} else {
-			// no error and we got the header we wanted to get, bump the state and push
-			// notification about data
-			cp.nextHeight = blockToRetrieve + 1
-			failedCycles = 0
-			cp.metrics.RecordLastPolledHeight(block.Height)
-
-			cp.logger.Info("the poller retrieved the block from the consumer chain",
-				zap.Uint64("height", block.Height))
+		restarting := time.Now().Before(restartAt)
+
+		if !restarting && newBlockChan == nil && cp.cfg.Mode == cfg.ChainPollerModeSubscription {
+			newBlockChan, unsubscribe = cp.subscribeNewBlocks(ctx)
+			lastEventTime = time.Now()
+			// the missed heights are backfilled once the first block is received
+			if newBlockChan != nil {
+				shouldPoll = false
+			}
+		}
 
-			// push the data to the channel
-			// Note: if the consumer is too slow -- the buffer is full
-			// the channel will block, and we will stop retrieving data from the node
-			cp.blockInfoChan <- block
+		if shouldPoll && !restarting {
+			// the in-flight requests are canceled once the poller is stopped
+			retrieved, failed := cp.pollSubscriptions(ctx, latestBlock)
+			if failed > 0 && retrieved == 0 {
+				failedCycles++
+			} else if retrieved > 0 {
+				failedCycles = 0
+				restartBackoff = cp.cfg.RestartBackoff
+			}
 		}
 
-		if failedCycles > maxFailedCycles {
-			cp.logger.Fatal("the poller has reached the max failed cycles, exiting")
+		// the poller keeps serving the subscribers while restarting, so that
+		// the requests to skip heights are not blocked by the backoff
+		if failedCycles > cp.cfg.MaxFailedCycles {
+			err := fmt.Errorf("the poller failed to retrieve any block for %d consecutive cycles", failedCycles)
+			cp.logger.Error("the poller has reached the max failed cycles, restarting it with backoff",
+				zap.Duration("backoff", restartBackoff), zap.Error(err))
+			cp.reportErr(err)
+
+			if newBlockChan != nil {
+				unsubscribe()
+			}
+			newBlockChan, latestBlock = nil, nil
+			failedCycles = 0
+			restartAt = time.Now().Add(restartBackoff)
+			restartBackoff = min(2*restartBackoff, cp.cfg.MaxRestartBackoff)
 		}
 
+		// the chain is only polled at the interval if the subscription is not available
+		shouldPoll = newBlockChan == nil
+
 		select {
 		case <-time.After(cp.cfg.PollInterval):
+			if newBlockChan != nil && time.Since(lastEventTime) > cp.cfg.SubscriptionTimeout {
+				cp.logger.Warn("no new block event is received, fall back to polling",
+					zap.Duration("timeout", cp.cfg.SubscriptionTimeout))
+				unsubscribe()
+				newBlockChan, latestBlock, shouldPoll = nil, nil, true
+			}
+
+		case block, ok := <-newBlockChan:
+			if !ok {
+				cp.logger.Warn("the subscription to new blocks is lost, fall back to polling")
+				unsubscribe()
+				newBlockChan, latestBlock, shouldPoll = nil, nil, true
+				continue
+			}
+			lastEventTime = time.Now()
+			if latestBlock == nil || block.Height > latestBlock.Height {
+				latestBlock = block
+			}
+			shouldPoll = true
+
+		case <-cp.newSubChan:
+			// the new subscriber is backfilled up to the latest block if subscribed
+			shouldPoll = newBlockChan == nil || latestBlock != nil
 
 		case req := <-cp.skipHeightChan:
 			// no need to skip heights if the target height is not higher
 			// than the next height to retrieve
+			sub := req.sub
 			targetHeight := req.height
-			if targetHeight <= cp.nextHeight {
+			if targetHeight <= sub.NextHeight() {
 				resp := &skipHeightResponse{
 					err: fmt.Errorf(
 						"the target height %d is not higher than the next height %d to retrieve",
-						targetHeight, cp.nextHeight)}
+						targetHeight, sub.NextHeight())}
 				req.resp <- resp
 				continue
 			}
 
-			// drain blocks that can be skipped from blockInfoChan
-			cp.clearChanBufferUpToHeight(targetHeight)
+			// drain blocks that can be skipped from the channel of the subscription
+			sub.clearChanBufferUpToHeight(targetHeight)
 
 			// set the next height to the skip height
-			cp.nextHeight = targetHeight
+			sub.nextHeight.Store(targetHeight)
 
 			cp.logger.Debug("the poller has skipped height(s)",
 				zap.Uint64("next_height", req.height))
@@ -298,7 +495,198 @@ func (cp *ChainPoller) pollChain() {
 	}
 }
 
-func (cp *ChainPoller) SkipToHeight(height uint64) error {
+// reportErr sends the error of the poller to the subscribers without blocking,
+// where a subscriber which has not read the previous error misses the new one
+func (cp *ChainPoller) reportErr(err error) {
+	for _, sub := range cp.subscriptions() {
+		select {
+		case sub.errChan <- err:
+		default:
+		}
+	}
+}
+
+// subscribeNewBlocks subscribes to the new blocks of the consumer chain
+// it returns a nil channel if the subscription is not available, in
+// which case the poller falls back to polling
+func (cp *ChainPoller) subscribeNewBlocks(ctx context.Context) (<-chan *types.BlockInfo, context.CancelFunc) {
+	subscriber, ok := cp.cc.(clientcontroller.BlockSubscriber)
+	if !ok {
+		cp.logger.Warn("the consumer chain does not support subscribing to new blocks, fall back to polling")
+		return nil, nil
+	}
+
+	subCtx, cancel := context.WithCancel(ctx)
+	newBlockChan, err := subscriber.SubscribeNewBlocks(subCtx)
+	if err != nil {
+		cancel()
+		cp.logger.Debug("failed to subscribe to new blocks, fall back to polling", zap.Error(err))
+		return nil, nil
+	}
+
+	cp.logger.Info("the chain poller is subscribed to new blocks")
+
+	return newBlockChan, cancel
+}
+
+// pollSubscriptions retrieves the next blocks of the subscriptions, where each
+// height is queried once for all the subscriptions waiting for it, and returns
+// the number of heights retrieved and failed to retrieve
+// if the latest block is given, the subscriptions receive all the blocks up to it,
+// otherwise each subscription receives its next block only
+func (cp *ChainPoller) pollSubscriptions(ctx context.Context, latestBlock *types.BlockInfo) (int, int) {
+	blocks := make(map[uint64]*types.BlockInfo)
+	failedHeights := make(map[uint64]struct{})
+	if latestBlock != nil {
+		cp.trackBlock(ctx, latestBlock, blocks)
+		blocks[latestBlock.Height] = latestBlock
+	}
+
+	for _, sub := range cp.subscriptions() {
+		for {
+			// the subscriber has not handled the reorg event yet
+			if len(sub.reorgChan) > 0 {
+				break
+			}
+
+			blockToRetrieve := sub.NextHeight()
+			if latestBlock != nil && blockToRetrieve > latestBlock.Height {
+				break
+			}
+			if _, failed := failedHeights[blockToRetrieve]; failed {
+				break
+			}
+
+			block, ok := blocks[blockToRetrieve]
+			if !ok {
+				var err error
+				block, err = cp.blockWithRetry(ctx, blockToRetrieve)
+				if err != nil {
+					failedHeights[blockToRetrieve] = struct{}{}
+					cp.logger.Debug(
+						"failed to query the consumer chain for the block",
+						zap.Uint64("block_to_retrieve", blockToRetrieve),
+						zap.Error(err),
+					)
+					break
+				}
+				cp.trackBlock(ctx, block, blocks)
+				blocks[blockToRetrieve] = block
+				cp.metrics.RecordLastPolledHeight(block.Height)
+
+				cp.logger.Info("the poller retrieved the block from the consumer chain",
+					zap.Uint64("height", block.Height))
+
+				// the block revealed a chain reorg rewinding the subscription
+				if sub.NextHeight() != blockToRetrieve {
+					break
+				}
+			}
+
+			// push the data to the channel of the subscription
+			// Note: if the subscriber is too slow -- the buffer is full
+			// the block is retried in the next cycle without blocking
+			// the other subscribers
+			select {
+			case sub.blockInfoChan <- block:
+				// no error and we got the header we wanted to get, bump the state
+				sub.nextHeight.Store(blockToRetrieve + 1)
+			default:
+				cp.logger.Debug("the buffer of the subscriber is full, retry in the next cycle",
+					zap.Uint64("height", block.Height))
+			}
+
+			// stop if the block is not pushed or only the next block is needed
+			if latestBlock == nil || sub.NextHeight() != blockToRetrieve+1 {
+				break
+			}
+		}
+	}
+
+	return len(blocks), len(failedHeights)
+}
+
+// trackBlock records the hash of the retrieved block to detect chain reorgs
+// a reorg is detected if the block replaces a tracked block at the same height
+// or it does not link to the tracked parent block, in which case the subscribers
+// having received the replaced blocks are rewound to the fork height
+func (cp *ChainPoller) trackBlock(ctx context.Context, block *types.BlockInfo, blocks map[uint64]*types.BlockInfo) {
+	var replacedHeight uint64
+	if hash, ok := cp.blockHashes[block.Height]; ok && !bytes.Equal(hash, block.Hash) {
+		replacedHeight = block.Height
+	} else if parentHash, ok := cp.blockHashes[block.Height-1]; ok && len(block.ParentHash) != 0 &&
+		!bytes.Equal(parentHash, block.ParentHash) {
+		replacedHeight = block.Height - 1
+	}
+
+	if replacedHeight != 0 {
+		forkHeight := cp.findForkHeight(ctx, replacedHeight)
+		cp.logger.Warn("the poller detected a chain reorg",
+			zap.Uint64("fork_height", forkHeight),
+			zap.Uint64("height", block.Height))
+		cp.metrics.IncrementChainReorgs()
+
+		// forget the replaced blocks
+		for height := range cp.blockHashes {
+			if height >= forkHeight {
+				delete(cp.blockHashes, height)
+			}
+		}
+		for height := range blocks {
+			if height >= forkHeight {
+				delete(blocks, height)
+			}
+		}
+
+		for _, sub := range cp.subscriptions() {
+			if sub.NextHeight() > forkHeight {
+				sub.rewindToHeight(forkHeight)
+			}
+		}
+	}
+
+	cp.blockHashes[block.Height] = block.Hash
+	// forget every block out of the max reorg depth, including
+	// those left behind when the poller skipped some heights
+	for height := range cp.blockHashes {
+		if height+cp.cfg.MaxReorgDepth <= block.Height {
+			delete(cp.blockHashes, height)
+		}
+	}
+}
+
+// findForkHeight walks back from the replaced height until the block
+// matches the tracked one and returns the lowest replaced height
+func (cp *ChainPoller) findForkHeight(ctx context.Context, replacedHeight uint64) uint64 {
+	forkHeight := replacedHeight
+	for height := replacedHeight - 1; height > 0; height-- {
+		hash, ok := cp.blockHashes[height]
+		if !ok {
+			if height+cp.cfg.MaxReorgDepth > replacedHeight {
+				// the height is not polled yet
+				break
+			}
+			cp.logger.Error("the chain reorg is deeper than the maximum reorg depth",
+				zap.Uint64("max_reorg_depth", cp.cfg.MaxReorgDepth))
+			break
+		}
+
+		block, err := cp.blockWithRetry(ctx, height)
+		if err != nil {
+			cp.logger.Debug("failed to query the consumer chain for the fork height",
+				zap.Uint64("height", height), zap.Error(err))
+			break
+		}
+		if bytes.Equal(hash, block.Hash) {
+			break
+		}
+		forkHeight = height
+	}
+
+	return forkHeight
+}
+
+func (cp *ChainPoller) skipToHeight(sub *ChainPollerSubscription, height uint64) error {
 	if !cp.IsRunning() {
 		return fmt.Errorf("the chain poller is stopped")
 	}
@@ -310,7 +698,7 @@ func (cp *ChainPoller) SkipToHeight(height uint64) error {
 	select {
 	case <-cp.quit:
 		return fmt.Errorf("the chain poller is stopped")
-	case cp.skipHeightChan <- &skipHeightRequest{height: height, resp: respChan}:
+	case cp.skipHeightChan <- &skipHeightRequest{sub: sub, height: height, resp: respChan}:
 	}
 
 	// this handles the case when the poller is stopped before
@@ -322,16 +710,3 @@ func (cp *ChainPoller) SkipToHeight(height uint64) error {
 		return resp.err
 	}
 }
-
-func (cp *ChainPoller) NextHeight() uint64 {
-	return cp.nextHeight
-}
-
-func (cp *ChainPoller) clearChanBufferUpToHeight(upToHeight uint64) {
-	for len(cp.blockInfoChan) > 0 {
-		block := <-cp.blockInfoChan
-		if block.Height+1 >= upToHeight {
-			break
-		}
-	}
-}
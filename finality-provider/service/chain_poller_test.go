@@ -1,8 +1,11 @@
 package service_test
 
 import (
+	"context"
+	"fmt"
 	"math/rand"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -31,39 +34,49 @@ func FuzzChainPoller_Start(f *testing.F) {
 
 		ctl := gomock.NewController(t)
 		mockClientController := mocks.NewMockClientController(ctl)
-		mockClientController.EXPECT().Close().Return(nil).AnyTimes()
-		mockClientController.EXPECT().QueryActivatedHeight().Return(uint64(1), nil).AnyTimes()
+		mockClientController.EXPECT().QueryActivatedHeight(gomock.Any()).Return(uint64(1), nil).AnyTimes()
 
 		currentBlockRes := &types.BlockInfo{
 			Height: currentHeight,
 		}
-		mockClientController.EXPECT().QueryBestBlock().Return(currentBlockRes, nil).AnyTimes()
+		mockClientController.EXPECT().QueryBestBlock(gomock.Any()).Return(currentBlockRes, nil).AnyTimes()
 
 		for i := startHeight; i <= endHeight; i++ {
 			resBlock := &types.BlockInfo{
 				Height: i,
 			}
-			mockClientController.EXPECT().QueryBlock(i).Return(resBlock, nil).AnyTimes()
+			mockClientController.EXPECT().QueryBlock(gomock.Any(), i).Return(resBlock, nil).AnyTimes()
 		}
 
 		// TODO: use mock metrics
 		m := metrics.NewFpMetrics()
 		pollerCfg := fpcfg.DefaultChainPollerConfig()
 		pollerCfg.PollInterval = 10 * time.Millisecond
-		poller := service.NewChainPoller(zap.NewNop(), &pollerCfg, mockClientController, m)
-		err := poller.Start(startHeight)
+		retryPolicy := fpcfg.DefaultQueryRetryPolicy()
+		poller := service.NewChainPoller(zap.NewNop(), &pollerCfg, &retryPolicy, mockClientController, m)
+		err := poller.Start()
 		require.NoError(t, err)
 		defer func() {
 			err := poller.Stop()
 			require.NoError(t, err)
 		}()
 
+		// every subscriber receives the blocks in sequence
+		numSubs := r.Intn(3) + 1
+		subs := make([]*service.ChainPollerSubscription, numSubs)
+		for i := range subs {
+			subs[i], err = poller.Subscribe(context.Background(), startHeight)
+			require.NoError(t, err)
+		}
+
 		for i := startHeight; i <= endHeight; i++ {
-			select {
-			case info := <-poller.GetBlockInfoChan():
-				require.Equal(t, i, info.Height)
-			case <-time.After(10 * time.Second):
-				t.Fatalf("Failed to get block info")
+			for _, sub := range subs {
+				select {
+				case info := <-sub.GetBlockInfoChan():
+					require.Equal(t, i, info.Height)
+				case <-time.After(10 * time.Second):
+					t.Fatalf("Failed to get block info")
+				}
 			}
 		}
 	})
@@ -77,41 +90,48 @@ func FuzzChainPoller_SkipHeight(f *testing.F) {
 
 		currentHeight := uint64(r.Int63n(100) + 1)
 		startHeight := currentHeight + 1
-		endHeight := startHeight + uint64(r.Int63n(10)+2)
+		// the first blocks are retrieved without waiting for the poll interval
+		// once the subscriptions are added and the lower height is skipped
+		endHeight := startHeight + uint64(r.Int63n(10)+3)
 		skipHeight := endHeight + uint64(r.Int63n(10)+1)
 
 		ctl := gomock.NewController(t)
 		mockClientController := mocks.NewMockClientController(ctl)
-		mockClientController.EXPECT().Close().Return(nil).AnyTimes()
-		mockClientController.EXPECT().QueryActivatedHeight().Return(uint64(1), nil).AnyTimes()
+		mockClientController.EXPECT().QueryActivatedHeight(gomock.Any()).Return(uint64(1), nil).AnyTimes()
 
 		currentBlockRes := &types.BlockInfo{
 			Height: currentHeight,
 		}
-		mockClientController.EXPECT().QueryBestBlock().Return(currentBlockRes, nil).AnyTimes()
+		mockClientController.EXPECT().QueryBestBlock(gomock.Any()).Return(currentBlockRes, nil).AnyTimes()
 
 		for i := startHeight; i <= skipHeight; i++ {
 			resBlock := &types.BlockInfo{
 				Height: i,
 			}
-			mockClientController.EXPECT().QueryBlock(i).Return(resBlock, nil).AnyTimes()
+			mockClientController.EXPECT().QueryBlock(gomock.Any(), i).Return(resBlock, nil).AnyTimes()
 		}
 
 		// TODO: use mock metrics
 		m := metrics.NewFpMetrics()
 		pollerCfg := fpcfg.DefaultChainPollerConfig()
 		pollerCfg.PollInterval = 1 * time.Second
-		poller := service.NewChainPoller(zap.NewNop(), &pollerCfg, mockClientController, m)
+		retryPolicy := fpcfg.DefaultQueryRetryPolicy()
+		poller := service.NewChainPoller(zap.NewNop(), &pollerCfg, &retryPolicy, mockClientController, m)
 		// should expect error if the poller is not started
-		err := poller.SkipToHeight(skipHeight)
+		_, err := poller.Subscribe(context.Background(), startHeight)
 		require.Error(t, err)
-		err = poller.Start(startHeight)
+		err = poller.Start()
+		require.NoError(t, err)
+		sub, err := poller.Subscribe(context.Background(), startHeight)
+		require.NoError(t, err)
+		// the other subscriber is not affected by the skipped heights
+		otherSub, err := poller.Subscribe(context.Background(), startHeight)
 		require.NoError(t, err)
 		defer func() {
 			err := poller.Stop()
 			require.NoError(t, err)
 			// should expect error if the poller is stopped
-			err = poller.SkipToHeight(skipHeight)
+			err = sub.SkipToHeight(skipHeight)
 			require.Error(t, err)
 		}()
 
@@ -121,11 +141,11 @@ func FuzzChainPoller_SkipHeight(f *testing.F) {
 			wg.Done()
 			// insert a skipToHeight request with height lower than the next
 			// height to retrieve, expecting an error
-			err = poller.SkipToHeight(poller.NextHeight() - 1)
+			err = sub.SkipToHeight(sub.NextHeight() - 1)
 			require.Error(t, err)
 			// insert a skipToHeight request with a height higher than the
 			// next height to retrieve
-			err = poller.SkipToHeight(skipHeight)
+			err = sub.SkipToHeight(skipHeight)
 			require.NoError(t, err)
 		}()
 
@@ -135,7 +155,7 @@ func FuzzChainPoller_SkipHeight(f *testing.F) {
 				break
 			}
 			select {
-			case info := <-poller.GetBlockInfoChan():
+			case info := <-sub.GetBlockInfoChan():
 				if info.Height == skipHeight {
 					skipped = true
 				} else {
@@ -148,6 +168,262 @@ func FuzzChainPoller_SkipHeight(f *testing.F) {
 
 		wg.Wait()
 
-		require.Equal(t, skipHeight+1, poller.NextHeight())
+		require.Equal(t, skipHeight+1, sub.NextHeight())
+
+		select {
+		case info := <-otherSub.GetBlockInfoChan():
+			require.Equal(t, startHeight, info.Height)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("Failed to get block info")
+		}
+
+		// should expect error if the subscription is closed
+		sub.Unsubscribe()
+		require.Error(t, sub.SkipToHeight(skipHeight+1))
+	})
+}
+
+// subscribingClientController pushes the blocks sent to blockChan
+// to the first subscriber to new blocks
+type subscribingClientController struct {
+	*mocks.MockClientController
+	blockChan  chan *types.BlockInfo
+	subscribed bool
+}
+
+func (cc *subscribingClientController) SubscribeNewBlocks(_ context.Context) (<-chan *types.BlockInfo, error) {
+	if cc.subscribed {
+		return nil, fmt.Errorf("the subscription is not available")
+	}
+	cc.subscribed = true
+
+	return cc.blockChan, nil
+}
+
+// FuzzChainPoller_Subscription tests the poller receiving blocks
+// from the subscription and falling back to polling
+func FuzzChainPoller_Subscription(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		currentHeight := uint64(r.Int63n(100) + 1)
+		startHeight := currentHeight + 1
+		// the heights lower than the pushed height are backfilled
+		pushedHeight := startHeight + uint64(r.Int63n(10))
+		endHeight := pushedHeight + 1
+
+		ctl := gomock.NewController(t)
+		mockClientController := mocks.NewMockClientController(ctl)
+		mockClientController.EXPECT().QueryActivatedHeight(gomock.Any()).Return(uint64(1), nil).AnyTimes()
+
+		currentBlockRes := &types.BlockInfo{
+			Height: currentHeight,
+		}
+		mockClientController.EXPECT().QueryBestBlock(gomock.Any()).Return(currentBlockRes, nil).AnyTimes()
+
+		for i := startHeight; i <= endHeight; i++ {
+			if i == pushedHeight {
+				continue
+			}
+			resBlock := &types.BlockInfo{
+				Height: i,
+			}
+			mockClientController.EXPECT().QueryBlock(gomock.Any(), i).Return(resBlock, nil).AnyTimes()
+		}
+		cc := &subscribingClientController{
+			MockClientController: mockClientController,
+			blockChan:            make(chan *types.BlockInfo),
+		}
+
+		// TODO: use mock metrics
+		m := metrics.NewFpMetrics()
+		pollerCfg := fpcfg.DefaultChainPollerConfig()
+		pollerCfg.Mode = fpcfg.ChainPollerModeSubscription
+		// the blocks are not polled at the interval during the test
+		pollerCfg.PollInterval = 1 * time.Minute
+		retryPolicy := fpcfg.DefaultQueryRetryPolicy()
+		poller := service.NewChainPoller(zap.NewNop(), &pollerCfg, &retryPolicy, cc, m)
+		err := poller.Start()
+		require.NoError(t, err)
+		defer func() {
+			err := poller.Stop()
+			require.NoError(t, err)
+		}()
+		sub, err := poller.Subscribe(context.Background(), startHeight)
+		require.NoError(t, err)
+
+		select {
+		case cc.blockChan <- &types.BlockInfo{Height: pushedHeight}:
+		case <-time.After(10 * time.Second):
+			t.Fatalf("Failed to push the block")
+		}
+
+		// the lost subscription falls back to polling
+		close(cc.blockChan)
+
+		for i := startHeight; i <= endHeight; i++ {
+			select {
+			case info := <-sub.GetBlockInfoChan():
+				require.Equal(t, i, info.Height)
+			case <-time.After(10 * time.Second):
+				t.Fatalf("Failed to get block info")
+			}
+		}
+	})
+}
+
+// FuzzChainPoller_Reorg tests that the poller detects the chain reorg
+// and the subscriber receives the blocks again from the fork height
+func FuzzChainPoller_Reorg(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		currentHeight := uint64(r.Int63n(100) + 1)
+		startHeight := currentHeight + 1
+		endHeight := startHeight + uint64(r.Int63n(10)+1)
+		forkHeight := startHeight + 1 + uint64(r.Int63n(int64(endHeight-startHeight)))
+
+		var (
+			mu      sync.Mutex
+			reorged bool
+		)
+		blockHash := func(height uint64) []byte {
+			if reorged && height >= forkHeight {
+				return []byte(fmt.Sprintf("reorged-%d", height))
+			}
+			return []byte(fmt.Sprintf("block-%d", height))
+		}
+
+		ctl := gomock.NewController(t)
+		mockClientController := mocks.NewMockClientController(ctl)
+		mockClientController.EXPECT().QueryActivatedHeight(gomock.Any()).Return(uint64(1), nil).AnyTimes()
+		mockClientController.EXPECT().QueryBestBlock(gomock.Any()).Return(&types.BlockInfo{Height: currentHeight}, nil).AnyTimes()
+		mockClientController.EXPECT().QueryBlock(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, height uint64) (*types.BlockInfo, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				// the reorged chain is one block longer
+				tip := endHeight
+				if reorged {
+					tip++
+				}
+				if height > tip {
+					return nil, fmt.Errorf("block %d not found", height)
+				}
+				return &types.BlockInfo{
+					Height:     height,
+					Hash:       blockHash(height),
+					ParentHash: blockHash(height - 1),
+				}, nil
+			}).AnyTimes()
+
+		m := metrics.NewFpMetrics()
+		pollerCfg := fpcfg.DefaultChainPollerConfig()
+		pollerCfg.PollInterval = 10 * time.Millisecond
+		retryPolicy := fpcfg.DefaultQueryRetryPolicy()
+		poller := service.NewChainPoller(zap.NewNop(), &pollerCfg, &retryPolicy, mockClientController, m)
+		err := poller.Start()
+		require.NoError(t, err)
+		defer func() {
+			err := poller.Stop()
+			require.NoError(t, err)
+		}()
+
+		sub, err := poller.Subscribe(context.Background(), startHeight)
+		require.NoError(t, err)
+
+		for i := startHeight; i <= endHeight; i++ {
+			select {
+			case info := <-sub.GetBlockInfoChan():
+				require.Equal(t, i, info.Height)
+			case <-time.After(10 * time.Second):
+				t.Fatalf("Failed to get block info")
+			}
+		}
+
+		mu.Lock()
+		reorged = true
+		mu.Unlock()
+
+		select {
+		case ev := <-sub.GetReorgChan():
+			require.Equal(t, forkHeight, ev.ForkHeight)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("Failed to get reorg event")
+		}
+
+		for i := forkHeight; i <= endHeight+1; i++ {
+			select {
+			case info := <-sub.GetBlockInfoChan():
+				require.Equal(t, i, info.Height)
+				require.Equal(t, []byte(fmt.Sprintf("reorged-%d", i)), info.Hash)
+			case <-time.After(10 * time.Second):
+				t.Fatalf("Failed to get block info")
+			}
+		}
+	})
+}
+
+// FuzzChainPoller_Restart tests that the poller reports an error to the
+// subscribers once it reaches the max failed cycles and keeps polling the
+// chain after the restart backoff
+func FuzzChainPoller_Restart(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		currentHeight := uint64(r.Int63n(100) + 1)
+		startHeight := currentHeight + 1
+
+		ctl := gomock.NewController(t)
+		mockClientController := mocks.NewMockClientController(ctl)
+		mockClientController.EXPECT().QueryActivatedHeight(gomock.Any()).Return(uint64(1), nil).AnyTimes()
+		mockClientController.EXPECT().QueryBestBlock(gomock.Any()).Return(&types.BlockInfo{Height: currentHeight}, nil).AnyTimes()
+
+		// the chain is unreachable until the poller reports the error
+		var reachable atomic.Bool
+		mockClientController.EXPECT().QueryBlock(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, height uint64) (*types.BlockInfo, error) {
+				if !reachable.Load() {
+					return nil, fmt.Errorf("connection refused")
+				}
+				return &types.BlockInfo{Height: height}, nil
+			}).AnyTimes()
+
+		m := metrics.NewFpMetrics()
+		pollerCfg := fpcfg.DefaultChainPollerConfig()
+		pollerCfg.PollInterval = 10 * time.Millisecond
+		pollerCfg.MaxFailedCycles = uint32(r.Intn(3) + 1)
+		pollerCfg.RestartBackoff = 50 * time.Millisecond
+		retryPolicy := fpcfg.DefaultQueryRetryPolicy()
+		retryPolicy.Attempts = 1
+		poller := service.NewChainPoller(zap.NewNop(), &pollerCfg, &retryPolicy, mockClientController, m)
+		err := poller.Start()
+		require.NoError(t, err)
+		defer func() {
+			err := poller.Stop()
+			require.NoError(t, err)
+		}()
+
+		sub, err := poller.Subscribe(context.Background(), startHeight)
+		require.NoError(t, err)
+
+		select {
+		case err := <-sub.GetErrChan():
+			require.Error(t, err)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("the poller did not report the error")
+		}
+		require.True(t, poller.IsRunning())
+
+		reachable.Store(true)
+		select {
+		case info := <-sub.GetBlockInfoChan():
+			require.Equal(t, startHeight, info.Height)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("the poller did not poll the chain after the restart")
+		}
 	})
 }
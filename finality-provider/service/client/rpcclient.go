@@ -8,17 +8,24 @@ import (
 	bbntypes "github.com/babylonchain/babylon/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/babylonchain/finality-provider/finality-provider/proto"
+	"github.com/babylonchain/finality-provider/rpcauth"
 )
 
 type FinalityProviderServiceGRpcClient struct {
 	client proto.FinalityProvidersClient
 }
 
-func NewFinalityProviderServiceGRpcClient(remoteAddr string) (*FinalityProviderServiceGRpcClient, func(), error) {
-	conn, err := grpc.Dial(remoteAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// NewFinalityProviderServiceGRpcClient connects to the finality provider daemon at the given
+// address, following the given auth config, or insecurely if it is nil
+func NewFinalityProviderServiceGRpcClient(remoteAddr string, authCfg *rpcauth.ClientConfig) (*FinalityProviderServiceGRpcClient, func(), error) {
+	dialOpts, err := rpcauth.DialOptions(authCfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid RPC auth config: %w", err)
+	}
+
+	conn, err := grpc.Dial(remoteAddr, dialOpts...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to build gRPC connection to %s: %w", remoteAddr, err)
 	}
@@ -46,9 +53,10 @@ func (c *FinalityProviderServiceGRpcClient) RegisterFinalityProvider(
 	ctx context.Context,
 	fpPk *bbntypes.BIP340PubKey,
 	passphrase string,
+	autoStart bool,
 ) (*proto.RegisterFinalityProviderResponse, error) {
 
-	req := &proto.RegisterFinalityProviderRequest{BtcPk: fpPk.MarshalHex(), Passphrase: passphrase}
+	req := &proto.RegisterFinalityProviderRequest{BtcPk: fpPk.MarshalHex(), Passphrase: passphrase, AutoStart: autoStart}
 	res, err := c.client.RegisterFinalityProvider(ctx, req)
 	if err != nil {
 		return nil, err
@@ -101,6 +109,56 @@ func (c *FinalityProviderServiceGRpcClient) AddFinalitySignature(ctx context.Con
 	return res, nil
 }
 
+func (c *FinalityProviderServiceGRpcClient) UnlockFinalityProvider(
+	ctx context.Context,
+	fpPk *bbntypes.BIP340PubKey,
+	passphrase string,
+) (*proto.UnlockFinalityProviderResponse, error) {
+
+	req := &proto.UnlockFinalityProviderRequest{BtcPk: fpPk.MarshalHex(), Passphrase: passphrase}
+	res, err := c.client.UnlockFinalityProvider(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *FinalityProviderServiceGRpcClient) StopFinalityProvider(ctx context.Context, fpPk *bbntypes.BIP340PubKey) (*proto.StopFinalityProviderResponse, error) {
+	req := &proto.StopFinalityProviderRequest{BtcPk: fpPk.MarshalHex()}
+	res, err := c.client.StopFinalityProvider(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *FinalityProviderServiceGRpcClient) PauseFinalityProvider(ctx context.Context, fpPk *bbntypes.BIP340PubKey) (*proto.PauseFinalityProviderResponse, error) {
+	req := &proto.PauseFinalityProviderRequest{BtcPk: fpPk.MarshalHex()}
+	res, err := c.client.PauseFinalityProvider(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *FinalityProviderServiceGRpcClient) ResumeFinalityProvider(
+	ctx context.Context,
+	fpPk *bbntypes.BIP340PubKey,
+	passphrase string,
+) (*proto.ResumeFinalityProviderResponse, error) {
+
+	req := &proto.ResumeFinalityProviderRequest{BtcPk: fpPk.MarshalHex(), Passphrase: passphrase}
+	res, err := c.client.ResumeFinalityProvider(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 func (c *FinalityProviderServiceGRpcClient) QueryFinalityProviderList(ctx context.Context) (*proto.QueryFinalityProviderListResponse, error) {
 	req := &proto.QueryFinalityProviderListRequest{}
 	res, err := c.client.QueryFinalityProviderList(ctx, req)
@@ -121,6 +179,35 @@ func (c *FinalityProviderServiceGRpcClient) QueryFinalityProviderInfo(ctx contex
 	return res, nil
 }
 
+func (c *FinalityProviderServiceGRpcClient) QueryVotes(
+	ctx context.Context,
+	fpPk *bbntypes.BIP340PubKey,
+	startHeight uint64,
+	limit uint32,
+) (*proto.QueryVotesResponse, error) {
+	req := &proto.QueryVotesRequest{
+		BtcPk:       fpPk.MarshalHex(),
+		StartHeight: startHeight,
+		Limit:       limit,
+	}
+	res, err := c.client.QueryVotes(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *FinalityProviderServiceGRpcClient) QueryLiveness(ctx context.Context, fpPk *bbntypes.BIP340PubKey) (*proto.QueryLivenessResponse, error) {
+	req := &proto.QueryLivenessRequest{BtcPk: fpPk.MarshalHex()}
+	res, err := c.client.QueryLiveness(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 func (c *FinalityProviderServiceGRpcClient) SignMessageFromChainKey(
 	ctx context.Context,
 	keyName, passphrase, hdPath string,
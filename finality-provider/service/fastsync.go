@@ -1,6 +1,8 @@
 package service
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"go.uber.org/zap"
@@ -17,7 +19,7 @@ type FastSyncResult struct {
 // FastSync attempts to send a batch of finality signatures
 // from the maximum of the last voted height and the last finalized height
 // to the current height
-func (fp *FinalityProviderInstance) FastSync(startHeight, endHeight uint64) (*FastSyncResult, error) {
+func (fp *FinalityProviderInstance) FastSync(ctx context.Context, startHeight, endHeight uint64) (*FastSyncResult, error) {
 	if fp.inSync.Swap(true) {
 		return nil, fmt.Errorf("the finality-provider has already been in fast sync")
 	}
@@ -33,7 +35,7 @@ func (fp *FinalityProviderInstance) FastSync(startHeight, endHeight uint64) (*Fa
 	// we may need several rounds to catch-up as we need to limit
 	// the catch-up distance for each round to avoid memory overflow
 	for startHeight <= endHeight {
-		blocks, err := fp.cc.QueryBlocks(startHeight, endHeight, fp.cfg.FastSyncLimit)
+		blocks, err := fp.cc.QueryBlocks(ctx, startHeight, endHeight, fp.cfg.FastSyncLimit)
 		if err != nil {
 			return nil, err
 		}
@@ -54,7 +56,7 @@ func (fp *FinalityProviderInstance) FastSync(startHeight, endHeight uint64) (*Fa
 				continue
 			}
 			// check whether the finality provider has voting power
-			hasVp, err := fp.hasVotingPower(b)
+			hasVp, err := fp.hasVotingPower(ctx, b)
 			if err != nil {
 				return nil, err
 			}
@@ -62,6 +64,19 @@ func (fp *FinalityProviderInstance) FastSync(startHeight, endHeight uint64) (*Fa
 				fp.metrics.IncrementFpTotalBlocksWithoutVotingPower(fp.GetBtcPkHex())
 				continue
 			}
+			// skip the block if a different block has been voted at the height
+			if err := fp.checkVotedBlock(b); err != nil {
+				if !errors.Is(err, ErrConflictingBlock) {
+					return nil, err
+				}
+				fp.logger.Error(
+					"the finality-provider refused to vote for a conflicting block",
+					zap.String("pk", fp.GetBtcPkHex()),
+					zap.Error(err),
+				)
+				fp.metrics.IncrementFpTotalConflictingBlocks(fp.GetBtcPkHex())
+				continue
+			}
 			// all good, add the block for catching up
 			catchUpBlocks = append(catchUpBlocks, b)
 		}
@@ -72,7 +87,7 @@ func (fp *FinalityProviderInstance) FastSync(startHeight, endHeight uint64) (*Fa
 
 		syncedHeight = catchUpBlocks[len(catchUpBlocks)-1].Height
 
-		res, err := fp.SubmitBatchFinalitySignatures(catchUpBlocks)
+		res, err := fp.SubmitBatchFinalitySignatures(ctx, catchUpBlocks)
 		if err != nil {
 			return nil, err
 		}
@@ -1,6 +1,7 @@
 package service_test
 
 import (
+	"context"
 	"math/rand"
 	"testing"
 
@@ -26,23 +27,23 @@ func FuzzFastSync(f *testing.F) {
 		currentHeight := finalizedHeight + uint64(r.Int63n(10)+1)
 		mockClientController := testutil.PrepareMockedClientController(t, r, randomStartingHeight, currentHeight)
 		// mock finalised BTC timestamped
-		mockClientController.EXPECT().QueryLastFinalizedEpoch().Return(randomRegiteredEpoch, nil).AnyTimes()
+		mockClientController.EXPECT().QueryLastFinalizedEpoch(gomock.Any()).Return(randomRegiteredEpoch, nil).AnyTimes()
 		_, fpIns, cleanUp := startFinalityProviderAppWithRegisteredFp(t, r, mockClientController, randomStartingHeight, randomRegiteredEpoch)
 		defer cleanUp()
 
 		// mock voting power
-		mockClientController.EXPECT().QueryFinalityProviderVotingPower(fpIns.GetBtcPk(), gomock.Any()).
+		mockClientController.EXPECT().QueryFinalityProviderVotingPower(gomock.Any(), fpIns.GetBtcPk(), gomock.Any()).
 			Return(uint64(1), nil).AnyTimes()
 
 		catchUpBlocks := testutil.GenBlocks(r, finalizedHeight+1, currentHeight)
 		expectedTxHash := testutil.GenRandomHexStr(r, 32)
 		finalizedBlock := &types.BlockInfo{Height: finalizedHeight, Hash: testutil.GenRandomByteArray(r, 32)}
-		mockClientController.EXPECT().QueryLatestFinalizedBlocks(uint64(1)).Return([]*types.BlockInfo{finalizedBlock}, nil).AnyTimes()
-		mockClientController.EXPECT().QueryBlocks(finalizedHeight+1, currentHeight, uint64(10)).
+		mockClientController.EXPECT().QueryLatestFinalizedBlocks(gomock.Any(), uint64(1)).Return([]*types.BlockInfo{finalizedBlock}, nil).AnyTimes()
+		mockClientController.EXPECT().QueryBlocks(gomock.Any(), finalizedHeight+1, currentHeight, uint64(10)).
 			Return(catchUpBlocks, nil)
-		mockClientController.EXPECT().SubmitBatchFinalitySigs(fpIns.GetBtcPk(), catchUpBlocks, gomock.Any()).
+		mockClientController.EXPECT().SubmitBatchFinalitySigs(gomock.Any(), fpIns.GetBtcPk(), catchUpBlocks, gomock.Any()).
 			Return(&types.TxResponse{TxHash: expectedTxHash}, nil).AnyTimes()
-		result, err := fpIns.FastSync(finalizedHeight+1, currentHeight)
+		result, err := fpIns.FastSync(context.Background(), finalizedHeight+1, currentHeight)
 		require.NoError(t, err)
 		require.NotNil(t, result)
 		require.Equal(t, expectedTxHash, result.Responses[0].TxHash)
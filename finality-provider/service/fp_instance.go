@@ -1,15 +1,15 @@
 package service
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 	"sync"
 	"time"
 
-	"github.com/avast/retry-go/v4"
 	bbntypes "github.com/babylonchain/babylon/types"
-	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
 	ftypes "github.com/babylonchain/babylon/x/finality/types"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -26,6 +26,14 @@ import (
 	"github.com/babylonchain/finality-provider/types"
 )
 
+// ErrEpochNotFinalized is returned when a finality-provider instance is created before
+// the epoch in which the finality provider is registered is BTC timestamped
+var ErrEpochNotFinalized = errors.New("the registered epoch of the finality provider is not BTC timestamped yet")
+
+// ErrConflictingBlock is returned when the finality provider is asked to vote for a block
+// at a height where it has already voted for a different block, e.g., after a chain reorg
+var ErrConflictingBlock = errors.New("a different block has been voted at the height")
+
 type FinalityProviderInstance struct {
 	chainPk *secp256k1.PubKey
 	btcPk   *bbntypes.BIP340PubKey
@@ -39,6 +47,12 @@ type FinalityProviderInstance struct {
 	poller  *ChainPoller
 	metrics *metrics.FpMetrics
 
+	// pollerSub receives the blocks from the poller shared by the instances
+	pollerSub *ChainPollerSubscription
+	// aggregator submits the finality signatures along with the ones of the other
+	// instances, which is nil if the finality signatures are submitted separately
+	aggregator *SubmissionAggregator
+
 	// passphrase is used to unlock private keys
 	passphrase string
 
@@ -51,16 +65,21 @@ type FinalityProviderInstance struct {
 
 	wg   sync.WaitGroup
 	quit chan struct{}
+	// cancel cancels the in-flight requests to the consumer chain on stop
+	cancel context.CancelFunc
 }
 
 // NewFinalityProviderInstance returns a FinalityProviderInstance instance with the given Babylon public key
 // the finality-provider should be registered before
 func NewFinalityProviderInstance(
+	ctx context.Context,
 	fpPk *bbntypes.BIP340PubKey,
 	cfg *fpcfg.Config,
 	s *store.FinalityProviderStore,
 	cc clientcontroller.ClientController,
 	em eotsmanager.EOTSManager,
+	poller *ChainPoller,
+	aggregator *SubmissionAggregator,
 	metrics *metrics.FpMetrics,
 	passphrase string,
 	errChan chan<- *CriticalError,
@@ -77,12 +96,12 @@ func NewFinalityProviderInstance(
 	}
 
 	registeredEpoch := sfp.RegisteredEpoch
-	lastFinalizedEpoch, err := cc.QueryLastFinalizedEpoch()
+	lastFinalizedEpoch, err := cc.QueryLastFinalizedEpoch(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get the last finalized epoch: %v", err)
 	}
 	if lastFinalizedEpoch < registeredEpoch {
-		return nil, fmt.Errorf("the registered epoch %d of the finality provider %s is not BTC timestamped yet (last finalized epoch: %d)", registeredEpoch, sfp.KeyName, lastFinalizedEpoch)
+		return nil, fmt.Errorf("%w: registered epoch %d of %s, last finalized epoch %d", ErrEpochNotFinalized, registeredEpoch, sfp.KeyName, lastFinalizedEpoch)
 	}
 
 	return &FinalityProviderInstance{
@@ -101,6 +120,8 @@ func NewFinalityProviderInstance(
 		passphrase:      passphrase,
 		em:              em,
 		cc:              cc,
+		poller:          poller,
+		aggregator:      aggregator,
 		metrics:         metrics,
 	}, nil
 }
@@ -112,48 +133,52 @@ func (fp *FinalityProviderInstance) Start() error {
 
 	fp.logger.Info("Starting finality-provider instance", zap.String("pk", fp.GetBtcPkHex()))
 
-	startHeight, err := fp.bootstrap()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	startHeight, err := fp.bootstrap(ctx)
 	if err != nil {
+		cancel()
 		return fmt.Errorf("failed to bootstrap the finality-provider %s: %w", fp.GetBtcPkHex(), err)
 	}
 
 	fp.logger.Info("the finality-provider has been bootstrapped",
 		zap.String("pk", fp.GetBtcPkHex()), zap.Uint64("height", startHeight))
 
-	poller := NewChainPoller(fp.logger, fp.cfg.PollerConfig, fp.cc, fp.metrics)
-
-	if err := poller.Start(startHeight + 1); err != nil {
-		return fmt.Errorf("failed to start the poller: %w", err)
+	pollerSub, err := fp.poller.Subscribe(ctx, startHeight+1)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("failed to subscribe to the poller: %w", err)
 	}
 
-	fp.poller = poller
+	fp.pollerSub = pollerSub
 
 	fp.laggingTargetChan = make(chan *types.BlockInfo, 1)
 
 	fp.quit = make(chan struct{})
+	fp.cancel = cancel
 
 	fp.wg.Add(1)
-	go fp.finalitySigSubmissionLoop()
+	go fp.finalitySigSubmissionLoop(ctx)
 	fp.wg.Add(1)
-	go fp.checkLaggingLoop()
+	go fp.checkLaggingLoop(ctx)
 
 	return nil
 }
 
-func (fp *FinalityProviderInstance) bootstrap() (uint64, error) {
-	latestBlock, err := fp.getLatestBlockWithRetry()
+func (fp *FinalityProviderInstance) bootstrap(ctx context.Context) (uint64, error) {
+	latestBlock, err := fp.getLatestBlockWithRetry(ctx)
 	if err != nil {
 		return 0, err
 	}
 
-	if fp.checkLagging(latestBlock) {
-		_, err := fp.tryFastSync(latestBlock)
+	if !fp.IsPaused() && fp.checkLagging(latestBlock) {
+		_, err := fp.tryFastSync(ctx, latestBlock)
 		if err != nil && !clientcontroller.IsExpected(err) {
 			return 0, err
 		}
 	}
 
-	startHeight, err := fp.getPollerStartingHeight()
+	startHeight, err := fp.getPollerStartingHeight(ctx)
 	if err != nil {
 		return 0, err
 	}
@@ -166,9 +191,10 @@ func (fp *FinalityProviderInstance) Stop() error {
 		return fmt.Errorf("the finality-provider %s has already stopped", fp.GetBtcPkHex())
 	}
 
-	if err := fp.poller.Stop(); err != nil {
-		return fmt.Errorf("failed to stop the poller: %w", err)
-	}
+	// cancel the in-flight requests so that the loops are not blocked by them
+	fp.cancel()
+
+	fp.pollerSub.Unsubscribe()
 
 	fp.logger.Info("stopping finality-provider instance", zap.String("pk", fp.GetBtcPkHex()))
 
@@ -184,12 +210,12 @@ func (fp *FinalityProviderInstance) IsRunning() bool {
 	return fp.isStarted.Load()
 }
 
-func (fp *FinalityProviderInstance) finalitySigSubmissionLoop() {
+func (fp *FinalityProviderInstance) finalitySigSubmissionLoop(ctx context.Context) {
 	defer fp.wg.Done()
 
 	for {
 		select {
-		case b := <-fp.poller.GetBlockInfoChan():
+		case b := <-fp.pollerSub.GetBlockInfoChan():
 			fp.logger.Debug(
 				"the finality-provider received a new block, start processing",
 				zap.String("pk", fp.GetBtcPkHex()),
@@ -200,9 +226,22 @@ func (fp *FinalityProviderInstance) finalitySigSubmissionLoop() {
 			if fp.hasProcessed(b) {
 				continue
 			}
+			// the paused finality provider keeps processing blocks without signing them
+			if fp.IsPaused() {
+				fp.MustSetLastProcessedHeight(b.Height)
+				fp.logger.Debug(
+					"the finality-provider is paused, skip signing the block",
+					zap.String("pk", fp.GetBtcPkHex()),
+					zap.Uint64("height", b.Height),
+				)
+				continue
+			}
 			// check whether the finality provider has voting power
-			hasVp, err := fp.hasVotingPower(b)
+			hasVp, err := fp.hasVotingPower(ctx, b)
 			if err != nil {
+				if ctx.Err() != nil {
+					continue
+				}
 				fp.reportCriticalErr(err)
 				continue
 			}
@@ -216,8 +255,23 @@ func (fp *FinalityProviderInstance) finalitySigSubmissionLoop() {
 
 			// use the copy of the block to avoid the impact to other receivers
 			nextBlock := *b
-			res, err := fp.retrySubmitFinalitySignatureUntilBlockFinalized(&nextBlock)
+			res, err := fp.retrySubmitFinalitySignatureUntilBlockFinalized(ctx, &nextBlock)
 			if err != nil {
+				if ctx.Err() != nil {
+					continue
+				}
+				if errors.Is(err, ErrConflictingBlock) {
+					// voting for the block would leak the EOTS private key,
+					// so the block is skipped
+					fp.logger.Error(
+						"the finality-provider refused to vote for a conflicting block",
+						zap.String("pk", fp.GetBtcPkHex()),
+						zap.Error(err),
+					)
+					fp.metrics.IncrementFpTotalConflictingBlocks(fp.GetBtcPkHex())
+					fp.MustSetLastProcessedHeight(b.Height)
+					continue
+				}
 				fp.metrics.IncrementFpTotalFailedVotes(fp.GetBtcPkHex())
 				fp.reportCriticalErr(err)
 				continue
@@ -235,11 +289,26 @@ func (fp *FinalityProviderInstance) finalitySigSubmissionLoop() {
 				zap.String("tx_hash", res.TxHash),
 			)
 
+		case ev := <-fp.pollerSub.GetReorgChan():
+			fp.logger.Warn(
+				"the finality-provider received a chain reorg, processing the blocks from the fork height again",
+				zap.String("pk", fp.GetBtcPkHex()),
+				zap.Uint64("fork_height", ev.ForkHeight),
+			)
+			if fp.GetLastProcessedHeight() >= ev.ForkHeight {
+				fp.MustRewindLastProcessedHeight(ev.ForkHeight - 1)
+			}
+
+		case err := <-fp.pollerSub.GetErrChan():
+			// the instance is set to ERRORED and restarted with backoff by
+			// the manager, while the shared poller restarts on its own
+			fp.reportCriticalErr(err)
+
 		case targetBlock := <-fp.laggingTargetChan:
-			res, err := fp.tryFastSync(targetBlock)
+			res, err := fp.tryFastSync(ctx, targetBlock)
 			fp.isLagging.Store(false)
 			if err != nil {
-				if errors.Is(err, bstypes.ErrFpAlreadySlashed) {
+				if clientcontroller.IsSlashed(err) {
 					fp.reportCriticalErr(err)
 					continue
 				}
@@ -261,7 +330,7 @@ func (fp *FinalityProviderInstance) finalitySigSubmissionLoop() {
 
 				// inform the poller to skip to the next block of the last
 				// processed one
-				err := fp.poller.SkipToHeight(fp.GetLastProcessedHeight() + 1)
+				err := fp.pollerSub.SkipToHeight(fp.GetLastProcessedHeight() + 1)
 				if err != nil {
 					fp.logger.Debug(
 						"failed to skip heights from the poller",
@@ -276,7 +345,7 @@ func (fp *FinalityProviderInstance) finalitySigSubmissionLoop() {
 	}
 }
 
-func (fp *FinalityProviderInstance) checkLaggingLoop() {
+func (fp *FinalityProviderInstance) checkLaggingLoop(ctx context.Context) {
 	defer fp.wg.Done()
 
 	if fp.cfg.FastSyncInterval == 0 {
@@ -294,8 +363,12 @@ func (fp *FinalityProviderInstance) checkLaggingLoop() {
 				// we are in fast sync mode, skip do not do checks
 				continue
 			}
+			if fp.IsPaused() {
+				// the paused finality provider does not sign blocks to catch up
+				continue
+			}
 
-			latestBlock, err := fp.getLatestBlockWithRetry()
+			latestBlock, err := fp.getLatestBlockWithRetry(ctx)
 			if err != nil {
 				fp.logger.Debug(
 					"failed to get the latest block of the consumer chain",
@@ -316,13 +389,13 @@ func (fp *FinalityProviderInstance) checkLaggingLoop() {
 	}
 }
 
-func (fp *FinalityProviderInstance) tryFastSync(targetBlock *types.BlockInfo) (*FastSyncResult, error) {
+func (fp *FinalityProviderInstance) tryFastSync(ctx context.Context, targetBlock *types.BlockInfo) (*FastSyncResult, error) {
 	if fp.inSync.Load() {
 		return nil, fmt.Errorf("the finality-provider %s is already in sync", fp.GetBtcPkHex())
 	}
 
 	// get the last finalized height
-	lastFinalizedBlocks, err := fp.cc.QueryLatestFinalizedBlocks(1)
+	lastFinalizedBlocks, err := fp.cc.QueryLatestFinalizedBlocks(ctx, 1)
 	if err != nil {
 		return nil, err
 	}
@@ -353,7 +426,7 @@ func (fp *FinalityProviderInstance) tryFastSync(targetBlock *types.BlockInfo) (*
 
 	fp.logger.Debug("the finality-provider is entering fast sync")
 
-	return fp.FastSync(startHeight, targetBlock.Height)
+	return fp.FastSync(ctx, startHeight, targetBlock.Height)
 }
 
 func (fp *FinalityProviderInstance) hasProcessed(b *types.BlockInfo) bool {
@@ -371,8 +444,8 @@ func (fp *FinalityProviderInstance) hasProcessed(b *types.BlockInfo) bool {
 }
 
 // hasVotingPower checks whether the finality provider has voting power for the given block
-func (fp *FinalityProviderInstance) hasVotingPower(b *types.BlockInfo) (bool, error) {
-	power, err := fp.GetVotingPowerWithRetry(b.Height)
+func (fp *FinalityProviderInstance) hasVotingPower(ctx context.Context, b *types.BlockInfo) (bool, error) {
+	power, err := fp.GetVotingPowerWithRetry(ctx, b.Height)
 	if err != nil {
 		return false, err
 	}
@@ -389,10 +462,16 @@ func (fp *FinalityProviderInstance) hasVotingPower(b *types.BlockInfo) (bool, er
 	return true, nil
 }
 
+// reportCriticalErr sends the critical error to the manager
+// it does not block once the instance is stopped, as the manager
+// stops the instance upon the first critical error it receives
 func (fp *FinalityProviderInstance) reportCriticalErr(err error) {
-	fp.criticalErrChan <- &CriticalError{
+	select {
+	case fp.criticalErrChan <- &CriticalError{
 		err:     err,
 		fpBtcPk: fp.GetBtcPkBIP340(),
+	}:
+	case <-fp.quit:
 	}
 }
 
@@ -403,44 +482,57 @@ func (fp *FinalityProviderInstance) checkLagging(currentBlock *types.BlockInfo)
 
 // retrySubmitFinalitySignatureUntilBlockFinalized periodically tries to submit finality signature until success or the block is finalized
 // error will be returned if maximum retries have been reached or the query to the consumer chain fails
-func (fp *FinalityProviderInstance) retrySubmitFinalitySignatureUntilBlockFinalized(targetBlock *types.BlockInfo) (*types.TxResponse, error) {
-	var failedCycles uint32
+func (fp *FinalityProviderInstance) retrySubmitFinalitySignatureUntilBlockFinalized(ctx context.Context, targetBlock *types.BlockInfo) (*types.TxResponse, error) {
+	var failedCycles uint
 
 	// we break the for loop if the block is finalized or the signature is successfully submitted
 	// error will be returned if maximum retries have been reached or the query to the consumer chain fails
 	for {
 		// error will be returned if max retries have been reached
-		res, err := fp.SubmitFinalitySignature(targetBlock)
+		res, err := fp.SubmitFinalitySignature(ctx, targetBlock)
 		if err != nil {
 
 			fp.logger.Debug(
 				"failed to submit finality signature to the consumer chain",
 				zap.String("pk", fp.GetBtcPkHex()),
-				zap.Uint32("current_failures", failedCycles),
+				zap.Uint("current_failures", failedCycles),
 				zap.Uint64("target_block_height", targetBlock.Height),
 				zap.Error(err),
 			)
 
-			if clientcontroller.IsUnrecoverable(err) {
+			if errors.Is(err, ErrConflictingBlock) {
 				return nil, err
 			}
 
-			if clientcontroller.IsExpected(err) {
+			switch clientcontroller.CategoryOf(err) {
+			case clientcontroller.CategoryUnrecoverable, clientcontroller.CategorySlashed:
+				return nil, err
+			case clientcontroller.CategoryExpected:
 				return nil, nil
+			case clientcontroller.CategoryInsufficientFunds:
+				// the submission is retried in case the account is funded in the meantime
+				fp.logger.Error(
+					"the account cannot afford the fees of the finality signature",
+					zap.String("pk", fp.GetBtcPkHex()),
+					zap.Uint64("target_block_height", targetBlock.Height),
+					zap.Error(err),
+				)
 			}
 
 			failedCycles += 1
-			if failedCycles > uint32(fp.cfg.MaxSubmissionRetries) {
+			if failedCycles >= fp.cfg.SubmissionRetry.Attempts {
+				fp.metrics.IncrementFailedRetries(submissionRetryPolicy)
 				return nil, fmt.Errorf("reached max failed cycles with err: %w", err)
 			}
+			fp.metrics.IncrementRetries(submissionRetryPolicy)
 		} else {
 			// the signature has been successfully submitted
 			return res, nil
 		}
 		select {
-		case <-time.After(fp.cfg.SubmissionRetryInterval):
+		case <-time.After(fp.cfg.SubmissionRetry.Delay(failedCycles)):
 			// periodically query the index block to be later checked whether it is Finalized
-			finalized, err := fp.checkBlockFinalization(targetBlock.Height)
+			finalized, err := fp.checkBlockFinalization(ctx, targetBlock.Height)
 			if err != nil {
 				return nil, fmt.Errorf("failed to query block finalization at height %v: %w", targetBlock.Height, err)
 			}
@@ -462,8 +554,8 @@ func (fp *FinalityProviderInstance) retrySubmitFinalitySignatureUntilBlockFinali
 	}
 }
 
-func (fp *FinalityProviderInstance) checkBlockFinalization(height uint64) (bool, error) {
-	b, err := fp.cc.QueryBlock(height)
+func (fp *FinalityProviderInstance) checkBlockFinalization(ctx context.Context, height uint64) (bool, error) {
+	b, err := fp.cc.QueryBlock(ctx, height)
 	if err != nil {
 		return false, err
 	}
@@ -472,14 +564,25 @@ func (fp *FinalityProviderInstance) checkBlockFinalization(height uint64) (bool,
 }
 
 // SubmitFinalitySignature builds and sends a finality signature over the given block to the consumer chain
-func (fp *FinalityProviderInstance) SubmitFinalitySignature(b *types.BlockInfo) (*types.TxResponse, error) {
-	eotsSig, err := fp.signEotsSig(b)
+func (fp *FinalityProviderInstance) SubmitFinalitySignature(ctx context.Context, b *types.BlockInfo) (*types.TxResponse, error) {
+	eotsSig, err := fp.signVoteEotsSig(b)
 	if err != nil {
 		return nil, err
 	}
 
 	// send finality signature to the consumer chain
-	res, err := fp.cc.SubmitFinalitySig(fp.GetBtcPk(), b.Height, b.Hash, eotsSig.ToModNScalar())
+	var res *types.TxResponse
+	submittedAt := time.Now()
+	if fp.aggregator != nil {
+		res, err = fp.aggregator.Submit(ctx, &types.FinalitySig{
+			FpPk:  fp.GetBtcPk(),
+			Block: b,
+			Sig:   eotsSig.ToModNScalar(),
+		})
+	} else {
+		res, err = fp.cc.SubmitFinalitySig(ctx, fp.GetBtcPk(), b.Height, b.Hash, eotsSig.ToModNScalar())
+	}
+	fp.journalVoteResult([]*types.BlockInfo{b}, submittedAt, res, err)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send finality signature to the consumer chain: %w", err)
 	}
@@ -496,14 +599,14 @@ func (fp *FinalityProviderInstance) SubmitFinalitySignature(b *types.BlockInfo)
 
 // SubmitBatchFinalitySignatures builds and sends a finality signature over the given block to the consumer chain
 // NOTE: the input blocks should be in the ascending order of height
-func (fp *FinalityProviderInstance) SubmitBatchFinalitySignatures(blocks []*types.BlockInfo) (*types.TxResponse, error) {
+func (fp *FinalityProviderInstance) SubmitBatchFinalitySignatures(ctx context.Context, blocks []*types.BlockInfo) (*types.TxResponse, error) {
 	if len(blocks) == 0 {
 		return nil, fmt.Errorf("should not submit batch finality signature with zero block")
 	}
 
 	sigs := make([]*btcec.ModNScalar, 0, len(blocks))
 	for _, b := range blocks {
-		eotsSig, err := fp.signEotsSig(b)
+		eotsSig, err := fp.signVoteEotsSig(b)
 		if err != nil {
 			return nil, err
 		}
@@ -511,7 +614,9 @@ func (fp *FinalityProviderInstance) SubmitBatchFinalitySignatures(blocks []*type
 	}
 
 	// send finality signature to the consumer chain
-	res, err := fp.cc.SubmitBatchFinalitySigs(fp.GetBtcPk(), blocks, sigs)
+	submittedAt := time.Now()
+	res, err := fp.cc.SubmitBatchFinalitySigs(ctx, fp.GetBtcPk(), blocks, sigs)
+	fp.journalVoteResult(blocks, submittedAt, res, err)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send a batch of finality signatures to the consumer chain: %w", err)
 	}
@@ -523,6 +628,119 @@ func (fp *FinalityProviderInstance) SubmitBatchFinalitySignatures(blocks []*type
 	return res, nil
 }
 
+// checkVotedBlock returns ErrConflictingBlock if the finality provider
+// has voted for a different block at the height of the given block
+func (fp *FinalityProviderInstance) checkVotedBlock(b *types.BlockInfo) error {
+	votedHash, err := fp.state.s.GetVotedBlockHash(fp.GetBtcPk(), b.Height)
+	if err != nil {
+		if errors.Is(err, store.ErrVotedBlockNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to get the voted block: %w", err)
+	}
+	if !bytes.Equal(votedHash, b.Hash) {
+		return fmt.Errorf("%w: height %d, voted block %x, block %x",
+			ErrConflictingBlock, b.Height, votedHash, b.Hash)
+	}
+
+	return nil
+}
+
+// signVoteEotsSig signs the given block after checking that no different block
+// has been voted at the same height, and records the block as voted along with
+// the vote in the vote journal
+func (fp *FinalityProviderInstance) signVoteEotsSig(b *types.BlockInfo) (*bbntypes.SchnorrEOTSSig, error) {
+	if err := fp.checkVotedBlock(b); err != nil {
+		return nil, err
+	}
+
+	eotsSig, err := fp.signEotsSig(b)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := fp.state.s.SaveVote(fp.GetBtcPk(), b.Height, b.Hash, eotsSig.MustMarshal()); err != nil {
+		if errors.Is(err, store.ErrConflictingVotedBlock) {
+			return nil, fmt.Errorf("%w: height %d", ErrConflictingBlock, b.Height)
+		}
+		return nil, fmt.Errorf("failed to save the voted block: %w", err)
+	}
+
+	return eotsSig, nil
+}
+
+// journalVoteResult records the inclusion status and the submission time of the votes
+// over the given blocks in the vote journal after their submission. The votes rejected as expected, e.g.,
+// duplicated votes, are regarded as included. The journal is only for auditability,
+// so the failures to update it do not fail the submission
+func (fp *FinalityProviderInstance) journalVoteResult(blocks []*types.BlockInfo, submittedAt time.Time, res *types.TxResponse, err error) {
+	status := proto.VoteStatus_INCLUDED
+	var txHash string
+	switch {
+	case err == nil:
+		if res != nil {
+			txHash = res.TxHash
+		}
+	case clientcontroller.CategoryOf(err) == clientcontroller.CategoryExpected:
+	default:
+		status = proto.VoteStatus_FAILED
+	}
+
+	for _, b := range blocks {
+		if err := fp.state.s.SetVoteStatus(fp.GetBtcPk(), b.Height, status, txHash, submittedAt); err != nil {
+			fp.logger.Warn("failed to update the vote in the vote journal",
+				zap.String("pk", fp.GetBtcPkHex()), zap.Uint64("height", b.Height), zap.Error(err))
+		}
+	}
+}
+
+// resubmitFinalitySignature resubmits the signed vote in the vote journal without
+// signing it again or updating the state of the instance, which has moved past the
+// height of the vote. The inclusion status of the vote is kept, while the hash of
+// the transaction and the submission time are updated once it is submitted
+func (fp *FinalityProviderInstance) resubmitFinalitySignature(ctx context.Context, vote *proto.VoteRecord) (*types.TxResponse, error) {
+	eotsSig, err := bbntypes.NewSchnorrEOTSSig(vote.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature of the vote at height %d: %w", vote.Height, err)
+	}
+	b := &types.BlockInfo{
+		Height: vote.Height,
+		Hash:   vote.BlockHash,
+	}
+
+	sig := &types.FinalitySig{
+		FpPk:  fp.GetBtcPk(),
+		Block: b,
+		Sig:   eotsSig.ToModNScalar(),
+	}
+
+	var res *types.TxResponse
+	submittedAt := time.Now()
+	if fp.aggregator != nil {
+		res, err = fp.aggregator.Submit(ctx, sig)
+	} else if submitter, ok := fp.cc.(clientcontroller.FinalitySigsSubmitter); ok {
+		// the resubmission runs concurrently with the submissions of the instance,
+		// so it is sent with the account sequence tracked by the client controller
+		res, err = submitter.SubmitFinalitySigs(ctx, []*types.FinalitySig{sig})
+	} else {
+		res, err = fp.cc.SubmitFinalitySig(ctx, fp.GetBtcPk(), b.Height, b.Hash, sig.Sig)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to resubmit the finality signature to the consumer chain: %w", err)
+	}
+
+	var txHash string
+	if res != nil {
+		txHash = res.TxHash
+	}
+	if err := fp.state.s.SetVoteStatus(fp.GetBtcPk(), vote.Height, vote.Status, txHash, submittedAt); err != nil {
+		fp.logger.Warn("failed to update the vote in the vote journal",
+			zap.String("pk", fp.GetBtcPkHex()), zap.Uint64("height", vote.Height), zap.Error(err))
+	}
+
+	return res, nil
+}
+
 func (fp *FinalityProviderInstance) signEotsSig(b *types.BlockInfo) (*bbntypes.SchnorrEOTSSig, error) {
 	// build proper finality signature request
 	msg := &ftypes.MsgAddFinalitySig{
@@ -542,14 +760,14 @@ func (fp *FinalityProviderInstance) signEotsSig(b *types.BlockInfo) (*bbntypes.S
 // TestSubmitFinalitySignatureAndExtractPrivKey is exposed for presentation/testing purpose to allow manual sending finality signature
 // this API is the same as SubmitFinalitySignature except that we don't constraint the voting height and update status
 // Note: this should not be used in the submission loop
-func (fp *FinalityProviderInstance) TestSubmitFinalitySignatureAndExtractPrivKey(b *types.BlockInfo) (*types.TxResponse, *btcec.PrivateKey, error) {
+func (fp *FinalityProviderInstance) TestSubmitFinalitySignatureAndExtractPrivKey(ctx context.Context, b *types.BlockInfo) (*types.TxResponse, *btcec.PrivateKey, error) {
 	eotsSig, err := fp.signEotsSig(b)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// send finality signature to the consumer chain
-	res, err := fp.cc.SubmitFinalitySig(fp.GetBtcPk(), b.Height, b.Hash, eotsSig.ToModNScalar())
+	res, err := fp.cc.SubmitFinalitySig(ctx, fp.GetBtcPk(), b.Height, b.Hash, eotsSig.ToModNScalar())
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to send finality signature to the consumer chain: %w", err)
 	}
@@ -575,7 +793,7 @@ func (fp *FinalityProviderInstance) TestSubmitFinalitySignatureAndExtractPrivKey
 	return res, privKey, nil
 }
 
-func (fp *FinalityProviderInstance) getPollerStartingHeight() (uint64, error) {
+func (fp *FinalityProviderInstance) getPollerStartingHeight(ctx context.Context) (uint64, error) {
 	if !fp.cfg.PollerConfig.AutoChainScanningMode {
 		return fp.cfg.PollerConfig.StaticChainScanningStartHeight, nil
 	}
@@ -590,7 +808,7 @@ func (fp *FinalityProviderInstance) getPollerStartingHeight() (uint64, error) {
 	//	(2) The finality providers do not submit signatures for any already
 	//	 finalised blocks.
 	initialBlockToGet := fp.GetLastProcessedHeight()
-	latestFinalisedBlock, err := fp.latestFinalizedBlocksWithRetry(1)
+	latestFinalisedBlock, err := fp.latestFinalizedBlocksWithRetry(ctx, 1)
 	if err != nil {
 		return 0, err
 	}
@@ -607,48 +825,48 @@ func (fp *FinalityProviderInstance) getPollerStartingHeight() (uint64, error) {
 	return initialBlockToGet, nil
 }
 
-func (fp *FinalityProviderInstance) latestFinalizedBlocksWithRetry(count uint64) ([]*types.BlockInfo, error) {
+func (fp *FinalityProviderInstance) latestFinalizedBlocksWithRetry(ctx context.Context, count uint64) ([]*types.BlockInfo, error) {
 	var response []*types.BlockInfo
-	if err := retry.Do(func() error {
-		latestFinalisedBlock, err := fp.cc.QueryLatestFinalizedBlocks(count)
+	if err := doWithRetry(ctx, fp.cfg.QueryRetry, queryRetryPolicy, fp.metrics, func() error {
+		latestFinalisedBlock, err := fp.cc.QueryLatestFinalizedBlocks(ctx, count)
 		if err != nil {
 			return err
 		}
 		response = latestFinalisedBlock
 		return nil
-	}, RtyAtt, RtyDel, RtyErr, retry.OnRetry(func(n uint, err error) {
+	}, func(n uint, err error) {
 		fp.logger.Debug(
 			"failed to query babylon for the latest finalised blocks",
 			zap.Uint("attempt", n+1),
-			zap.Uint("max_attempts", RtyAttNum),
+			zap.Uint("max_attempts", fp.cfg.QueryRetry.Attempts),
 			zap.Error(err),
 		)
-	})); err != nil {
+	}); err != nil {
 		return nil, err
 	}
 	return response, nil
 }
 
-func (fp *FinalityProviderInstance) getLatestBlockWithRetry() (*types.BlockInfo, error) {
+func (fp *FinalityProviderInstance) getLatestBlockWithRetry(ctx context.Context) (*types.BlockInfo, error) {
 	var (
 		latestBlock *types.BlockInfo
 		err         error
 	)
 
-	if err := retry.Do(func() error {
-		latestBlock, err = fp.cc.QueryBestBlock()
+	if err := doWithRetry(ctx, fp.cfg.QueryRetry, queryRetryPolicy, fp.metrics, func() error {
+		latestBlock, err = fp.cc.QueryBestBlock(ctx)
 		if err != nil {
 			return err
 		}
 		return nil
-	}, RtyAtt, RtyDel, RtyErr, retry.OnRetry(func(n uint, err error) {
+	}, func(n uint, err error) {
 		fp.logger.Debug(
 			"failed to query the consumer chain for the latest block",
 			zap.Uint("attempt", n+1),
-			zap.Uint("max_attempts", RtyAttNum),
+			zap.Uint("max_attempts", fp.cfg.QueryRetry.Attempts),
 			zap.Error(err),
 		)
-	})); err != nil {
+	}); err != nil {
 		return nil, err
 	}
 	fp.metrics.RecordBabylonTipHeight(latestBlock.Height)
@@ -656,52 +874,52 @@ func (fp *FinalityProviderInstance) getLatestBlockWithRetry() (*types.BlockInfo,
 	return latestBlock, nil
 }
 
-func (fp *FinalityProviderInstance) GetVotingPowerWithRetry(height uint64) (uint64, error) {
+func (fp *FinalityProviderInstance) GetVotingPowerWithRetry(ctx context.Context, height uint64) (uint64, error) {
 	var (
 		power uint64
 		err   error
 	)
 
-	if err := retry.Do(func() error {
-		power, err = fp.cc.QueryFinalityProviderVotingPower(fp.GetBtcPk(), height)
+	if err := doWithRetry(ctx, fp.cfg.QueryRetry, queryRetryPolicy, fp.metrics, func() error {
+		power, err = fp.cc.QueryFinalityProviderVotingPower(ctx, fp.GetBtcPk(), height)
 		if err != nil {
 			return err
 		}
 		return nil
-	}, RtyAtt, RtyDel, RtyErr, retry.OnRetry(func(n uint, err error) {
+	}, func(n uint, err error) {
 		fp.logger.Debug(
 			"failed to query the voting power",
 			zap.Uint("attempt", n+1),
-			zap.Uint("max_attempts", RtyAttNum),
+			zap.Uint("max_attempts", fp.cfg.QueryRetry.Attempts),
 			zap.Error(err),
 		)
-	})); err != nil {
+	}); err != nil {
 		return 0, err
 	}
 
 	return power, nil
 }
 
-func (fp *FinalityProviderInstance) GetFinalityProviderSlashedWithRetry() (bool, error) {
+func (fp *FinalityProviderInstance) GetFinalityProviderSlashedWithRetry(ctx context.Context) (bool, error) {
 	var (
 		slashed bool
 		err     error
 	)
 
-	if err := retry.Do(func() error {
-		slashed, err = fp.cc.QueryFinalityProviderSlashed(fp.GetBtcPk())
+	if err := doWithRetry(ctx, fp.cfg.QueryRetry, queryRetryPolicy, fp.metrics, func() error {
+		slashed, err = fp.cc.QueryFinalityProviderSlashed(ctx, fp.GetBtcPk())
 		if err != nil {
 			return err
 		}
 		return nil
-	}, RtyAtt, RtyDel, RtyErr, retry.OnRetry(func(n uint, err error) {
+	}, func(n uint, err error) {
 		fp.logger.Debug(
 			"failed to query the finality-provider",
 			zap.Uint("attempt", n+1),
-			zap.Uint("max_attempts", RtyAttNum),
+			zap.Uint("max_attempts", fp.cfg.QueryRetry.Attempts),
 			zap.Error(err),
 		)
-	})); err != nil {
+	}); err != nil {
 		return false, err
 	}
 
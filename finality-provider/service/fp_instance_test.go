@@ -1,6 +1,7 @@
 package service_test
 
 import (
+	"context"
 	"math/rand"
 	"os"
 	"path/filepath"
@@ -32,13 +33,13 @@ func FuzzSubmitFinalitySig(f *testing.F) {
 		startingBlock := &types.BlockInfo{Height: randomStartingHeight, Hash: testutil.GenRandomByteArray(r, 32)}
 		mockClientController := testutil.PrepareMockedClientController(t, r, randomStartingHeight, currentHeight)
 		// mock finalised BTC timestamped
-		mockClientController.EXPECT().QueryLastFinalizedEpoch().Return(randomRegiteredEpoch, nil).AnyTimes()
+		mockClientController.EXPECT().QueryLastFinalizedEpoch(gomock.Any()).Return(randomRegiteredEpoch, nil).AnyTimes()
 
 		_, fpIns, cleanUp := startFinalityProviderAppWithRegisteredFp(t, r, mockClientController, randomStartingHeight, randomRegiteredEpoch)
 		defer cleanUp()
 
 		// mock voting power
-		mockClientController.EXPECT().QueryFinalityProviderVotingPower(fpIns.GetBtcPk(), gomock.Any()).
+		mockClientController.EXPECT().QueryFinalityProviderVotingPower(gomock.Any(), fpIns.GetBtcPk(), gomock.Any()).
 			Return(uint64(1), nil).AnyTimes()
 
 		// submit finality sig
@@ -48,9 +49,9 @@ func FuzzSubmitFinalitySig(f *testing.F) {
 		}
 		expectedTxHash := testutil.GenRandomHexStr(r, 32)
 		mockClientController.EXPECT().
-			SubmitFinalitySig(fpIns.GetBtcPk(), nextBlock.Height, nextBlock.Hash, gomock.Any()).
+			SubmitFinalitySig(gomock.Any(), fpIns.GetBtcPk(), nextBlock.Height, nextBlock.Hash, gomock.Any()).
 			Return(&types.TxResponse{TxHash: expectedTxHash}, nil).AnyTimes()
-		providerRes, err := fpIns.SubmitFinalitySignature(nextBlock)
+		providerRes, err := fpIns.SubmitFinalitySignature(context.Background(), nextBlock)
 		require.NoError(t, err)
 		require.Equal(t, expectedTxHash, providerRes.TxHash)
 
@@ -82,7 +83,7 @@ func startFinalityProviderAppWithRegisteredFp(t *testing.T, r *rand.Rand, cc cli
 	require.NoError(t, err)
 	err = app.Start()
 	require.NoError(t, err)
-	err = app.StartHandlingAll()
+	err = app.StartHandlingAll(context.Background())
 	require.NoError(t, err)
 
 	// create registered finality-provider
@@ -95,7 +96,8 @@ func startFinalityProviderAppWithRegisteredFp(t *testing.T, r *rand.Rand, cc cli
 
 	// TODO: use mock metrics
 	m := metrics.NewFpMetrics()
-	fpIns, err := service.NewFinalityProviderInstance(fp.GetBIP340BTCPK(), &fpCfg, app.GetFinalityProviderStore(), cc, em, m, passphrase, make(chan *service.CriticalError), logger)
+	poller := service.NewChainPoller(logger, fpCfg.PollerConfig, fpCfg.QueryRetry, cc, m)
+	fpIns, err := service.NewFinalityProviderInstance(context.Background(), fp.GetBIP340BTCPK(), &fpCfg, app.GetFinalityProviderStore(), cc, em, poller, nil, m, passphrase, make(chan *service.CriticalError), logger)
 	require.NoError(t, err)
 
 	cleanUp := func() {
@@ -1,19 +1,19 @@
 package service
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"strings"
 	"sync"
 	"time"
 
-	"github.com/avast/retry-go/v4"
 	bbntypes "github.com/babylonchain/babylon/types"
-	btcstakingtypes "github.com/babylonchain/babylon/x/btcstaking/types"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
 	"github.com/babylonchain/finality-provider/clientcontroller"
 	"github.com/babylonchain/finality-provider/eotsmanager"
+	eotstypes "github.com/babylonchain/finality-provider/eotsmanager/types"
 	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
 	"github.com/babylonchain/finality-provider/finality-provider/proto"
 	"github.com/babylonchain/finality-provider/finality-provider/store"
@@ -23,6 +23,10 @@ import (
 
 const instanceTerminatingMsg = "terminating the finality-provider instance due to critical error"
 
+// ErrIncorrectPassphrase is returned when a finality provider is started with
+// a passphrase that cannot unlock its EOTS key
+var ErrIncorrectPassphrase = errors.New("the passphrase cannot unlock the EOTS key of the finality provider")
+
 type CriticalError struct {
 	err     error
 	fpBtcPk *bbntypes.BIP340PubKey
@@ -40,6 +44,9 @@ type FinalityProviderManager struct {
 
 	// running finality-provider instances map keyed by the hex string of the BTC public key
 	fpis map[string]*FinalityProviderInstance
+	// passphrases of the finality providers waiting for their registered epochs
+	// to be BTC timestamped, keyed by the hex string of the BTC public key
+	pendingFps map[string]string
 
 	// needed for initiating finality-provider instances
 	fps    *store.FinalityProviderStore
@@ -48,11 +55,28 @@ type FinalityProviderManager struct {
 	em     eotsmanager.EOTSManager
 	logger *zap.Logger
 
+	// poller is shared by the finality-provider instances of the consumer chain
+	poller *ChainPoller
+	// aggregator submits the finality signatures of the finality-provider
+	// instances together, which is nil if it is disabled
+	aggregator *SubmissionAggregator
+	// liveness tracks the liveness of the running finality-provider
+	// instances, which is nil if it is disabled
+	liveness *LivenessTracker
+	// verifier verifies the included votes of the running finality-provider
+	// instances, which is nil if it is disabled
+	verifier *VoteVerifier
+
 	metrics *metrics.FpMetrics
 
 	criticalErrChan chan *CriticalError
 
 	quit chan struct{}
+	// ctx is the context of the monitoring loops and the restarts of the
+	// finality providers, which outlive the requests starting them
+	ctx context.Context
+	// cancel cancels the in-flight requests of the monitoring loops on stop
+	cancel context.CancelFunc
 }
 
 func NewFinalityProviderManager(
@@ -63,14 +87,43 @@ func NewFinalityProviderManager(
 	metrics *metrics.FpMetrics,
 	logger *zap.Logger,
 ) (*FinalityProviderManager, error) {
+	var aggregator *SubmissionAggregator
+	if config.SubmissionAggregator.Enabled {
+		submitter, ok := cc.(clientcontroller.FinalitySigsSubmitter)
+		if !ok {
+			return nil, fmt.Errorf("the consumer chain %s does not support the submission aggregator", config.ChainName)
+		}
+		aggregator = NewSubmissionAggregator(submitter, config.SubmissionAggregator, metrics, logger)
+	}
+
+	var (
+		liveness *LivenessTracker
+		verifier *VoteVerifier
+	)
+	// the liveness and the votes are not verified on
+	// the consumer chains not recording the votes
+	if vq, ok := cc.(clientcontroller.VoteQuerier); ok {
+		if config.Liveness.Interval > 0 {
+			liveness = NewLivenessTracker(cc, vq, config.Liveness, metrics, logger)
+		}
+		if config.VoteVerifier.Interval > 0 {
+			verifier = NewVoteVerifier(vq, config.VoteVerifier, metrics, logger)
+		}
+	}
+
 	return &FinalityProviderManager{
 		fpis:            make(map[string]*FinalityProviderInstance),
+		pendingFps:      make(map[string]string),
 		criticalErrChan: make(chan *CriticalError),
 		isStarted:       atomic.NewBool(false),
 		fps:             fps,
 		config:          config,
 		cc:              cc,
 		em:              em,
+		poller:          NewChainPoller(logger, config.PollerConfig, config.QueryRetry, cc, metrics),
+		aggregator:      aggregator,
+		liveness:        liveness,
+		verifier:        verifier,
 		metrics:         metrics,
 		logger:          logger,
 		quit:            make(chan struct{}),
@@ -80,8 +133,9 @@ func NewFinalityProviderManager(
 // monitorCriticalErr takes actions when it receives critical errors from a finality-provider instance
 // if the finality-provider is slashed, it will be terminated and the program keeps running in case
 // new finality providers join
-// otherwise, the program will panic
-func (fpm *FinalityProviderManager) monitorCriticalErr() {
+// otherwise, the finality-provider is stopped and set to ERRORED, and it will be restarted with backoff
+// while the other finality providers keep running
+func (fpm *FinalityProviderManager) monitorCriticalErr(ctx context.Context) {
 	defer fpm.wg.Done()
 
 	var criticalErr *CriticalError
@@ -94,16 +148,13 @@ func (fpm *FinalityProviderManager) monitorCriticalErr() {
 					zap.String("pk", criticalErr.fpBtcPk.MarshalHex()))
 				continue
 			}
-			// cannot use error.Is because the unwrapped error
-			// is not the expected error type
-			if strings.Contains(criticalErr.err.Error(), btcstakingtypes.ErrFpAlreadySlashed.Error()) {
+			if clientcontroller.IsSlashed(criticalErr.err) {
 				fpm.setFinalityProviderSlashed(fpi)
 				fpm.logger.Debug("the finality-provider has been slashed",
 					zap.String("pk", criticalErr.fpBtcPk.MarshalHex()))
 				continue
 			}
-			fpm.logger.Fatal(instanceTerminatingMsg,
-				zap.String("pk", criticalErr.fpBtcPk.MarshalHex()), zap.Error(criticalErr.err))
+			fpm.setFinalityProviderErrored(ctx, fpi, criticalErr.err)
 		case <-fpm.quit:
 			return
 		}
@@ -117,7 +168,7 @@ func (fpm *FinalityProviderManager) monitorCriticalErr() {
 // 2. if power == 0 and slashed_height > 0, set status to SLASHED and stop and remove the finality-provider instance
 // 3. if power > 0 (slashed_height must > 0), set status to ACTIVE
 // NOTE: once error occurs, we log and continue as the status update is not critical to the entire program
-func (fpm *FinalityProviderManager) monitorStatusUpdate() {
+func (fpm *FinalityProviderManager) monitorStatusUpdate(ctx context.Context) {
 	defer fpm.wg.Done()
 
 	if fpm.config.StatusUpdateInterval == 0 {
@@ -131,7 +182,7 @@ func (fpm *FinalityProviderManager) monitorStatusUpdate() {
 	for {
 		select {
 		case <-statusUpdateTicker.C:
-			latestBlock, err := fpm.getLatestBlockWithRetry()
+			latestBlock, err := fpm.getLatestBlockWithRetry(ctx)
 			if err != nil {
 				fpm.logger.Debug("failed to get the latest block", zap.Error(err))
 				continue
@@ -139,7 +190,7 @@ func (fpm *FinalityProviderManager) monitorStatusUpdate() {
 			fpis := fpm.ListFinalityProviderInstances()
 			for _, fpi := range fpis {
 				oldStatus := fpi.GetStatus()
-				power, err := fpi.GetVotingPowerWithRetry(latestBlock.Height)
+				power, err := fpi.GetVotingPowerWithRetry(ctx, latestBlock.Height)
 				if err != nil {
 					fpm.logger.Debug(
 						"failed to get the voting power",
@@ -162,7 +213,7 @@ func (fpm *FinalityProviderManager) monitorStatusUpdate() {
 					}
 					continue
 				}
-				slashed, err := fpi.GetFinalityProviderSlashedWithRetry()
+				slashed, err := fpi.GetFinalityProviderSlashedWithRetry(ctx)
 				if err != nil {
 					fpm.logger.Debug(
 						"failed to get the slashed height",
@@ -204,38 +255,152 @@ func (fpm *FinalityProviderManager) setFinalityProviderSlashed(fpi *FinalityProv
 	}
 }
 
-func (fpm *FinalityProviderManager) StartFinalityProvider(fpPk *bbntypes.BIP340PubKey, passphrase string) error {
-	if !fpm.isStarted.Load() {
-		fpm.isStarted.Store(true)
+// setFinalityProviderErrored stops the finality-provider instance due to the critical error
+// and schedules its restart if enabled
+func (fpm *FinalityProviderManager) setFinalityProviderErrored(ctx context.Context, fpi *FinalityProviderInstance, criticalErr error) {
+	fpPk := fpi.GetBtcPkBIP340()
+	prevStatus := fpi.GetStatus()
+
+	fpm.logger.Error(instanceTerminatingMsg, zap.String("pk", fpPk.MarshalHex()), zap.Error(criticalErr))
+
+	if err := fpi.SetErrored(criticalErr.Error()); err != nil {
+		fpm.logger.Error("failed to set the finality-provider status to ERRORED",
+			zap.String("pk", fpPk.MarshalHex()), zap.Error(err))
+	}
+	fpm.metrics.RecordFpStatus(fpPk.MarshalHex(), proto.FinalityProviderStatus_ERRORED)
+
+	if err := fpm.removeFinalityProviderInstance(fpPk); err != nil {
+		fpm.logger.Error("failed to terminate the errored finality-provider",
+			zap.String("pk", fpPk.MarshalHex()), zap.Error(err))
+		return
+	}
+
+	if fpm.config.MaxRestartAttempts == 0 {
+		fpm.logger.Info("the restart of errored finality-provider is disabled",
+			zap.String("pk", fpPk.MarshalHex()))
+		return
+	}
+
+	fpm.wg.Add(1)
+	go fpm.restartFinalityProvider(ctx, fpPk, prevStatus, fpi.passphrase)
+}
+
+// restartFinalityProvider tries to restart the errored finality-provider instance
+// with exponential backoff until it succeeds or the maximum attempts are reached
+// the status before the critical error is restored once the instance is restarted
+func (fpm *FinalityProviderManager) restartFinalityProvider(
+	ctx context.Context,
+	fpPk *bbntypes.BIP340PubKey,
+	prevStatus proto.FinalityProviderStatus,
+	passphrase string,
+) {
+	defer fpm.wg.Done()
+
+	backoff := fpm.config.RestartBackoff
+	for attempt := uint32(1); attempt <= fpm.config.MaxRestartAttempts; attempt++ {
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return
+		}
+
+		if fpm.IsFinalityProviderRunning(fpPk) {
+			fpm.logger.Info("the errored finality-provider is already restarted",
+				zap.String("pk", fpPk.MarshalHex()))
+			return
+		}
+		// the finality provider has been locked or is waiting to be
+		// started with the passphrase of the user in the meantime
+		storedFp, err := fpm.fps.GetFinalityProvider(fpPk.MustToBTCPK())
+		if err != nil || storedFp.Status == proto.FinalityProviderStatus_LOCKED || fpm.IsFinalityProviderPending(fpPk) {
+			fpm.logger.Info("stop restarting the locked or pending finality-provider",
+				zap.String("pk", fpPk.MarshalHex()), zap.Error(err))
+			return
+		}
 
-		fpm.wg.Add(1)
-		go fpm.monitorCriticalErr()
+		err = fpm.StartFinalityProvider(ctx, fpPk, passphrase)
+		if errors.Is(err, ErrIncorrectPassphrase) {
+			if err := fpm.setFinalityProviderLocked(fpPk); err != nil {
+				fpm.logger.Error("failed to set the finality-provider status to LOCKED",
+					zap.String("pk", fpPk.MarshalHex()), zap.Error(err))
+			}
+			return
+		}
+		if err != nil {
+			fpm.logger.Warn(
+				"failed to restart the errored finality-provider",
+				zap.String("pk", fpPk.MarshalHex()),
+				zap.Uint32("attempt", attempt),
+				zap.Uint32("max_attempts", fpm.config.MaxRestartAttempts),
+				zap.Error(err),
+			)
+			backoff = min(2*backoff, fpm.config.MaxRestartBackoff)
+			continue
+		}
 
-		fpm.wg.Add(1)
-		go fpm.monitorStatusUpdate()
+		if prevStatus != proto.FinalityProviderStatus_ERRORED {
+			fpi, err := fpm.GetFinalityProviderInstance(fpPk)
+			if err == nil {
+				err = fpi.SetStatus(prevStatus)
+			}
+			if err != nil {
+				fpm.logger.Error("failed to restore the finality-provider status",
+					zap.String("pk", fpPk.MarshalHex()), zap.Error(err))
+			}
+		}
+
+		fpm.logger.Info("the errored finality-provider is restarted",
+			zap.String("pk", fpPk.MarshalHex()), zap.Uint32("attempt", attempt))
+		return
 	}
 
+	fpm.logger.Error("stop restarting the errored finality-provider after maximum attempts",
+		zap.String("pk", fpPk.MarshalHex()))
+}
+
+// StartFinalityProvider starts the finality-provider instance with the given public key
+// ctx only applies to the start, while the instance runs until the manager is stopped
+func (fpm *FinalityProviderManager) StartFinalityProvider(ctx context.Context, fpPk *bbntypes.BIP340PubKey, passphrase string) error {
+	fpm.startMonitoring()
+
 	if fpm.numOfRunningFinalityProviders() >= int(fpm.config.MaxNumFinalityProviders) {
 		return fmt.Errorf("reaching maximum number of running finality providers %v", fpm.config.MaxNumFinalityProviders)
 	}
 
-	if err := fpm.addFinalityProviderInstance(fpPk, passphrase); err != nil {
+	storedFp, err := fpm.fps.GetFinalityProvider(fpPk.MustToBTCPK())
+	if err != nil {
+		return err
+	}
+	if err := fpm.verifyPassphrase(storedFp, passphrase); err != nil {
 		return err
 	}
 
-	return nil
-}
+	if err := fpm.addFinalityProviderInstance(ctx, fpPk, passphrase); err != nil {
+		return err
+	}
 
-func (fpm *FinalityProviderManager) StartAll() error {
-	if !fpm.isStarted.Load() {
-		fpm.isStarted.Store(true)
+	// the finality-provider recovers from the critical error or the lock once it is started
+	// and the status update will move it to ACTIVE or INACTIVE
+	fpi, err := fpm.GetFinalityProviderInstance(fpPk)
+	if err != nil {
+		return err
+	}
+	if status := fpi.GetStatus(); status == proto.FinalityProviderStatus_ERRORED || status == proto.FinalityProviderStatus_LOCKED {
+		if err := fpi.SetStatus(proto.FinalityProviderStatus_REGISTERED); err != nil {
+			return fmt.Errorf("failed to recover the errored finality-provider %s: %w", fpPk.MarshalHex(), err)
+		}
+	}
 
-		fpm.wg.Add(1)
-		go fpm.monitorCriticalErr()
+	return nil
+}
 
-		fpm.wg.Add(1)
-		go fpm.monitorStatusUpdate()
-	}
+// StartAll starts all the stored finality providers that are not running yet
+// the finality providers whose EOTS keys are encrypted are set to LOCKED, and
+// they are not started until they are unlocked with their own passphrases
+// the finality providers failing to start for other errors are set to ERRORED
+// and restarted with backoff, while the others are still started
+func (fpm *FinalityProviderManager) StartAll(ctx context.Context) error {
+	fpm.startMonitoring()
 
 	storedFps, err := fpm.fps.GetAllStoredFinalityProviders()
 	if err != nil {
@@ -243,25 +408,314 @@ func (fpm *FinalityProviderManager) StartAll() error {
 	}
 
 	for _, fp := range storedFps {
+		fpPk := fp.GetBIP340BTCPK()
 		if fp.Status == proto.FinalityProviderStatus_CREATED || fp.Status == proto.FinalityProviderStatus_SLASHED {
 			fpm.logger.Info("the finality provider cannot be started with status",
-				zap.String("btc-pk", fp.GetBIP340BTCPK().MarshalHex()),
+				zap.String("btc-pk", fpPk.MarshalHex()),
 				zap.String("status", fp.Status.String()))
 			continue
 		}
-		if err := fpm.StartFinalityProvider(fp.GetBIP340BTCPK(), ""); err != nil {
-			return err
+		if fpm.IsFinalityProviderRunning(fpPk) {
+			continue
 		}
+		err := fpm.StartFinalityProviderWhenFinalized(ctx, fpPk, "")
+		if errors.Is(err, ErrIncorrectPassphrase) {
+			if err := fpm.setFinalityProviderLocked(fpPk); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			fpm.setStartFailed(fp, err)
+		}
+	}
+
+	return nil
+}
+
+// setFinalityProviderLocked sets the finality provider to LOCKED
+// until it is unlocked with the passphrase of its EOTS key
+func (fpm *FinalityProviderManager) setFinalityProviderLocked(fpPk *bbntypes.BIP340PubKey) error {
+	if err := fpm.fps.SetFpStatus(fpPk.MustToBTCPK(), proto.FinalityProviderStatus_LOCKED); err != nil {
+		return err
+	}
+	fpm.metrics.RecordFpStatus(fpPk.MarshalHex(), proto.FinalityProviderStatus_LOCKED)
+	fpm.logger.Info("the finality provider is locked until it is unlocked with its passphrase",
+		zap.String("btc-pk", fpPk.MarshalHex()))
+
+	return nil
+}
+
+// setStartFailed sets the stored finality provider which failed to start
+// to ERRORED and schedules its restart if enabled
+func (fpm *FinalityProviderManager) setStartFailed(fp *store.StoredFinalityProvider, startErr error) {
+	fpPk := fp.GetBIP340BTCPK()
+
+	fpm.logger.Error("failed to start the finality provider",
+		zap.String("btc-pk", fpPk.MarshalHex()), zap.Error(startErr))
+
+	if err := fpm.fps.SetFpErrored(fp.BtcPk, startErr.Error()); err != nil {
+		fpm.logger.Error("failed to set the finality-provider status to ERRORED",
+			zap.String("pk", fpPk.MarshalHex()), zap.Error(err))
+	}
+	fpm.metrics.RecordFpStatus(fpPk.MarshalHex(), proto.FinalityProviderStatus_ERRORED)
+
+	if fpm.config.MaxRestartAttempts == 0 {
+		fpm.logger.Info("the restart of errored finality-provider is disabled",
+			zap.String("pk", fpPk.MarshalHex()))
+		return
 	}
 
+	fpm.wg.Add(1)
+	go fpm.restartFinalityProvider(fpm.ctx, fpPk, fp.Status, "")
+}
+
+// StartFinalityProviderWhenFinalized starts the finality-provider instance with the given public key
+// if the epoch in which it is registered is not BTC timestamped yet, the finality provider waits
+// until it is and then it is started in the background
+func (fpm *FinalityProviderManager) StartFinalityProviderWhenFinalized(ctx context.Context, fpPk *bbntypes.BIP340PubKey, passphrase string) error {
+	err := fpm.StartFinalityProvider(ctx, fpPk, passphrase)
+	if !errors.Is(err, ErrEpochNotFinalized) || fpm.config.AutoStartInterval == 0 {
+		return err
+	}
+
+	fpm.mu.Lock()
+	fpm.pendingFps[fpPk.MarshalHex()] = passphrase
+	fpm.mu.Unlock()
+
+	fpm.logger.Info("the finality provider will be started once its registered epoch is BTC timestamped",
+		zap.String("pk", fpPk.MarshalHex()), zap.Error(err))
+
 	return nil
 }
 
+// IsFinalityProviderPending returns whether the finality provider is waiting
+// for its registered epoch to be BTC timestamped to be started
+func (fpm *FinalityProviderManager) IsFinalityProviderPending(fpPk *bbntypes.BIP340PubKey) bool {
+	fpm.mu.Lock()
+	defer fpm.mu.Unlock()
+
+	_, pending := fpm.pendingFps[fpPk.MarshalHex()]
+	return pending
+}
+
+// autoStartLoop periodically starts the pending finality providers
+// whose registered epochs are BTC timestamped
+func (fpm *FinalityProviderManager) autoStartLoop(ctx context.Context) {
+	defer fpm.wg.Done()
+
+	if fpm.config.AutoStartInterval == 0 {
+		fpm.logger.Info("the auto start of pending finality providers is disabled")
+		return
+	}
+
+	autoStartTicker := time.NewTicker(fpm.config.AutoStartInterval)
+	defer autoStartTicker.Stop()
+
+	for {
+		select {
+		case <-autoStartTicker.C:
+			fpm.startPendingFinalityProviders(ctx)
+		case <-fpm.quit:
+			return
+		}
+	}
+}
+
+func (fpm *FinalityProviderManager) startPendingFinalityProviders(ctx context.Context) {
+	fpm.mu.Lock()
+	pendingFps := make(map[string]string, len(fpm.pendingFps))
+	for pkHex, passphrase := range fpm.pendingFps {
+		pendingFps[pkHex] = passphrase
+	}
+	fpm.mu.Unlock()
+
+	if len(pendingFps) == 0 {
+		return
+	}
+
+	lastFinalizedEpoch, err := fpm.cc.QueryLastFinalizedEpoch(ctx)
+	if err != nil {
+		fpm.logger.Debug("failed to query the last finalized epoch", zap.Error(err))
+		return
+	}
+
+	for pkHex, passphrase := range pendingFps {
+		fpPk, err := bbntypes.NewBIP340PubKeyFromHex(pkHex)
+		if err != nil {
+			panic(fmt.Errorf("invalid pending finality-provider %s: %w", pkHex, err))
+		}
+
+		storedFp, err := fpm.fps.GetFinalityProvider(fpPk.MustToBTCPK())
+		if err != nil {
+			fpm.logger.Debug("failed to get the pending finality provider",
+				zap.String("pk", pkHex), zap.Error(err))
+			continue
+		}
+		if storedFp.RegisteredEpoch > lastFinalizedEpoch {
+			continue
+		}
+
+		err = fpm.StartFinalityProvider(ctx, fpPk, passphrase)
+		if errors.Is(err, ErrEpochNotFinalized) {
+			continue
+		}
+
+		// the pending finality provider is either started or dropped
+		// as it cannot be started without the intervention of the user
+		fpm.mu.Lock()
+		delete(fpm.pendingFps, pkHex)
+		fpm.mu.Unlock()
+
+		if err != nil {
+			fpm.logger.Error("failed to start the pending finality provider",
+				zap.String("pk", pkHex), zap.Error(err))
+			continue
+		}
+
+		fpm.logger.Info("the pending finality provider is started",
+			zap.String("pk", pkHex), zap.Uint64("registered_epoch", storedFp.RegisteredEpoch))
+	}
+}
+
+// UnlockFinalityProvider starts the LOCKED finality provider with the passphrase of its EOTS key
+// if the epoch in which it is registered is not BTC timestamped yet, the finality provider
+// is started in the background once it is
+func (fpm *FinalityProviderManager) UnlockFinalityProvider(ctx context.Context, fpPk *bbntypes.BIP340PubKey, passphrase string) error {
+	storedFp, err := fpm.fps.GetFinalityProvider(fpPk.MustToBTCPK())
+	if err != nil {
+		return err
+	}
+
+	if storedFp.Status != proto.FinalityProviderStatus_LOCKED {
+		return fmt.Errorf("the finality provider %s is not locked (status: %s)", fpPk.MarshalHex(), storedFp.Status.String())
+	}
+
+	return fpm.StartFinalityProviderWhenFinalized(ctx, fpPk, passphrase)
+}
+
+// StopFinalityProvider stops the running finality-provider instance
+// it is started again by ResumeFinalityProvider or the restart of the daemon
+func (fpm *FinalityProviderManager) StopFinalityProvider(fpPk *bbntypes.BIP340PubKey) error {
+	// the pending finality provider is no longer started once its registered epoch is BTC timestamped
+	fpm.mu.Lock()
+	_, pending := fpm.pendingFps[fpPk.MarshalHex()]
+	delete(fpm.pendingFps, fpPk.MarshalHex())
+	fpm.mu.Unlock()
+	if pending {
+		return nil
+	}
+
+	if !fpm.IsFinalityProviderRunning(fpPk) {
+		return fmt.Errorf("the finality-provider %s is not running", fpPk.MarshalHex())
+	}
+
+	return fpm.removeFinalityProviderInstance(fpPk)
+}
+
+// PauseFinalityProvider stops the finality provider from signing blocks
+// while it keeps processing them. The paused state persists across restarts
+func (fpm *FinalityProviderManager) PauseFinalityProvider(fpPk *bbntypes.BIP340PubKey) error {
+	return fpm.setFinalityProviderPaused(fpPk, true)
+}
+
+// ResumeFinalityProvider resumes signing blocks of the paused finality provider
+// and starts the finality-provider instance if it is not running
+func (fpm *FinalityProviderManager) ResumeFinalityProvider(ctx context.Context, fpPk *bbntypes.BIP340PubKey, passphrase string) error {
+	if err := fpm.setFinalityProviderPaused(fpPk, false); err != nil {
+		return err
+	}
+
+	if fpm.IsFinalityProviderRunning(fpPk) {
+		return nil
+	}
+
+	return fpm.StartFinalityProvider(ctx, fpPk, passphrase)
+}
+
+func (fpm *FinalityProviderManager) setFinalityProviderPaused(fpPk *bbntypes.BIP340PubKey, paused bool) error {
+	fpm.mu.Lock()
+	defer fpm.mu.Unlock()
+
+	// the running instance keeps the state in memory
+	if fpi, exists := fpm.fpis[fpPk.MarshalHex()]; exists {
+		return fpi.SetPaused(paused)
+	}
+
+	return fpm.fps.SetFpPaused(fpPk.MustToBTCPK(), paused)
+}
+
+// verifyPassphrase checks whether the passphrase unlocks the EOTS key of the finality provider
+// by deriving the master public randomness, which should match the registered one
+func (fpm *FinalityProviderManager) verifyPassphrase(fp *store.StoredFinalityProvider, passphrase string) error {
+	mpr, err := fpm.em.CreateMasterRandPair(fp.GetBIP340BTCPK().MustMarshal(), types.MarshalChainID(fp.ChainID),
+		eotstypes.MasterPubRandVersion(fp.MasterPubRand), passphrase)
+	if errors.Is(err, eotstypes.ErrIncorrectPassphrase) {
+		return fmt.Errorf("%w: %v", ErrIncorrectPassphrase, err)
+	}
+	// the other errors, e.g., the EOTS manager being unreachable, do not
+	// tell whether the passphrase is correct
+	if err != nil {
+		return fmt.Errorf("failed to derive the master public randomness: %w", err)
+	}
+
+	if mpr != fp.MasterPubRand {
+		return fmt.Errorf("%w: the master public randomness does not match the registered one", ErrIncorrectPassphrase)
+	}
+
+	return nil
+}
+
+// startMonitoring starts the monitoring loops if they are not started yet
+func (fpm *FinalityProviderManager) startMonitoring() {
+	if fpm.isStarted.Load() {
+		return
+	}
+	fpm.isStarted.Store(true)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	fpm.ctx, fpm.cancel = ctx, cancel
+
+	if err := fpm.poller.Start(); err != nil {
+		// the poller is only started along with the monitoring loops
+		panic(fmt.Errorf("failed to start the chain poller: %w", err))
+	}
+
+	if fpm.aggregator != nil {
+		if err := fpm.aggregator.Start(); err != nil {
+			panic(fmt.Errorf("failed to start the submission aggregator: %w", err))
+		}
+	}
+
+	fpm.wg.Add(1)
+	go fpm.monitorCriticalErr(ctx)
+
+	fpm.wg.Add(1)
+	go fpm.monitorStatusUpdate(ctx)
+
+	fpm.wg.Add(1)
+	go fpm.autoStartLoop(ctx)
+
+	fpm.wg.Add(1)
+	go fpm.monitorBalance(ctx)
+
+	fpm.wg.Add(1)
+	go fpm.pruneVoteJournalLoop(ctx)
+
+	fpm.wg.Add(1)
+	go fpm.trackLivenessLoop(ctx)
+
+	fpm.wg.Add(1)
+	go fpm.verifyVotesLoop(ctx)
+}
+
 func (fpm *FinalityProviderManager) Stop() error {
 	if !fpm.isStarted.Swap(false) {
 		return fmt.Errorf("the finality-provider manager has already stopped")
 	}
 
+	fpm.cancel()
+
 	var stopErr error
 
 	for _, fpi := range fpm.fpis {
@@ -275,6 +729,16 @@ func (fpm *FinalityProviderManager) Stop() error {
 		fpm.metrics.DecrementRunningFpGauge()
 	}
 
+	if fpm.aggregator != nil {
+		if err := fpm.aggregator.Stop(); err != nil && stopErr == nil {
+			stopErr = err
+		}
+	}
+
+	if err := fpm.poller.Stop(); err != nil && stopErr == nil {
+		stopErr = err
+	}
+
 	close(fpm.quit)
 	fpm.wg.Wait()
 
@@ -365,6 +829,12 @@ func (fpm *FinalityProviderManager) removeFinalityProviderInstance(fpPk *bbntype
 	}
 
 	delete(fpm.fpis, keyHex)
+	if fpm.liveness != nil {
+		fpm.liveness.Untrack(fpPk.MustToBTCPK())
+	}
+	if fpm.verifier != nil {
+		fpm.verifier.Untrack(fpPk.MustToBTCPK())
+	}
 	fpm.metrics.DecrementRunningFpGauge()
 	return nil
 }
@@ -378,6 +848,7 @@ func (fpm *FinalityProviderManager) numOfRunningFinalityProviders() int {
 
 // addFinalityProviderInstance creates a finality-provider instance, starts it and adds it into the finality-provider manager
 func (fpm *FinalityProviderManager) addFinalityProviderInstance(
+	ctx context.Context,
 	pk *bbntypes.BIP340PubKey,
 	passphrase string,
 ) error {
@@ -389,7 +860,7 @@ func (fpm *FinalityProviderManager) addFinalityProviderInstance(
 		return fmt.Errorf("finality-provider instance already exists")
 	}
 
-	fpIns, err := NewFinalityProviderInstance(pk, fpm.config, fpm.fps, fpm.cc, fpm.em, fpm.metrics, passphrase, fpm.criticalErrChan, fpm.logger)
+	fpIns, err := NewFinalityProviderInstance(ctx, pk, fpm.config, fpm.fps, fpm.cc, fpm.em, fpm.poller, fpm.aggregator, fpm.metrics, passphrase, fpm.criticalErrChan, fpm.logger)
 	if err != nil {
 		return fmt.Errorf("failed to create finality-provider %s instance: %w", pkHex, err)
 	}
@@ -404,26 +875,26 @@ func (fpm *FinalityProviderManager) addFinalityProviderInstance(
 	return nil
 }
 
-func (fpm *FinalityProviderManager) getLatestBlockWithRetry() (*types.BlockInfo, error) {
+func (fpm *FinalityProviderManager) getLatestBlockWithRetry(ctx context.Context) (*types.BlockInfo, error) {
 	var (
 		latestBlock *types.BlockInfo
 		err         error
 	)
 
-	if err := retry.Do(func() error {
-		latestBlock, err = fpm.cc.QueryBestBlock()
+	if err := doWithRetry(ctx, fpm.config.QueryRetry, queryRetryPolicy, fpm.metrics, func() error {
+		latestBlock, err = fpm.cc.QueryBestBlock(ctx)
 		if err != nil {
 			return err
 		}
 		return nil
-	}, RtyAtt, RtyDel, RtyErr, retry.OnRetry(func(n uint, err error) {
+	}, func(n uint, err error) {
 		fpm.logger.Debug(
 			"failed to query the consumer chain for the latest block",
 			zap.Uint("attempt", n+1),
-			zap.Uint("max_attempts", RtyAttNum),
+			zap.Uint("max_attempts", fpm.config.QueryRetry.Attempts),
 			zap.Error(err),
 		)
-	})); err != nil {
+	}); err != nil {
 		return nil, err
 	}
 
@@ -1,18 +1,23 @@
 package service_test
 
 import (
+	"context"
 	"math/rand"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	bbntypes "github.com/babylonchain/babylon/types"
+	finalitytypes "github.com/babylonchain/babylon/x/finality/types"
 	"github.com/babylonchain/finality-provider/clientcontroller"
 	"github.com/babylonchain/finality-provider/eotsmanager"
 	eotscfg "github.com/babylonchain/finality-provider/eotsmanager/config"
+	eotstypes "github.com/babylonchain/finality-provider/eotsmanager/types"
 	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
 	"github.com/babylonchain/finality-provider/finality-provider/proto"
 	"github.com/babylonchain/finality-provider/finality-provider/service"
@@ -24,10 +29,16 @@ import (
 	"github.com/babylonchain/finality-provider/testutil/mocks"
 	"github.com/babylonchain/finality-provider/types"
 	"github.com/babylonchain/finality-provider/util"
+	sdkkeyring "github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -40,34 +51,21 @@ func FuzzStatusUpdate(f *testing.F) {
 	f.Fuzz(func(t *testing.T, seed int64) {
 		r := rand.New(rand.NewSource(seed))
 
-		ctl := gomock.NewController(t)
-		mockClientController := mocks.NewMockClientController(ctl)
-		vm, fpPk, cleanUp := newFinalityProviderManagerWithRegisteredFp(t, r, mockClientController)
-		defer cleanUp()
+		env := newFpManagerTestEnv(t, r)
+		defer env.cleanUp()
+		vm, fpPk, mockClientController := env.vm, env.fpPk, env.cc
+		currentHeight := env.currentBlock.Height
 
 		// setup mocks
-		currentHeight := uint64(r.Int63n(100) + 1)
-		currentBlockRes := &types.BlockInfo{
-			Height: currentHeight,
-			Hash:   datagen.GenRandomByteArray(r, 32),
-		}
-		mockClientController.EXPECT().QueryBestBlock().Return(currentBlockRes, nil).AnyTimes()
-		mockClientController.EXPECT().Close().Return(nil).AnyTimes()
-		mockClientController.EXPECT().QueryLatestFinalizedBlocks(gomock.Any()).Return(nil, nil).AnyTimes()
-		mockClientController.EXPECT().QueryBestBlock().Return(currentBlockRes, nil).AnyTimes()
-		mockClientController.EXPECT().QueryActivatedHeight().Return(uint64(1), nil).AnyTimes()
-		mockClientController.EXPECT().QueryBlock(gomock.Any()).Return(currentBlockRes, nil).AnyTimes()
-		mockClientController.EXPECT().QueryLastFinalizedEpoch().Return(uint64(0), nil).AnyTimes()
-
 		votingPower := uint64(r.Intn(2))
-		mockClientController.EXPECT().QueryFinalityProviderVotingPower(gomock.Any(), currentHeight).Return(votingPower, nil).AnyTimes()
-		mockClientController.EXPECT().SubmitFinalitySig(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&types.TxResponse{TxHash: ""}, nil).AnyTimes()
+		mockClientController.EXPECT().QueryFinalityProviderVotingPower(gomock.Any(), gomock.Any(), currentHeight).Return(votingPower, nil).AnyTimes()
+		mockClientController.EXPECT().SubmitFinalitySig(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&types.TxResponse{TxHash: ""}, nil).AnyTimes()
 		var slashedHeight uint64
 		if votingPower == 0 {
-			mockClientController.EXPECT().QueryFinalityProviderSlashed(gomock.Any()).Return(true, nil).AnyTimes()
+			mockClientController.EXPECT().QueryFinalityProviderSlashed(gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
 		}
 
-		err := vm.StartFinalityProvider(fpPk, passphrase)
+		err := vm.StartFinalityProvider(context.Background(), fpPk, passphrase)
 		require.NoError(t, err)
 		fpIns := vm.ListFinalityProviderInstances()[0]
 		// stop the finality-provider as we are testing static functionalities
@@ -86,6 +84,282 @@ func FuzzStatusUpdate(f *testing.F) {
 	})
 }
 
+func FuzzCriticalErrRestart(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		env := newFpManagerTestEnv(t, r)
+		defer env.cleanUp()
+		vm, fpPk, mockClientController := env.vm, env.fpPk, env.cc
+
+		// setup mocks
+		mockClientController.EXPECT().QueryFinalityProviderVotingPower(gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(1), nil).AnyTimes()
+		// the first submission fails with a critical error while the later ones succeed
+		mockClientController.EXPECT().SubmitFinalitySig(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, clientcontroller.NewError(clientcontroller.CategoryUnrecoverable, finalitytypes.ErrInvalidFinalitySig)).Times(1)
+		mockClientController.EXPECT().SubmitFinalitySig(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&types.TxResponse{TxHash: ""}, nil).AnyTimes()
+
+		err := vm.StartFinalityProvider(context.Background(), fpPk, passphrase)
+		require.NoError(t, err)
+		erroredIns, err := vm.GetFinalityProviderInstance(fpPk)
+		require.NoError(t, err)
+
+		// the errored instance is stopped and replaced by a restarted one
+		var restartedIns *service.FinalityProviderInstance
+		require.Eventually(t, func() bool {
+			restartedIns, err = vm.GetFinalityProviderInstance(fpPk)
+			return err == nil && restartedIns != erroredIns
+		}, eventuallyWaitTimeOut, eventuallyPollTime)
+		require.False(t, erroredIns.IsRunning())
+		require.True(t, restartedIns.IsRunning())
+
+		// the critical error is cleared once the status is updated
+		require.Eventually(t, func() bool {
+			fpInfo, err := vm.FinalityProviderInfo(fpPk)
+			return err == nil && fpInfo.Status == proto.FinalityProviderStatus_ACTIVE.String() && fpInfo.ErrorReason == ""
+		}, eventuallyWaitTimeOut, eventuallyPollTime)
+	})
+}
+
+func FuzzUnlockFinalityProvider(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// the EOTS key is encrypted with the passphrase in the file keyring
+		// and the registered epoch is not finalized yet
+		registeredEpoch := uint64(r.Int63n(100) + 1)
+		var lastFinalizedEpoch atomic.Uint64
+		lastFinalizedEpoch.Store(registeredEpoch - 1)
+		em := &unreachableEOTSManager{}
+		env := newFpManagerTestEnv(t, r,
+			withEOTSKeyringBackend(sdkkeyring.BackendFile),
+			withRegisteredEpoch(registeredEpoch),
+			withLastFinalizedEpoch(&lastFinalizedEpoch),
+			withEOTSManager(func(lm eotsmanager.EOTSManager) eotsmanager.EOTSManager {
+				em.EOTSManager = lm
+				return em
+			}),
+		)
+		defer env.cleanUp()
+		vm, fpPk, mockClientController := env.vm, env.fpPk, env.cc
+
+		// setup mocks
+		mockClientController.EXPECT().QueryFinalityProviderVotingPower(gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(0), nil).AnyTimes()
+		mockClientController.EXPECT().QueryFinalityProviderSlashed(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+
+		// the finality provider is not locked but errored while the EOTS manager is unreachable,
+		// which does not fail the start of the other finality providers
+		em.unreachable.Store(true)
+		err := vm.StartAll(context.Background())
+		require.NoError(t, err)
+		fpInfo, err := vm.FinalityProviderInfo(fpPk)
+		require.NoError(t, err)
+		require.Equal(t, proto.FinalityProviderStatus_ERRORED.String(), fpInfo.Status)
+		require.Contains(t, fpInfo.ErrorReason, "connection refused")
+		em.unreachable.Store(false)
+
+		// the finality provider cannot be started without the passphrase
+		err = vm.StartAll(context.Background())
+		require.NoError(t, err)
+		require.False(t, vm.IsFinalityProviderRunning(fpPk))
+		fpInfo, err = vm.FinalityProviderInfo(fpPk)
+		require.NoError(t, err)
+		require.Equal(t, proto.FinalityProviderStatus_LOCKED.String(), fpInfo.Status)
+
+		// a wrong passphrase keeps it locked
+		err = vm.UnlockFinalityProvider(context.Background(), fpPk, passphrase+"wrong")
+		require.ErrorIs(t, err, service.ErrIncorrectPassphrase)
+		require.False(t, vm.IsFinalityProviderRunning(fpPk))
+		require.False(t, vm.IsFinalityProviderPending(fpPk))
+
+		// the unlocked finality provider waits for its registered epoch to be finalized
+		err = vm.UnlockFinalityProvider(context.Background(), fpPk, passphrase)
+		require.NoError(t, err)
+		require.True(t, vm.IsFinalityProviderPending(fpPk))
+		lastFinalizedEpoch.Store(registeredEpoch)
+		require.Eventually(t, func() bool {
+			fpInfo, err := vm.FinalityProviderInfo(fpPk)
+			return err == nil && vm.IsFinalityProviderRunning(fpPk) &&
+				fpInfo.Status == proto.FinalityProviderStatus_REGISTERED.String()
+		}, eventuallyWaitTimeOut, eventuallyPollTime)
+
+		// it can be unlocked only once
+		err = vm.UnlockFinalityProvider(context.Background(), fpPk, passphrase)
+		require.Error(t, err)
+	})
+}
+
+func FuzzPauseFinalityProvider(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		env := newFpManagerTestEnv(t, r)
+		defer env.cleanUp()
+		vm, fpPk, mockClientController := env.vm, env.fpPk, env.cc
+		currentHeight := env.currentBlock.Height
+
+		// setup mocks without the expectation of submitting finality signatures
+		mockClientController.EXPECT().QueryFinalityProviderVotingPower(gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(1), nil).AnyTimes()
+
+		// the paused state is stored before the finality provider starts
+		err := vm.PauseFinalityProvider(fpPk)
+		require.NoError(t, err)
+		err = vm.StartFinalityProvider(context.Background(), fpPk, passphrase)
+		require.NoError(t, err)
+		fpIns, err := vm.GetFinalityProviderInstance(fpPk)
+		require.NoError(t, err)
+		require.True(t, fpIns.IsPaused())
+
+		// the block is processed without being signed
+		require.Eventually(t, func() bool {
+			return fpIns.GetLastProcessedHeight() == currentHeight
+		}, eventuallyWaitTimeOut, eventuallyPollTime)
+		require.Zero(t, fpIns.GetLastVotedHeight())
+
+		err = vm.StopFinalityProvider(fpPk)
+		require.NoError(t, err)
+		require.False(t, vm.IsFinalityProviderRunning(fpPk))
+		fpInfo, err := vm.FinalityProviderInfo(fpPk)
+		require.NoError(t, err)
+		require.True(t, fpInfo.IsPaused)
+
+		// resuming starts the stopped finality provider
+		err = vm.ResumeFinalityProvider(context.Background(), fpPk, passphrase)
+		require.NoError(t, err)
+		require.True(t, vm.IsFinalityProviderRunning(fpPk))
+		fpInfo, err = vm.FinalityProviderInfo(fpPk)
+		require.NoError(t, err)
+		require.False(t, fpInfo.IsPaused)
+	})
+}
+
+func FuzzAutoStartFinalityProvider(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// the registered epoch is not finalized yet
+		registeredEpoch := uint64(r.Int63n(100) + 1)
+		var lastFinalizedEpoch atomic.Uint64
+		lastFinalizedEpoch.Store(registeredEpoch - 1)
+		env := newFpManagerTestEnv(t, r, withRegisteredEpoch(registeredEpoch), withLastFinalizedEpoch(&lastFinalizedEpoch))
+		defer env.cleanUp()
+		vm, fpPk, mockClientController := env.vm, env.fpPk, env.cc
+
+		// setup mocks
+		mockClientController.EXPECT().QueryFinalityProviderVotingPower(gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(1), nil).AnyTimes()
+		mockClientController.EXPECT().SubmitFinalitySig(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&types.TxResponse{TxHash: ""}, nil).AnyTimes()
+
+		// the finality provider cannot be started before its registered epoch is finalized
+		err := vm.StartFinalityProvider(context.Background(), fpPk, passphrase)
+		require.ErrorIs(t, err, service.ErrEpochNotFinalized)
+
+		// the finality provider waits for its registered epoch instead
+		err = vm.StartFinalityProviderWhenFinalized(context.Background(), fpPk, passphrase)
+		require.NoError(t, err)
+		require.True(t, vm.IsFinalityProviderPending(fpPk))
+		require.False(t, vm.IsFinalityProviderRunning(fpPk))
+
+		// the finality provider is started once its registered epoch is finalized
+		lastFinalizedEpoch.Store(registeredEpoch)
+		require.Eventually(t, func() bool {
+			return vm.IsFinalityProviderRunning(fpPk)
+		}, eventuallyWaitTimeOut, eventuallyPollTime)
+		require.False(t, vm.IsFinalityProviderPending(fpPk))
+	})
+}
+
+// FuzzBalanceMonitor tests the balance of the account paying
+// for the transactions is checked periodically
+func FuzzBalanceMonitor(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		criticalBalance := sdk.NewInt64Coin("ubbn", r.Int63n(1000000)+1)
+		lowBalance := criticalBalance.AddAmount(sdkmath.NewInt(r.Int63n(1000000) + 1))
+		gasPrice := sdkmath.LegacyNewDecWithPrec(r.Int63n(1000)+1, 4)
+		core, logs := observer.New(zap.WarnLevel)
+		env := newFpManagerTestEnv(t, r,
+			withConfig(func(cfg *fpcfg.Config) {
+				cfg.BalanceMonitor.Interval = 10 * time.Millisecond
+				cfg.BalanceMonitor.LowBalance = lowBalance.String()
+				cfg.BalanceMonitor.CriticalBalance = criticalBalance.String()
+			}),
+			withLogger(zap.New(core)),
+			withClientController(func(cc clientcontroller.ClientController) clientcontroller.ClientController {
+				return &gasPriceUpdatingClientController{
+					ClientController: cc,
+					gasPrices:        sdk.NewDecCoinFromDec("ubbn", gasPrice).String(),
+				}
+			}),
+		)
+		defer env.cleanUp()
+		vm, fpPk, mockClientController := env.vm, env.fpPk, env.cc
+
+		// setup mocks with a critically low balance
+		mockClientController.EXPECT().QueryFinalityProviderVotingPower(gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(0), nil).AnyTimes()
+		mockClientController.EXPECT().QueryFinalityProviderSlashed(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+		var balance atomic.Pointer[sdk.Coin]
+		balance.Store(&sdk.Coin{Denom: "ubbn", Amount: criticalBalance.Amount.SubRaw(1)})
+		mockClientController.EXPECT().QueryBalance(gomock.Any()).DoAndReturn(func(_ context.Context) (*sdk.Coin, error) {
+			return balance.Load(), nil
+		}).MinTimes(1)
+
+		err := vm.StartFinalityProvider(context.Background(), fpPk, passphrase)
+		require.NoError(t, err)
+
+		// the balance and the updated gas price are recorded
+		requireGaugeValue(t, "signer_balance", float64(criticalBalance.Amount.Int64()-1))
+		requireGaugeValue(t, "gas_price", gasPrice.MustFloat64())
+		require.Eventually(t, func() bool {
+			return logs.FilterLevelExact(zap.ErrorLevel).FilterMessageSnippet("critically low").Len() > 0
+		}, eventuallyWaitTimeOut, eventuallyPollTime)
+
+		// the low balance is only warned
+		balance.Store(&sdk.Coin{Denom: "ubbn", Amount: lowBalance.Amount.SubRaw(1)})
+		requireGaugeValue(t, "signer_balance", float64(lowBalance.Amount.Int64()-1))
+		require.Eventually(t, func() bool {
+			return logs.FilterLevelExact(zap.WarnLevel).FilterMessageSnippet("is low").Len() > 0
+		}, eventuallyWaitTimeOut, eventuallyPollTime)
+
+		// the balance is not warned once the account is funded
+		balance.Store(&lowBalance)
+		requireGaugeValue(t, "signer_balance", float64(lowBalance.Amount.Int64()))
+		numLogs := logs.FilterMessageSnippet("balance").Len()
+		time.Sleep(50 * time.Millisecond)
+		require.Equal(t, numLogs, logs.FilterMessageSnippet("balance").Len())
+	})
+}
+
+// gasPriceUpdatingClientController updates the gas prices to the given ones
+type gasPriceUpdatingClientController struct {
+	clientcontroller.ClientController
+	gasPrices string
+}
+
+func (cc *gasPriceUpdatingClientController) UpdateGasPrices(_ context.Context) (string, error) {
+	return cc.gasPrices, nil
+}
+
+// requireGaugeValue waits for the gauge of the given name to be recorded with the value
+func requireGaugeValue(t *testing.T, name string, value float64) {
+	require.Eventually(t, func() bool {
+		families, err := prometheus.DefaultGatherer.Gather()
+		require.NoError(t, err)
+		for _, family := range families {
+			if family.GetName() == name && len(family.GetMetric()) == 1 {
+				return family.GetMetric()[0].GetGauge().GetValue() == value
+			}
+		}
+		return false
+	}, eventuallyWaitTimeOut, eventuallyPollTime)
+}
+
 func waitForStatus(t *testing.T, fpIns *service.FinalityProviderInstance, s proto.FinalityProviderStatus) {
 	require.Eventually(t,
 		func() bool {
@@ -93,11 +367,147 @@ func waitForStatus(t *testing.T, fpIns *service.FinalityProviderInstance, s prot
 		}, eventuallyWaitTimeOut, eventuallyPollTime)
 }
 
-func newFinalityProviderManagerWithRegisteredFp(t *testing.T, r *rand.Rand, cc clientcontroller.ClientController) (*service.FinalityProviderManager, *bbntypes.BIP340PubKey, func()) {
+// fpManagerTestEnv is a finality provider manager with a registered finality provider,
+// and the mocked client controller it runs with
+type fpManagerTestEnv struct {
+	vm *service.FinalityProviderManager
+	// cc expects the queries every running finality provider sends, the
+	// expectations of what a test checks are set up by the test
+	cc           *mocks.MockClientController
+	fpPk         *bbntypes.BIP340PubKey
+	currentBlock *types.BlockInfo
+	cleanUp      func()
+}
+
+type fpManagerTestOptions struct {
+	eotsKeyringBackend string
+	registeredEpoch    uint64
+	// lastFinalizedEpoch is the last finalized epoch returned by the client
+	// controller, which is the registered epoch if not set
+	lastFinalizedEpoch *atomic.Uint64
+	updateConfig       func(cfg *fpcfg.Config)
+	wrapEOTSManager    func(em eotsmanager.EOTSManager) eotsmanager.EOTSManager
+	wrapCC             func(cc clientcontroller.ClientController) clientcontroller.ClientController
+	logger             *zap.Logger
+}
+
+// unreachableEOTSManager fails to derive the master public randomness
+// as if the EOTS manager were unreachable once unreachable is set
+type unreachableEOTSManager struct {
+	eotsmanager.EOTSManager
+	unreachable atomic.Bool
+}
+
+func (em *unreachableEOTSManager) CreateMasterRandPair(uid, chainID []byte, version eotstypes.MasterRandVersion, passphrase string) (string, error) {
+	if em.unreachable.Load() {
+		return "", status.Error(codes.Unavailable, "connection refused")
+	}
+
+	return em.EOTSManager.CreateMasterRandPair(uid, chainID, version, passphrase)
+}
+
+type fpManagerTestOption func(opts *fpManagerTestOptions)
+
+// withEOTSKeyringBackend sets the keyring backend of the EOTS manager
+func withEOTSKeyringBackend(backend string) fpManagerTestOption {
+	return func(opts *fpManagerTestOptions) {
+		opts.eotsKeyringBackend = backend
+	}
+}
+
+// withRegisteredEpoch sets the epoch in which the finality provider is registered
+func withRegisteredEpoch(epoch uint64) fpManagerTestOption {
+	return func(opts *fpManagerTestOptions) {
+		opts.registeredEpoch = epoch
+	}
+}
+
+// withLastFinalizedEpoch sets the last finalized epoch, which the test can update
+func withLastFinalizedEpoch(epoch *atomic.Uint64) fpManagerTestOption {
+	return func(opts *fpManagerTestOptions) {
+		opts.lastFinalizedEpoch = epoch
+	}
+}
+
+// withEOTSManager wraps the EOTS manager of the finality provider manager
+func withEOTSManager(wrap func(em eotsmanager.EOTSManager) eotsmanager.EOTSManager) fpManagerTestOption {
+	return func(opts *fpManagerTestOptions) {
+		opts.wrapEOTSManager = wrap
+	}
+}
+
+// withConfig updates the config of the finality provider manager
+func withConfig(update func(cfg *fpcfg.Config)) fpManagerTestOption {
+	return func(opts *fpManagerTestOptions) {
+		opts.updateConfig = update
+	}
+}
+
+// withClientController wraps the client controller of the finality provider manager
+func withClientController(wrap func(cc clientcontroller.ClientController) clientcontroller.ClientController) fpManagerTestOption {
+	return func(opts *fpManagerTestOptions) {
+		opts.wrapCC = wrap
+	}
+}
+
+func withLogger(logger *zap.Logger) fpManagerTestOption {
+	return func(opts *fpManagerTestOptions) {
+		opts.logger = logger
+	}
+}
+
+func newFpManagerTestEnv(t *testing.T, r *rand.Rand, opts ...fpManagerTestOption) *fpManagerTestEnv {
+	options := &fpManagerTestOptions{
+		eotsKeyringBackend: sdkkeyring.BackendTest,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.lastFinalizedEpoch == nil {
+		options.lastFinalizedEpoch = &atomic.Uint64{}
+		options.lastFinalizedEpoch.Store(options.registeredEpoch)
+	}
+
+	currentHeight := uint64(r.Int63n(100) + 1)
+	currentBlock := &types.BlockInfo{
+		Height: currentHeight,
+		Hash:   datagen.GenRandomByteArray(r, 32),
+	}
+	ctl := gomock.NewController(t)
+	mockClientController := mocks.NewMockClientController(ctl)
+	mockClientController.EXPECT().Close().Return(nil).AnyTimes()
+	mockClientController.EXPECT().QueryBestBlock(gomock.Any()).Return(currentBlock, nil).AnyTimes()
+	mockClientController.EXPECT().QueryBlock(gomock.Any(), gomock.Any()).Return(currentBlock, nil).AnyTimes()
+	mockClientController.EXPECT().QueryLatestFinalizedBlocks(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockClientController.EXPECT().QueryActivatedHeight(gomock.Any()).Return(uint64(1), nil).AnyTimes()
+	mockClientController.EXPECT().QueryLastFinalizedEpoch(gomock.Any()).DoAndReturn(func(_ context.Context) (uint64, error) {
+		return options.lastFinalizedEpoch.Load(), nil
+	}).AnyTimes()
+
+	var cc clientcontroller.ClientController = mockClientController
+	if options.wrapCC != nil {
+		cc = options.wrapCC(mockClientController)
+	}
+	vm, fpPk, cleanUp := newFinalityProviderManagerWithRegisteredFp(t, r, cc, options)
+
+	return &fpManagerTestEnv{
+		vm:           vm,
+		cc:           mockClientController,
+		fpPk:         fpPk,
+		currentBlock: currentBlock,
+		cleanUp:      cleanUp,
+	}
+}
+
+func newFinalityProviderManagerWithRegisteredFp(t *testing.T, r *rand.Rand, cc clientcontroller.ClientController, options *fpManagerTestOptions) (*service.FinalityProviderManager, *bbntypes.BIP340PubKey, func()) {
 	logger := zap.NewNop()
+	if options.logger != nil {
+		logger = options.logger
+	}
 	// create an EOTS manager
 	eotsHomeDir := filepath.Join(t.TempDir(), "eots-home")
 	eotsCfg := eotscfg.DefaultConfigWithHomePath(eotsHomeDir)
+	eotsCfg.KeyringBackend = options.eotsKeyringBackend
 	eotsdb, err := eotsCfg.DatabaseConfig.GetDbBackend()
 	require.NoError(t, err)
 	em, err := eotsmanager.NewLocalEOTSManager(eotsHomeDir, eotsCfg.KeyringBackend, eotsdb, logger)
@@ -107,6 +517,13 @@ func newFinalityProviderManagerWithRegisteredFp(t *testing.T, r *rand.Rand, cc c
 	fpHomeDir := filepath.Join(t.TempDir(), "fp-home")
 	fpCfg := fpcfg.DefaultConfigWithHome(fpHomeDir)
 	fpCfg.StatusUpdateInterval = 10 * time.Millisecond
+	fpCfg.RestartBackoff = 10 * time.Millisecond
+	fpCfg.AutoStartInterval = 10 * time.Millisecond
+	// the balance monitor is only enabled by the tests checking it
+	fpCfg.BalanceMonitor.Interval = 0
+	if options.updateConfig != nil {
+		options.updateConfig(&fpCfg)
+	}
 	input := strings.NewReader("")
 	kr, err := keyring.CreateKeyring(
 		fpCfg.BabylonConfig.KeyDirectory,
@@ -122,10 +539,6 @@ func newFinalityProviderManagerWithRegisteredFp(t *testing.T, r *rand.Rand, cc c
 	fpStore, err := fpstore.NewFinalityProviderStore(fpdb)
 	require.NoError(t, err)
 
-	metricsCollectors := metrics.NewFpMetrics()
-	vm, err := service.NewFinalityProviderManager(fpStore, &fpCfg, cc, em, metricsCollectors, logger)
-	require.NoError(t, err)
-
 	// create registered finality-provider
 	keyName := datagen.GenRandomHexStr(r, 10)
 	chainID := datagen.GenRandomHexStr(r, 10)
@@ -162,7 +575,22 @@ func newFinalityProviderManagerWithRegisteredFp(t *testing.T, r *rand.Rand, cc c
 	err = fpStore.SetFpStatus(btcPk.MustToBTCPK(), proto.FinalityProviderStatus_REGISTERED)
 	require.NoError(t, err)
 
-	err = fpStore.SetFpRegisteredEpoch(btcPk.MustToBTCPK(), 0)
+	err = fpStore.SetFpRegisteredEpoch(btcPk.MustToBTCPK(), options.registeredEpoch)
+	require.NoError(t, err)
+
+	// reopen the EOTS manager as the keyring keeps the passphrase once the key is unlocked
+	err = em.Close()
+	require.NoError(t, err)
+	em, err = eotsmanager.NewLocalEOTSManager(eotsHomeDir, eotsCfg.KeyringBackend, eotsdb, logger)
+	require.NoError(t, err)
+
+	var fpEm eotsmanager.EOTSManager = em
+	if options.wrapEOTSManager != nil {
+		fpEm = options.wrapEOTSManager(em)
+	}
+
+	metricsCollectors := metrics.NewFpMetrics()
+	vm, err := service.NewFinalityProviderManager(fpStore, &fpCfg, cc, fpEm, metricsCollectors, logger)
 	require.NoError(t, err)
 
 	cleanUp := func() {
@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	bbntypes "github.com/babylonchain/babylon/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"go.uber.org/zap"
+
+	"github.com/babylonchain/finality-provider/clientcontroller"
+	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
+	"github.com/babylonchain/finality-provider/finality-provider/proto"
+	"github.com/babylonchain/finality-provider/metrics"
+)
+
+// ErrLivenessNotTracked is returned when the liveness of a finality provider is
+// queried before it is tracked, e.g., the finality provider is not running
+var ErrLivenessNotTracked = errors.New("the liveness of the finality provider is not tracked")
+
+// LivenessTracker compares the heights where the finality providers have voting
+// power with the heights where their votes are recorded by the consumer chain,
+// over a sliding window of the latest processed heights
+type LivenessTracker struct {
+	cc      clientcontroller.ClientController
+	vq      clientcontroller.VoteQuerier
+	cfg     *fpcfg.LivenessConfig
+	metrics *metrics.FpMetrics
+	logger  *zap.Logger
+
+	mu sync.Mutex
+	// windows of the tracked finality providers keyed by the hex string of the BTC public key
+	windows map[string]*livenessWindow
+}
+
+// livenessWindow is the liveness of a finality provider over the window of heights
+type livenessWindow struct {
+	startHeight uint64
+	// checkedHeight is the highest height which has been checked
+	checkedHeight uint64
+	// powerHeights are the heights in the window where the finality
+	// provider has voting power, mapped to whether its vote is recorded
+	powerHeights map[uint64]bool
+}
+
+func NewLivenessTracker(
+	cc clientcontroller.ClientController,
+	vq clientcontroller.VoteQuerier,
+	cfg *fpcfg.LivenessConfig,
+	metrics *metrics.FpMetrics,
+	logger *zap.Logger,
+) *LivenessTracker {
+	return &LivenessTracker{
+		cc:      cc,
+		vq:      vq,
+		cfg:     cfg,
+		metrics: metrics,
+		logger:  logger,
+		windows: make(map[string]*livenessWindow),
+	}
+}
+
+// Track moves the window of the finality provider to end at the given height
+// and checks the heights which have not been checked yet. The heights checked
+// before a failed query are kept, so the rest are checked by the next call.
+// The missed heights above the given finalized height are checked again, as
+// the votes might still be in flight, so that they are only counted as missed
+// once their blocks are finalized without the votes
+func (lt *LivenessTracker) Track(ctx context.Context, fpPk *btcec.PublicKey, endHeight, finalizedHeight uint64) error {
+	pkHex := bbntypes.NewBIP340PubKeyFromBTCPK(fpPk).MarshalHex()
+
+	activatedHeight, err := lt.cc.QueryActivatedHeight(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query the activated height: %w", err)
+	}
+	startHeight := activatedHeight
+	if endHeight >= lt.cfg.WindowSize {
+		startHeight = max(startHeight, endHeight-lt.cfg.WindowSize+1)
+	}
+
+	lt.mu.Lock()
+	w, ok := lt.windows[pkHex]
+	if !ok {
+		w = &livenessWindow{powerHeights: make(map[uint64]bool)}
+		lt.windows[pkHex] = w
+	}
+	w.startHeight = startHeight
+	var pendingHeights []uint64
+	for height, voted := range w.powerHeights {
+		switch {
+		case height < startHeight:
+			delete(w.powerHeights, height)
+		case !voted && height > finalizedHeight:
+			pendingHeights = append(pendingHeights, height)
+		}
+	}
+	fromHeight := max(w.checkedHeight+1, startHeight)
+	lt.mu.Unlock()
+
+	// the queries are made without holding the lock so that
+	// the liveness can be queried in the meantime
+	trackErr := lt.recheckHeights(ctx, fpPk, w, pendingHeights)
+	for height := fromHeight; trackErr == nil && height <= endHeight; height++ {
+		hasPower, voted, err := lt.checkHeight(ctx, fpPk, height)
+		if err != nil {
+			trackErr = err
+			break
+		}
+
+		lt.mu.Lock()
+		if hasPower {
+			w.powerHeights[height] = voted
+		}
+		w.checkedHeight = height
+		lt.mu.Unlock()
+	}
+
+	if liveness, err := lt.Liveness(fpPk); err == nil {
+		lt.metrics.RecordFpLiveness(pkHex, liveness.Uptime, len(liveness.MissedHeights))
+	}
+
+	return trackErr
+}
+
+// recheckHeights checks again whether the votes of the finality provider
+// are recorded at the given missed heights
+func (lt *LivenessTracker) recheckHeights(ctx context.Context, fpPk *btcec.PublicKey, w *livenessWindow, heights []uint64) error {
+	for _, height := range heights {
+		voted, err := lt.checkVoted(ctx, fpPk, height)
+		if err != nil {
+			return err
+		}
+		if !voted {
+			continue
+		}
+
+		lt.mu.Lock()
+		if _, ok := w.powerHeights[height]; ok {
+			w.powerHeights[height] = true
+		}
+		lt.mu.Unlock()
+	}
+
+	return nil
+}
+
+// checkHeight returns whether the finality provider has voting power at the
+// given height, and if so, whether its vote at the height is recorded
+func (lt *LivenessTracker) checkHeight(ctx context.Context, fpPk *btcec.PublicKey, height uint64) (bool, bool, error) {
+	power, err := lt.cc.QueryFinalityProviderVotingPower(ctx, fpPk, height)
+	if err != nil {
+		return false, false, err
+	}
+	if power == 0 {
+		return false, false, nil
+	}
+
+	voted, err := lt.checkVoted(ctx, fpPk, height)
+	if err != nil {
+		return false, false, err
+	}
+
+	return true, voted, nil
+}
+
+// checkVoted returns whether the vote of the finality provider at the given height is recorded
+func (lt *LivenessTracker) checkVoted(ctx context.Context, fpPk *btcec.PublicKey, height uint64) (bool, error) {
+	votes, err := lt.vq.QueryVotesAtHeight(ctx, height)
+	if err != nil {
+		return false, err
+	}
+	fpBtcPk := bbntypes.NewBIP340PubKeyFromBTCPK(fpPk)
+	for _, pk := range votes {
+		if pk.Equals(fpBtcPk) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Liveness returns the liveness of the finality provider over the checked heights of its window
+func (lt *LivenessTracker) Liveness(fpPk *btcec.PublicKey) (*proto.LivenessInfo, error) {
+	pkHex := bbntypes.NewBIP340PubKeyFromBTCPK(fpPk).MarshalHex()
+
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+
+	w, ok := lt.windows[pkHex]
+	if !ok || w.checkedHeight < w.startHeight {
+		return nil, ErrLivenessNotTracked
+	}
+
+	liveness := &proto.LivenessInfo{
+		BtcPkHex:      pkHex,
+		StartHeight:   w.startHeight,
+		EndHeight:     w.checkedHeight,
+		MissedHeights: []uint64{},
+	}
+	for height, voted := range w.powerHeights {
+		liveness.PowerHeights++
+		if voted {
+			liveness.VotedHeights++
+		} else {
+			liveness.MissedHeights = append(liveness.MissedHeights, height)
+		}
+	}
+	sort.Slice(liveness.MissedHeights, func(i, j int) bool {
+		return liveness.MissedHeights[i] < liveness.MissedHeights[j]
+	})
+	liveness.Uptime = 1
+	if liveness.PowerHeights > 0 {
+		liveness.Uptime = float64(liveness.VotedHeights) / float64(liveness.PowerHeights)
+	}
+
+	return liveness, nil
+}
+
+// Untrack stops tracking the liveness of the finality provider
+// and removes its liveness metrics
+func (lt *LivenessTracker) Untrack(fpPk *btcec.PublicKey) {
+	pkHex := bbntypes.NewBIP340PubKeyFromBTCPK(fpPk).MarshalHex()
+
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+
+	delete(lt.windows, pkHex)
+	lt.metrics.RemoveFpLiveness(pkHex)
+}
+
+// trackLivenessLoop periodically tracks the liveness of the running finality
+// providers up to their last processed heights
+func (fpm *FinalityProviderManager) trackLivenessLoop(ctx context.Context) {
+	defer fpm.wg.Done()
+
+	if fpm.liveness == nil {
+		fpm.logger.Info("the liveness tracker is disabled")
+		return
+	}
+
+	livenessTicker := time.NewTicker(fpm.config.Liveness.Interval)
+	defer livenessTicker.Stop()
+
+	for {
+		select {
+		case <-livenessTicker.C:
+			fpm.trackLiveness(ctx)
+		case <-fpm.quit:
+			return
+		}
+	}
+}
+
+func (fpm *FinalityProviderManager) trackLiveness(ctx context.Context) {
+	finalizedBlocks, err := fpm.cc.QueryLatestFinalizedBlocks(ctx, 1)
+	if err != nil {
+		fpm.logger.Debug("failed to query the latest finalized block", zap.Error(err))
+		return
+	}
+	var finalizedHeight uint64
+	if len(finalizedBlocks) > 0 {
+		finalizedHeight = finalizedBlocks[0].Height
+	}
+
+	for _, fpi := range fpm.ListFinalityProviderInstances() {
+		if !fpi.IsRunning() {
+			continue
+		}
+		if err := fpm.liveness.Track(ctx, fpi.GetBtcPk(), fpi.GetLastProcessedHeight(), finalizedHeight); err != nil {
+			fpm.logger.Debug("failed to track the liveness",
+				zap.String("pk", fpi.GetBtcPkHex()), zap.Error(err))
+		}
+	}
+}
+
+// Liveness returns the liveness of the finality provider
+// over the latest processed heights
+func (fpm *FinalityProviderManager) Liveness(fpPk *bbntypes.BIP340PubKey) (*proto.LivenessInfo, error) {
+	if fpm.liveness == nil {
+		return nil, fmt.Errorf("the liveness tracker is disabled")
+	}
+
+	return fpm.liveness.Liveness(fpPk.MustToBTCPK())
+}
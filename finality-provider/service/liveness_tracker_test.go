@@ -0,0 +1,176 @@
+package service_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	bbntypes "github.com/babylonchain/babylon/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
+	"github.com/babylonchain/finality-provider/finality-provider/service"
+	"github.com/babylonchain/finality-provider/metrics"
+	"github.com/babylonchain/finality-provider/testutil"
+	"github.com/babylonchain/finality-provider/testutil/mocks"
+)
+
+// FuzzLivenessTracker tests that the liveness tracker reports the heights
+// where the finality provider has voting power but no recorded vote within
+// the sliding window, only checks the voting power at each height once, and
+// checks the missed heights again until their blocks are finalized
+func FuzzLivenessTracker(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		sk, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+		fpPk := sk.PubKey()
+		otherSk, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+		otherPk := *bbntypes.NewBIP340PubKeyFromBTCPK(otherSk.PubKey())
+		pkHex := bbntypes.NewBIP340PubKeyFromBTCPK(fpPk).MarshalHex()
+
+		activatedHeight := uint64(r.Int63n(100) + 1)
+		windowSize := uint64(r.Int63n(50) + 1)
+		firstEnd := activatedHeight + uint64(r.Int63n(100))
+		secondEnd := firstEnd + uint64(r.Int63n(100))
+
+		// the finality provider randomly has voting power and votes at each height
+		hasPower := make(map[uint64]bool)
+		voted := make(map[uint64]bool)
+		for h := activatedHeight; h <= secondEnd; h++ {
+			hasPower[h] = r.Intn(4) > 0
+			voted[h] = hasPower[h] && r.Intn(3) > 0
+		}
+		// some votes missed at the first check are recorded afterwards, which
+		// only count if their blocks are not finalized at the second check
+		finalizedHeight := activatedHeight - 1 + uint64(r.Int63n(int64(firstEnd-activatedHeight+2)))
+		lateVoted := make(map[uint64]bool)
+		for h := activatedHeight; h <= firstEnd; h++ {
+			lateVoted[h] = hasPower[h] && !voted[h] && r.Intn(2) == 0
+		}
+		// recorded are the votes recorded by the consumer chain
+		recorded := make(map[uint64]bool)
+		for h, v := range voted {
+			recorded[h] = v
+		}
+
+		ctl := gomock.NewController(t)
+		mockClientController := mocks.NewMockClientController(ctl)
+		mockVoteQuerier := mocks.NewMockVoteQuerier(ctl)
+		mockClientController.EXPECT().QueryActivatedHeight(gomock.Any()).Return(activatedHeight, nil).AnyTimes()
+		checked := make(map[uint64]bool)
+		mockClientController.EXPECT().QueryFinalityProviderVotingPower(gomock.Any(), fpPk, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ *btcec.PublicKey, height uint64) (uint64, error) {
+				// the voting power at each height is only checked once
+				require.False(t, checked[height])
+				checked[height] = true
+				if hasPower[height] {
+					return 1, nil
+				}
+				return 0, nil
+			}).AnyTimes()
+		mockVoteQuerier.EXPECT().QueryVotesAtHeight(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, height uint64) ([]bbntypes.BIP340PubKey, error) {
+				votes := []bbntypes.BIP340PubKey{otherPk}
+				if recorded[height] {
+					votes = append(votes, *bbntypes.NewBIP340PubKeyFromBTCPK(fpPk))
+				}
+				return votes, nil
+			}).AnyTimes()
+
+		cfg := fpcfg.DefaultLivenessConfig()
+		cfg.WindowSize = windowSize
+		tracker := service.NewLivenessTracker(mockClientController, mockVoteQuerier, &cfg, metrics.NewFpMetrics(), zap.NewNop())
+
+		_, err = tracker.Liveness(fpPk)
+		require.ErrorIs(t, err, service.ErrLivenessNotTracked)
+
+		for i, endHeight := range []uint64{firstEnd, secondEnd} {
+			if i == 1 {
+				for h, late := range lateVoted {
+					if late {
+						recorded[h] = true
+						voted[h] = h > finalizedHeight
+					}
+				}
+			}
+			err = tracker.Track(context.Background(), fpPk, endHeight, finalizedHeight)
+			require.NoError(t, err)
+
+			startHeight := activatedHeight
+			if endHeight >= windowSize && endHeight-windowSize+1 > startHeight {
+				startHeight = endHeight - windowSize + 1
+			}
+			var (
+				powerHeights, votedHeights uint64
+				missedHeights              = []uint64{}
+			)
+			for h := startHeight; h <= endHeight; h++ {
+				if !hasPower[h] {
+					continue
+				}
+				powerHeights++
+				if voted[h] {
+					votedHeights++
+				} else {
+					missedHeights = append(missedHeights, h)
+				}
+			}
+
+			liveness, err := tracker.Liveness(fpPk)
+			require.NoError(t, err)
+			require.Equal(t, startHeight, liveness.StartHeight)
+			require.Equal(t, endHeight, liveness.EndHeight)
+			require.Equal(t, powerHeights, liveness.PowerHeights)
+			require.Equal(t, votedHeights, liveness.VotedHeights)
+			require.Equal(t, missedHeights, liveness.MissedHeights)
+			if powerHeights == 0 {
+				require.Equal(t, float64(1), liveness.Uptime)
+			} else {
+				require.InDelta(t, float64(votedHeights)/float64(powerHeights), liveness.Uptime, 1e-9)
+			}
+			_, ok := fpMetricValue(t, "fp_missed_heights", pkHex)
+			require.True(t, ok)
+		}
+
+		// the liveness metrics of the untracked finality provider are removed
+		tracker.Untrack(fpPk)
+		_, err = tracker.Liveness(fpPk)
+		require.ErrorIs(t, err, service.ErrLivenessNotTracked)
+		_, ok := fpMetricValue(t, "fp_uptime_ratio", pkHex)
+		require.False(t, ok)
+		_, ok = fpMetricValue(t, "fp_missed_heights", pkHex)
+		require.False(t, ok)
+	})
+}
+
+// fpMetricValue returns the value of the gauge or the counter of the
+// finality provider and whether it is recorded
+func fpMetricValue(t *testing.T, name string, fpBtcPkHex string) (float64, bool) {
+	families, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			for _, label := range m.GetLabel() {
+				if label.GetName() == "fp_btc_pk_hex" && label.GetValue() == fpBtcPkHex {
+					if m.GetCounter() != nil {
+						return m.GetCounter().GetValue(), true
+					}
+					return m.GetGauge().GetValue(), true
+				}
+			}
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+
+	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
+	"github.com/babylonchain/finality-provider/metrics"
+)
+
+const (
+	// the labels of the retry metrics
+	queryRetryPolicy            = "query"
+	submissionRetryPolicy       = "submission"
+	randomnessCommitRetryPolicy = "randomness_commit"
+)
+
+// doWithRetry calls the function until it succeeds or the attempts of the
+// retry policy are used up, onRetry is called after each failed attempt
+func doWithRetry(
+	ctx context.Context,
+	policy *fpcfg.RetryPolicy,
+	policyName string,
+	m *metrics.FpMetrics,
+	fn func() error,
+	onRetry func(n uint, err error),
+) error {
+	err := retry.Do(fn,
+		retry.Context(ctx),
+		retry.Attempts(policy.Attempts),
+		retry.DelayType(func(n uint, _ error, _ *retry.Config) time.Duration {
+			return policy.Delay(n + 1)
+		}),
+		retry.LastErrorOnly(true),
+		retry.OnRetry(func(n uint, err error) {
+			if n+1 < policy.Attempts {
+				m.IncrementRetries(policyName)
+			}
+			onRetry(n, err)
+		}),
+	)
+	if err != nil && ctx.Err() == nil {
+		m.IncrementFailedRetries(policyName)
+	}
+
+	return err
+}
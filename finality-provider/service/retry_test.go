@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+	"github.com/stretchr/testify/require"
+
+	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
+	"github.com/babylonchain/finality-provider/metrics"
+)
+
+func TestDoWithRetry(t *testing.T) {
+	policy := &fpcfg.RetryPolicy{
+		Attempts:     3,
+		InitialDelay: time.Millisecond,
+		MaxDelay:     time.Millisecond,
+	}
+	m := metrics.NewFpMetrics()
+	someErr := fmt.Errorf("some error")
+
+	// the request succeeds before the attempts are used up
+	var calls, retries uint
+	err := doWithRetry(context.Background(), policy, queryRetryPolicy, m, func() error {
+		calls++
+		if calls < policy.Attempts {
+			return someErr
+		}
+		return nil
+	}, func(n uint, err error) {
+		require.Equal(t, retries, n)
+		require.ErrorIs(t, err, someErr)
+		retries++
+	})
+	require.NoError(t, err)
+	require.Equal(t, policy.Attempts, calls)
+	require.Equal(t, policy.Attempts-1, retries)
+
+	// the last error is returned once the attempts are used up
+	calls = 0
+	err = doWithRetry(context.Background(), policy, queryRetryPolicy, m, func() error {
+		calls++
+		return fmt.Errorf("attempt %d: %w", calls, someErr)
+	}, func(uint, error) {})
+	require.ErrorIs(t, err, someErr)
+	require.EqualError(t, err, fmt.Sprintf("attempt %d: some error", policy.Attempts))
+	require.Equal(t, policy.Attempts, calls)
+
+	// the unrecoverable error is not retried
+	calls = 0
+	err = doWithRetry(context.Background(), policy, queryRetryPolicy, m, func() error {
+		calls++
+		return retry.Unrecoverable(someErr)
+	}, func(uint, error) {})
+	require.ErrorIs(t, err, someErr)
+	require.Equal(t, uint(1), calls)
+
+	// the request is not retried once the context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	calls = 0
+	err = doWithRetry(ctx, policy, queryRetryPolicy, m, func() error {
+		calls++
+		cancel()
+		return someErr
+	}, func(uint, error) {})
+	require.Error(t, err)
+	require.Equal(t, uint(1), calls)
+}
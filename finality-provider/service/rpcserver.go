@@ -118,13 +118,18 @@ func (r *rpcServer) CreateFinalityProvider(ctx context.Context, req *proto.Creat
 func (r *rpcServer) RegisterFinalityProvider(ctx context.Context, req *proto.RegisterFinalityProviderRequest) (
 	*proto.RegisterFinalityProviderResponse, error) {
 
-	txRes, err := r.app.RegisterFinalityProvider(req.BtcPk)
+	txRes, err := r.app.RegisterFinalityProvider(ctx, req.BtcPk)
 	if err != nil {
 		return nil, fmt.Errorf("failed to register the finality-provider to Babylon: %w", err)
 	}
 
 	// the finality-provider instance should be started right after registration
-	if err := r.app.StartHandlingFinalityProvider(txRes.btcPubKey, req.Passphrase); err != nil {
+	// or once its registered epoch is BTC timestamped if auto start is enabled
+	startHandling := r.app.StartHandlingFinalityProvider
+	if req.AutoStart {
+		startHandling = r.app.StartHandlingFinalityProviderWhenFinalized
+	}
+	if err := startHandling(ctx, txRes.btcPubKey, req.Passphrase); err != nil {
 		return nil, fmt.Errorf("failed to start the registered finality-provider %s: %w", hex.EncodeToString(txRes.bbnPubKey.Key), err)
 	}
 
@@ -151,7 +156,7 @@ func (r *rpcServer) AddFinalitySignature(ctx context.Context, req *proto.AddFina
 		Hash:   req.AppHash,
 	}
 
-	txRes, privKey, err := fpi.TestSubmitFinalitySignatureAndExtractPrivKey(b)
+	txRes, privKey, err := fpi.TestSubmitFinalitySignatureAndExtractPrivKey(ctx, b)
 	if err != nil {
 		return nil, err
 	}
@@ -182,6 +187,71 @@ func (r *rpcServer) AddFinalitySignature(ctx context.Context, req *proto.AddFina
 	return res, nil
 }
 
+// UnlockFinalityProvider starts the LOCKED finality-provider with the passphrase of its EOTS key
+func (r *rpcServer) UnlockFinalityProvider(ctx context.Context, req *proto.UnlockFinalityProviderRequest) (
+	*proto.UnlockFinalityProviderResponse, error) {
+
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(req.BtcPk)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.app.UnlockFinalityProvider(ctx, fpPk, req.Passphrase); err != nil {
+		return nil, fmt.Errorf("failed to unlock the finality-provider %s: %w", req.BtcPk, err)
+	}
+
+	return &proto.UnlockFinalityProviderResponse{}, nil
+}
+
+// StopFinalityProvider stops the running finality-provider
+func (r *rpcServer) StopFinalityProvider(ctx context.Context, req *proto.StopFinalityProviderRequest) (
+	*proto.StopFinalityProviderResponse, error) {
+
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(req.BtcPk)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.app.StopHandlingFinalityProvider(fpPk); err != nil {
+		return nil, fmt.Errorf("failed to stop the finality-provider %s: %w", req.BtcPk, err)
+	}
+
+	return &proto.StopFinalityProviderResponse{}, nil
+}
+
+// PauseFinalityProvider stops the finality-provider from signing blocks
+func (r *rpcServer) PauseFinalityProvider(ctx context.Context, req *proto.PauseFinalityProviderRequest) (
+	*proto.PauseFinalityProviderResponse, error) {
+
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(req.BtcPk)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.app.PauseFinalityProvider(fpPk); err != nil {
+		return nil, fmt.Errorf("failed to pause the finality-provider %s: %w", req.BtcPk, err)
+	}
+
+	return &proto.PauseFinalityProviderResponse{}, nil
+}
+
+// ResumeFinalityProvider resumes signing blocks of the finality-provider
+// and starts it if it is stopped
+func (r *rpcServer) ResumeFinalityProvider(ctx context.Context, req *proto.ResumeFinalityProviderRequest) (
+	*proto.ResumeFinalityProviderResponse, error) {
+
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(req.BtcPk)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.app.ResumeFinalityProvider(ctx, fpPk, req.Passphrase); err != nil {
+		return nil, fmt.Errorf("failed to resume the finality-provider %s: %w", req.BtcPk, err)
+	}
+
+	return &proto.ResumeFinalityProviderResponse{}, nil
+}
+
 // QueryFinalityProvider queries the information of the finality-provider
 func (r *rpcServer) QueryFinalityProvider(ctx context.Context, req *proto.QueryFinalityProviderRequest) (
 	*proto.QueryFinalityProviderResponse, error) {
@@ -210,6 +280,38 @@ func (r *rpcServer) QueryFinalityProviderList(ctx context.Context, req *proto.Qu
 	return &proto.QueryFinalityProviderListResponse{FinalityProviders: fps}, nil
 }
 
+// QueryVotes queries a page of the votes of the finality provider in the vote journal
+func (r *rpcServer) QueryVotes(ctx context.Context, req *proto.QueryVotesRequest) (
+	*proto.QueryVotesResponse, error) {
+
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(req.BtcPk)
+	if err != nil {
+		return nil, err
+	}
+	votes, nextHeight, err := r.app.GetVotes(fpPk, req.StartHeight, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.QueryVotesResponse{Votes: votes, NextHeight: nextHeight}, nil
+}
+
+// QueryLiveness queries the liveness of the finality provider over the latest processed heights
+func (r *rpcServer) QueryLiveness(ctx context.Context, req *proto.QueryLivenessRequest) (
+	*proto.QueryLivenessResponse, error) {
+
+	fpPk, err := bbntypes.NewBIP340PubKeyFromHex(req.BtcPk)
+	if err != nil {
+		return nil, err
+	}
+	liveness, err := r.app.GetLiveness(fpPk)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.QueryLivenessResponse{Liveness: liveness}, nil
+}
+
 // SignMessageFromChainKey signs a message from the chain keyring.
 func (r *rpcServer) SignMessageFromChainKey(ctx context.Context, req *proto.SignMessageFromChainKeyRequest) (
 	*proto.SignMessageFromChainKeyResponse, error) {
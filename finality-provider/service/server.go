@@ -14,6 +14,7 @@ import (
 
 	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
 	"github.com/babylonchain/finality-provider/metrics"
+	"github.com/babylonchain/finality-provider/rpcauth"
 )
 
 // Server is the main daemon construct for the Finality Provider server. It handles
@@ -79,7 +80,12 @@ func (s *Server) RunUntilShutdown() error {
 	}
 	defer lis.Close()
 
-	grpcServer := grpc.NewServer()
+	grpcOpts, err := rpcauth.ServerOptions(s.cfg.RPCAuth)
+	if err != nil {
+		return fmt.Errorf("failed to set up the RPC server security: %w", err)
+	}
+
+	grpcServer := grpc.NewServer(grpcOpts...)
 	defer grpcServer.Stop()
 
 	if err := s.rpcServer.RegisterWithGrpcServer(grpcServer); err != nil {
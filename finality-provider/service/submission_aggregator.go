@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/babylonchain/finality-provider/clientcontroller"
+	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
+	"github.com/babylonchain/finality-provider/metrics"
+	"github.com/babylonchain/finality-provider/types"
+)
+
+// ErrAggregatorStopped is returned for the finality signatures
+// which are not submitted before the aggregator is stopped
+var ErrAggregatorStopped = errors.New("the submission aggregator is stopped")
+
+// SubmissionAggregator collects the finality signatures of the finality-provider
+// instances and submits the ones at the same height in a single transaction, so
+// that the instances do not race on the account sequence of the shared key
+type SubmissionAggregator struct {
+	isStarted *atomic.Bool
+	wg        sync.WaitGroup
+	quit      chan struct{}
+	// cancel cancels the in-flight submissions on stop
+	cancel context.CancelFunc
+
+	cc      clientcontroller.FinalitySigsSubmitter
+	cfg     *fpcfg.SubmissionAggregatorConfig
+	metrics *metrics.FpMetrics
+	logger  *zap.Logger
+
+	sigChan   chan *finalitySigRequest
+	flushChan chan *finalitySigBatch
+}
+
+type finalitySigRequest struct {
+	sig     *types.FinalitySig
+	resChan chan *finalitySigResult
+}
+
+type finalitySigResult struct {
+	res *types.TxResponse
+	err error
+}
+
+// finalitySigBatch is the batch of the finality signatures at the same height
+type finalitySigBatch struct {
+	height uint64
+	reqs   []*finalitySigRequest
+}
+
+func NewSubmissionAggregator(
+	cc clientcontroller.FinalitySigsSubmitter,
+	cfg *fpcfg.SubmissionAggregatorConfig,
+	metrics *metrics.FpMetrics,
+	logger *zap.Logger,
+) *SubmissionAggregator {
+	return &SubmissionAggregator{
+		isStarted: atomic.NewBool(false),
+		quit:      make(chan struct{}),
+		cc:        cc,
+		cfg:       cfg,
+		metrics:   metrics,
+		logger:    logger,
+		sigChan:   make(chan *finalitySigRequest),
+		flushChan: make(chan *finalitySigBatch),
+	}
+}
+
+func (sa *SubmissionAggregator) Start() error {
+	if sa.isStarted.Swap(true) {
+		return fmt.Errorf("the submission aggregator is already started")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sa.cancel = cancel
+
+	sa.wg.Add(1)
+	go sa.aggregationLoop(ctx)
+
+	sa.logger.Info("the submission aggregator is successfully started")
+
+	return nil
+}
+
+func (sa *SubmissionAggregator) Stop() error {
+	if !sa.isStarted.Swap(false) {
+		return fmt.Errorf("the submission aggregator has already stopped")
+	}
+
+	sa.cancel()
+	close(sa.quit)
+	sa.wg.Wait()
+
+	sa.logger.Info("the submission aggregator is successfully stopped")
+
+	return nil
+}
+
+// Submit adds the finality signature to the batch of its height
+// and waits until the batch is submitted
+func (sa *SubmissionAggregator) Submit(ctx context.Context, sig *types.FinalitySig) (*types.TxResponse, error) {
+	req := &finalitySigRequest{
+		sig:     sig,
+		resChan: make(chan *finalitySigResult, 1),
+	}
+
+	select {
+	case sa.sigChan <- req:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-sa.quit:
+		return nil, ErrAggregatorStopped
+	}
+
+	select {
+	case result := <-req.resChan:
+		return result.res, result.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// aggregationLoop collects the finality signatures into the batches of their heights,
+// a batch is submitted once the batch window since its first finality signature has
+// passed or it is full
+func (sa *SubmissionAggregator) aggregationLoop(ctx context.Context) {
+	defer sa.wg.Done()
+
+	batches := make(map[uint64]*finalitySigBatch)
+	for {
+		select {
+		case req := <-sa.sigChan:
+			height := req.sig.Block.Height
+			batch, ok := batches[height]
+			if !ok {
+				batch = &finalitySigBatch{height: height}
+				batches[height] = batch
+				time.AfterFunc(sa.cfg.BatchWindow, func() {
+					select {
+					case sa.flushChan <- batch:
+					case <-sa.quit:
+					}
+				})
+			}
+			batch.reqs = append(batch.reqs, req)
+
+			if uint32(len(batch.reqs)) >= sa.cfg.MaxBatchSize {
+				delete(batches, height)
+				sa.submitBatch(ctx, batch)
+			}
+
+		case batch := <-sa.flushChan:
+			// the batch might have been submitted once it is full
+			if batches[batch.height] != batch {
+				continue
+			}
+			delete(batches, batch.height)
+			sa.submitBatch(ctx, batch)
+
+		case <-sa.quit:
+			for _, batch := range batches {
+				for _, req := range batch.reqs {
+					req.resChan <- &finalitySigResult{err: ErrAggregatorStopped}
+				}
+			}
+			return
+		}
+	}
+}
+
+// submitBatch submits the batch in the background, so that the transactions
+// of different heights are in flight at the same time
+func (sa *SubmissionAggregator) submitBatch(ctx context.Context, batch *finalitySigBatch) {
+	sa.wg.Add(1)
+	go func() {
+		defer sa.wg.Done()
+
+		sigs := make([]*types.FinalitySig, 0, len(batch.reqs))
+		for _, req := range batch.reqs {
+			sigs = append(sigs, req.sig)
+		}
+
+		sa.metrics.RecordFinalitySigBatchSize(len(sigs))
+		res, err := sa.cc.SubmitFinalitySigs(ctx, sigs)
+		if err != nil && len(sigs) > 1 && isFinalitySigErr(err) {
+			// the transaction fails as a whole if any finality signature fails,
+			// so they are submitted separately to find out the failing ones
+			sa.logger.Debug(
+				"failed to submit the batch of finality signatures, submitting them separately",
+				zap.Uint64("height", batch.height),
+				zap.Int("batch_size", len(sigs)),
+				zap.Error(err),
+			)
+			sa.submitSeparately(ctx, batch)
+			return
+		}
+
+		for _, req := range batch.reqs {
+			req.resChan <- &finalitySigResult{res: res, err: err}
+		}
+	}()
+}
+
+func (sa *SubmissionAggregator) submitSeparately(ctx context.Context, batch *finalitySigBatch) {
+	var wg sync.WaitGroup
+	for _, req := range batch.reqs {
+		wg.Add(1)
+		go func(req *finalitySigRequest) {
+			defer wg.Done()
+			res, err := sa.cc.SubmitFinalitySigs(ctx, []*types.FinalitySig{req.sig})
+			req.resChan <- &finalitySigResult{res: res, err: err}
+		}(req)
+	}
+	wg.Wait()
+}
+
+// isFinalitySigErr returns true if the error might be caused by a single
+// finality signature rather than the transaction
+func isFinalitySigErr(err error) bool {
+	switch clientcontroller.CategoryOf(err) {
+	case clientcontroller.CategoryExpected, clientcontroller.CategoryUnrecoverable, clientcontroller.CategorySlashed:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,184 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	finalitytypes "github.com/babylonchain/babylon/x/finality/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/babylonchain/finality-provider/clientcontroller"
+	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
+	"github.com/babylonchain/finality-provider/finality-provider/service"
+	"github.com/babylonchain/finality-provider/metrics"
+	"github.com/babylonchain/finality-provider/testutil"
+	"github.com/babylonchain/finality-provider/testutil/mocks"
+	"github.com/babylonchain/finality-provider/types"
+)
+
+// FuzzSubmissionAggregator tests that the finality signatures of
+// several finality providers at the same height are submitted in a
+// single transaction, and that they are submitted separately once the
+// transaction fails because of one of them
+func FuzzSubmissionAggregator(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		numFps := int(r.Int31n(5)) + 2
+		block := &types.BlockInfo{
+			Height: uint64(r.Int63n(1000) + 1),
+			Hash:   testutil.GenRandomByteArray(r, 32),
+		}
+		sigs := make([]*types.FinalitySig, 0, numFps)
+		for i := 0; i < numFps; i++ {
+			sk, err := btcec.NewPrivateKey()
+			require.NoError(t, err)
+			sigs = append(sigs, &types.FinalitySig{
+				FpPk:  sk.PubKey(),
+				Block: block,
+				Sig:   new(btcec.ModNScalar),
+			})
+		}
+		// the finality signature of the failing finality provider
+		// makes the whole transaction fail
+		failing := sigs[r.Intn(numFps)]
+		failingErr := clientcontroller.NewError(clientcontroller.CategoryUnrecoverable, finalitytypes.ErrInvalidFinalitySig)
+		withFailure := r.Intn(2) == 0
+
+		ctl := gomock.NewController(t)
+		mockSubmitter := mocks.NewMockFinalitySigsSubmitter(ctl)
+		if withFailure {
+			mockSubmitter.EXPECT().SubmitFinalitySigs(gomock.Any(), gomock.Len(numFps)).
+				Return(nil, failingErr).Times(1)
+			mockSubmitter.EXPECT().SubmitFinalitySigs(gomock.Any(), gomock.Len(1)).
+				DoAndReturn(func(_ context.Context, batch []*types.FinalitySig) (*types.TxResponse, error) {
+					if batch[0] == failing {
+						return nil, failingErr
+					}
+					return &types.TxResponse{TxHash: batch[0].FpPk.X().String()}, nil
+				}).Times(numFps)
+		} else {
+			mockSubmitter.EXPECT().SubmitFinalitySigs(gomock.Any(), gomock.Len(numFps)).
+				Return(&types.TxResponse{TxHash: "batch"}, nil).Times(1)
+		}
+
+		// the batch is submitted once it is full
+		cfg := fpcfg.DefaultSubmissionAggregatorConfig()
+		cfg.Enabled = true
+		cfg.BatchWindow = 1 * time.Minute
+		cfg.MaxBatchSize = uint32(numFps)
+		aggregator := service.NewSubmissionAggregator(mockSubmitter, &cfg, metrics.NewFpMetrics(), zap.NewNop())
+		require.NoError(t, aggregator.Start())
+		defer func() {
+			require.NoError(t, aggregator.Stop())
+		}()
+
+		var wg sync.WaitGroup
+		for _, sig := range sigs {
+			wg.Add(1)
+			go func(sig *types.FinalitySig) {
+				defer wg.Done()
+				res, err := aggregator.Submit(context.Background(), sig)
+				switch {
+				case !withFailure:
+					require.NoError(t, err)
+					require.Equal(t, "batch", res.TxHash)
+				case sig == failing:
+					require.ErrorIs(t, err, finalitytypes.ErrInvalidFinalitySig)
+				default:
+					require.NoError(t, err)
+					require.Equal(t, sig.FpPk.X().String(), res.TxHash)
+				}
+			}(sig)
+		}
+		wg.Wait()
+	})
+}
+
+// FuzzSubmissionAggregatorBatchWindow tests that the batch which is not
+// full is submitted once the batch window since its first finality signature
+// has passed, and that the batch submitted once it is full is not submitted
+// again when its batch window passes
+func FuzzSubmissionAggregatorBatchWindow(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		maxBatchSize := int(r.Int31n(5)) + 2
+		numPartial := int(r.Int31n(int32(maxBatchSize-1))) + 1
+		partialHeight := uint64(r.Int63n(1000) + 1)
+		fullHeight := partialHeight + 1
+		genSigs := func(height uint64, num int) []*types.FinalitySig {
+			block := &types.BlockInfo{
+				Height: height,
+				Hash:   testutil.GenRandomByteArray(r, 32),
+			}
+			sigs := make([]*types.FinalitySig, 0, num)
+			for i := 0; i < num; i++ {
+				sk, err := btcec.NewPrivateKey()
+				require.NoError(t, err)
+				sigs = append(sigs, &types.FinalitySig{
+					FpPk:  sk.PubKey(),
+					Block: block,
+					Sig:   new(btcec.ModNScalar),
+				})
+			}
+			return sigs
+		}
+		sigs := append(genSigs(partialHeight, numPartial), genSigs(fullHeight, maxBatchSize)...)
+
+		ctl := gomock.NewController(t)
+		mockSubmitter := mocks.NewMockFinalitySigsSubmitter(ctl)
+		mockSubmitter.EXPECT().SubmitFinalitySigs(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, batch []*types.FinalitySig) (*types.TxResponse, error) {
+				height := batch[0].Block.Height
+				for _, sig := range batch {
+					require.Equal(t, height, sig.Block.Height)
+				}
+				if height == partialHeight {
+					require.Len(t, batch, numPartial)
+				} else {
+					require.Len(t, batch, maxBatchSize)
+				}
+				return &types.TxResponse{TxHash: fmt.Sprint(height)}, nil
+			}).Times(2)
+
+		cfg := fpcfg.DefaultSubmissionAggregatorConfig()
+		cfg.Enabled = true
+		cfg.BatchWindow = 200 * time.Millisecond
+		cfg.MaxBatchSize = uint32(maxBatchSize)
+		aggregator := service.NewSubmissionAggregator(mockSubmitter, &cfg, metrics.NewFpMetrics(), zap.NewNop())
+		require.NoError(t, aggregator.Start())
+		defer func() {
+			require.NoError(t, aggregator.Stop())
+		}()
+
+		start := time.Now()
+		var wg sync.WaitGroup
+		for _, sig := range sigs {
+			wg.Add(1)
+			go func(sig *types.FinalitySig) {
+				defer wg.Done()
+				res, err := aggregator.Submit(context.Background(), sig)
+				require.NoError(t, err)
+				require.Equal(t, fmt.Sprint(sig.Block.Height), res.TxHash)
+				if sig.Block.Height == partialHeight {
+					// the batch which is not full waits for the batch window
+					require.GreaterOrEqual(t, time.Since(start), cfg.BatchWindow)
+				}
+			}(sig)
+		}
+		wg.Wait()
+
+		// the batch window of the full batch passes without submitting it again
+		time.Sleep(2 * cfg.BatchWindow)
+	})
+}
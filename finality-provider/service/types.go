@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"sync"
 
 	sdkmath "cosmossdk.io/math"
@@ -32,6 +33,8 @@ type createFinalityProviderRequest struct {
 }
 
 type registerFinalityProviderRequest struct {
+	// ctx cancels the registration on the consumer chain once it is done
+	ctx       context.Context
 	bbnPubKey *secp256k1.PubKey
 	btcPubKey *bbntypes.BIP340PubKey
 	// TODO we should have our own representation of PoP
@@ -77,10 +80,26 @@ func (fps *fpState) getStoreFinalityProvider() *store.StoredFinalityProvider {
 func (fps *fpState) setStatus(s proto.FinalityProviderStatus) error {
 	fps.mu.Lock()
 	fps.fp.Status = s
+	fps.fp.ErrorReason = ""
 	fps.mu.Unlock()
 	return fps.s.SetFpStatus(fps.fp.BtcPk, s)
 }
 
+func (fps *fpState) setErrored(reason string) error {
+	fps.mu.Lock()
+	fps.fp.Status = proto.FinalityProviderStatus_ERRORED
+	fps.fp.ErrorReason = reason
+	fps.mu.Unlock()
+	return fps.s.SetFpErrored(fps.fp.BtcPk, reason)
+}
+
+func (fps *fpState) setPaused(paused bool) error {
+	fps.mu.Lock()
+	fps.fp.Paused = paused
+	fps.mu.Unlock()
+	return fps.s.SetFpPaused(fps.fp.BtcPk, paused)
+}
+
 func (fps *fpState) setLastProcessedHeight(height uint64) error {
 	fps.mu.Lock()
 	fps.fp.LastProcessedHeight = height
@@ -88,6 +107,15 @@ func (fps *fpState) setLastProcessedHeight(height uint64) error {
 	return fps.s.SetFpLastProcessedHeight(fps.fp.BtcPk, height)
 }
 
+func (fps *fpState) rewindLastProcessedHeight(height uint64) error {
+	fps.mu.Lock()
+	if fps.fp.LastProcessedHeight > height {
+		fps.fp.LastProcessedHeight = height
+	}
+	fps.mu.Unlock()
+	return fps.s.RewindFpLastProcessedHeight(fps.fp.BtcPk, height)
+}
+
 func (fps *fpState) setLastProcessedAndVotedHeight(height uint64) error {
 	fps.mu.Lock()
 	fps.fp.LastVotedHeight = height
@@ -116,6 +144,10 @@ func (fp *FinalityProviderInstance) GetStatus() proto.FinalityProviderStatus {
 	return fp.state.getStoreFinalityProvider().Status
 }
 
+func (fp *FinalityProviderInstance) IsPaused() bool {
+	return fp.state.getStoreFinalityProvider().Paused
+}
+
 func (fp *FinalityProviderInstance) GetLastVotedHeight() uint64 {
 	return fp.state.getStoreFinalityProvider().LastVotedHeight
 }
@@ -132,6 +164,14 @@ func (fp *FinalityProviderInstance) SetStatus(s proto.FinalityProviderStatus) er
 	return fp.state.setStatus(s)
 }
 
+func (fp *FinalityProviderInstance) SetPaused(paused bool) error {
+	return fp.state.setPaused(paused)
+}
+
+func (fp *FinalityProviderInstance) SetErrored(reason string) error {
+	return fp.state.setErrored(reason)
+}
+
 func (fp *FinalityProviderInstance) MustSetStatus(s proto.FinalityProviderStatus) {
 	if err := fp.SetStatus(s); err != nil {
 		fp.logger.Fatal("failed to set finality-provider status",
@@ -151,6 +191,18 @@ func (fp *FinalityProviderInstance) MustSetLastProcessedHeight(height uint64) {
 	fp.metrics.RecordFpLastProcessedHeight(fp.GetBtcPkHex(), height)
 }
 
+func (fp *FinalityProviderInstance) RewindLastProcessedHeight(height uint64) error {
+	return fp.state.rewindLastProcessedHeight(height)
+}
+
+func (fp *FinalityProviderInstance) MustRewindLastProcessedHeight(height uint64) {
+	if err := fp.RewindLastProcessedHeight(height); err != nil {
+		fp.logger.Fatal("failed to rewind last processed height",
+			zap.String("pk", fp.GetBtcPkHex()), zap.Uint64("last_processed_height", height))
+	}
+	fp.metrics.RecordFpLastProcessedHeight(fp.GetBtcPkHex(), height)
+}
+
 func (fp *FinalityProviderInstance) updateStateAfterFinalitySigSubmission(height uint64) error {
 	return fp.state.setLastProcessedAndVotedHeight(height)
 }
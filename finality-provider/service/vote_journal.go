@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	bbntypes "github.com/babylonchain/babylon/types"
+	"go.uber.org/zap"
+
+	"github.com/babylonchain/finality-provider/finality-provider/proto"
+)
+
+// pruneVoteJournalLoop periodically deletes the votes out of the retention
+// of the vote journal and the voted blocks below the finalized height so
+// that they do not grow without bound
+func (fpm *FinalityProviderManager) pruneVoteJournalLoop(ctx context.Context) {
+	defer fpm.wg.Done()
+
+	if fpm.config.VoteJournal.PruneInterval == 0 {
+		fpm.logger.Info("the pruning of the vote journal is disabled")
+		return
+	}
+
+	pruneTicker := time.NewTicker(fpm.config.VoteJournal.PruneInterval)
+	defer pruneTicker.Stop()
+
+	for {
+		select {
+		case <-pruneTicker.C:
+			fpm.pruneVoteJournal()
+			fpm.pruneVotedBlocks(ctx)
+		case <-fpm.quit:
+			return
+		}
+	}
+}
+
+// pruneVoteJournal deletes the votes of every stored finality provider which are
+// more than the retention heights below its last voted height or older than the
+// retention period
+func (fpm *FinalityProviderManager) pruneVoteJournal() {
+	cfg := fpm.config.VoteJournal
+	if cfg.RetentionHeights == 0 && cfg.RetentionPeriod == 0 {
+		return
+	}
+
+	storedFps, err := fpm.fps.GetAllStoredFinalityProviders()
+	if err != nil {
+		fpm.logger.Debug("failed to get the finality providers to prune the vote journal", zap.Error(err))
+		return
+	}
+
+	var submittedBefore time.Time
+	if cfg.RetentionPeriod > 0 {
+		submittedBefore = time.Now().Add(-cfg.RetentionPeriod)
+	}
+	for _, fp := range storedFps {
+		var belowHeight uint64
+		if cfg.RetentionHeights > 0 && fp.LastVotedHeight > cfg.RetentionHeights {
+			belowHeight = fp.LastVotedHeight - cfg.RetentionHeights
+		}
+
+		pruned, err := fpm.fps.PruneVotes(fp.BtcPk, belowHeight, submittedBefore)
+		if err != nil {
+			fpm.logger.Debug("failed to prune the vote journal",
+				zap.String("pk", fp.GetBIP340BTCPK().MarshalHex()), zap.Error(err))
+			continue
+		}
+		if pruned > 0 {
+			fpm.logger.Debug("pruned the vote journal",
+				zap.String("pk", fp.GetBIP340BTCPK().MarshalHex()),
+				zap.Uint64("below_height", belowHeight),
+				zap.Int("pruned_votes", pruned))
+		}
+	}
+}
+
+// pruneVotedBlocks deletes the voted blocks of every stored finality provider
+// below the latest finalized height, which are only kept to refuse voting for
+// a conflicting block at the same height and cannot be reorged once finalized
+func (fpm *FinalityProviderManager) pruneVotedBlocks(ctx context.Context) {
+	finalizedBlocks, err := fpm.cc.QueryLatestFinalizedBlocks(ctx, 1)
+	if err != nil {
+		fpm.logger.Debug("failed to query the latest finalized block to prune the voted blocks", zap.Error(err))
+		return
+	}
+	if len(finalizedBlocks) == 0 {
+		return
+	}
+	finalizedHeight := finalizedBlocks[0].Height
+
+	storedFps, err := fpm.fps.GetAllStoredFinalityProviders()
+	if err != nil {
+		fpm.logger.Debug("failed to get the finality providers to prune the voted blocks", zap.Error(err))
+		return
+	}
+
+	for _, fp := range storedFps {
+		pruned, err := fpm.fps.PruneVotedBlocks(fp.BtcPk, finalizedHeight)
+		if err != nil {
+			fpm.logger.Debug("failed to prune the voted blocks",
+				zap.String("pk", fp.GetBIP340BTCPK().MarshalHex()), zap.Error(err))
+			continue
+		}
+		if pruned > 0 {
+			fpm.logger.Debug("pruned the voted blocks",
+				zap.String("pk", fp.GetBIP340BTCPK().MarshalHex()),
+				zap.Uint64("below_height", finalizedHeight),
+				zap.Int("pruned_blocks", pruned))
+		}
+	}
+}
+
+// Votes returns a page of the votes of the finality provider in the vote journal
+// from the start height, along with the start height of the next page
+func (fpm *FinalityProviderManager) Votes(fpPk *bbntypes.BIP340PubKey, startHeight uint64, limit uint32) ([]*proto.VoteInfo, uint64, error) {
+	if limit == 0 || limit > fpm.config.VoteJournal.QueryLimit {
+		limit = fpm.config.VoteJournal.QueryLimit
+	}
+
+	records, nextHeight, err := fpm.fps.GetVotes(fpPk.MustToBTCPK(), startHeight, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	votes := make([]*proto.VoteInfo, 0, len(records))
+	for _, r := range records {
+		vote, err := proto.NewVoteInfo(r)
+		if err != nil {
+			return nil, 0, err
+		}
+		votes = append(votes, vote)
+	}
+
+	return votes, nextHeight, nil
+}
@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	bbntypes "github.com/babylonchain/babylon/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"go.uber.org/zap"
+
+	"github.com/babylonchain/finality-provider/clientcontroller"
+	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
+	"github.com/babylonchain/finality-provider/finality-provider/proto"
+	"github.com/babylonchain/finality-provider/metrics"
+)
+
+// VoteVerifier checks whether the included votes in the vote journal are recorded
+// by the consumer chain after their submission, marks the ones which are not as
+// unconfirmed, and resubmits them while their blocks are not finalized
+type VoteVerifier struct {
+	vq      clientcontroller.VoteQuerier
+	cfg     *fpcfg.VoteVerifierConfig
+	metrics *metrics.FpMetrics
+	logger  *zap.Logger
+
+	// mu guards the cursors and the resubmissions, which are updated by the
+	// verification loop and removed once the finality providers are stopped
+	mu sync.Mutex
+	// cursors are the lowest heights of the votes which have not been resolved,
+	// keyed by the hex string of the BTC public key of the finality providers
+	cursors map[string]uint64
+	// resubmissions are the numbers of times the unconfirmed votes are resubmitted
+	resubmissions map[voteKey]uint32
+}
+
+type voteKey struct {
+	fpBtcPkHex string
+	height     uint64
+}
+
+func NewVoteVerifier(
+	vq clientcontroller.VoteQuerier,
+	cfg *fpcfg.VoteVerifierConfig,
+	metrics *metrics.FpMetrics,
+	logger *zap.Logger,
+) *VoteVerifier {
+	return &VoteVerifier{
+		vq:            vq,
+		cfg:           cfg,
+		metrics:       metrics,
+		logger:        logger,
+		cursors:       make(map[string]uint64),
+		resubmissions: make(map[voteKey]uint32),
+	}
+}
+
+// Verify verifies the votes of the finality-provider instance in the vote journal from
+// the lowest unresolved height. A vote is resolved once it is confirmed, it failed to
+// be submitted, or its block is finalized. The verification of a finality provider
+// starts from the height above the given finalized height
+func (vv *VoteVerifier) Verify(ctx context.Context, fpi *FinalityProviderInstance, finalizedHeight uint64) error {
+	vv.mu.Lock()
+	defer vv.mu.Unlock()
+
+	pkHex := fpi.GetBtcPkHex()
+	cursor, ok := vv.cursors[pkHex]
+	if !ok {
+		cursor = finalizedHeight + 1
+	}
+	defer func() {
+		vv.cursors[pkHex] = cursor
+	}()
+
+	votes, _, err := fpi.state.s.GetVotes(fpi.GetBtcPk(), cursor, vv.cfg.BatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to get the votes from the vote journal: %w", err)
+	}
+
+	// the cursor only moves past the votes
+	// whose lower votes are all resolved
+	resolvedBelow := true
+	for _, vote := range votes {
+		resolved, err := vv.verifyVote(ctx, fpi, vote, finalizedHeight)
+		if err != nil {
+			return err
+		}
+		if !resolved {
+			resolvedBelow = false
+		}
+		if resolvedBelow {
+			cursor = vote.Height + 1
+		}
+	}
+
+	return nil
+}
+
+// Untrack stops verifying the votes of the finality provider and removes its
+// cursor and the resubmissions of its votes
+func (vv *VoteVerifier) Untrack(fpPk *btcec.PublicKey) {
+	pkHex := bbntypes.NewBIP340PubKeyFromBTCPK(fpPk).MarshalHex()
+
+	vv.mu.Lock()
+	defer vv.mu.Unlock()
+
+	delete(vv.cursors, pkHex)
+	for key := range vv.resubmissions {
+		if key.fpBtcPkHex == pkHex {
+			delete(vv.resubmissions, key)
+		}
+	}
+}
+
+// verifyVote verifies the vote and returns whether it is resolved
+func (vv *VoteVerifier) verifyVote(
+	ctx context.Context,
+	fpi *FinalityProviderInstance,
+	vote *proto.VoteRecord,
+	finalizedHeight uint64,
+) (bool, error) {
+	key := voteKey{fpBtcPkHex: fpi.GetBtcPkHex(), height: vote.Height}
+
+	switch vote.Status {
+	case proto.VoteStatus_CONFIRMED, proto.VoteStatus_FAILED:
+		delete(vv.resubmissions, key)
+		return true, nil
+	case proto.VoteStatus_SIGNED:
+		// the vote is being submitted, or its submission is interrupted
+		return vote.Height <= finalizedHeight, nil
+	}
+
+	recorded, err := vv.isVoteRecorded(ctx, fpi, vote.Height)
+	if err != nil {
+		return false, err
+	}
+	if recorded {
+		delete(vv.resubmissions, key)
+		if err := fpi.state.s.SetVoteStatus(fpi.GetBtcPk(), vote.Height, proto.VoteStatus_CONFIRMED, "", time.Time{}); err != nil {
+			return false, fmt.Errorf("failed to confirm the vote at height %d: %w", vote.Height, err)
+		}
+		return true, nil
+	}
+
+	if vote.Status == proto.VoteStatus_INCLUDED {
+		vote.Status = proto.VoteStatus_UNCONFIRMED
+		vv.logger.Warn(
+			"the included vote is not recorded by the consumer chain",
+			zap.String("pk", key.fpBtcPkHex),
+			zap.Uint64("height", vote.Height),
+			zap.String("tx_hash", vote.TxHash),
+		)
+		vv.metrics.IncrementFpTotalUnconfirmedVotes(key.fpBtcPkHex)
+		if err := fpi.state.s.SetVoteStatus(fpi.GetBtcPk(), vote.Height, proto.VoteStatus_UNCONFIRMED, "", time.Time{}); err != nil {
+			return false, fmt.Errorf("failed to mark the vote at height %d as unconfirmed: %w", vote.Height, err)
+		}
+	}
+
+	if vote.Height <= finalizedHeight {
+		// the vote no longer counts once the block is finalized
+		delete(vv.resubmissions, key)
+		return true, nil
+	}
+
+	if vv.resubmissions[key] >= vv.cfg.MaxResubmissions {
+		// the vote is kept unconfirmed until the block is finalized
+		return false, nil
+	}
+
+	vv.resubmissions[key]++
+	vv.metrics.IncrementFpTotalResubmittedVotes(key.fpBtcPkHex)
+	vv.logger.Info(
+		"resubmitting the unconfirmed vote",
+		zap.String("pk", key.fpBtcPkHex),
+		zap.Uint64("height", vote.Height),
+		zap.Uint32("resubmission", vv.resubmissions[key]),
+	)
+
+	// the result of the resubmission is verified at the next interval, and
+	// the vote is kept unconfirmed until it is recorded
+	if _, err := fpi.resubmitFinalitySignature(ctx, vote); err != nil {
+		vv.logger.Debug(
+			"failed to resubmit the unconfirmed vote",
+			zap.String("pk", key.fpBtcPkHex),
+			zap.Uint64("height", vote.Height),
+			zap.Error(err),
+		)
+	}
+
+	return false, nil
+}
+
+// isVoteRecorded returns whether the vote of the finality
+// provider at the given height is recorded
+func (vv *VoteVerifier) isVoteRecorded(ctx context.Context, fpi *FinalityProviderInstance, height uint64) (bool, error) {
+	votes, err := vv.vq.QueryVotesAtHeight(ctx, height)
+	if err != nil {
+		return false, err
+	}
+
+	fpBtcPk := fpi.GetBtcPkBIP340()
+	for _, pk := range votes {
+		if pk.Equals(fpBtcPk) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// verifyVotesLoop periodically verifies the included votes
+// of the running finality-provider instances
+func (fpm *FinalityProviderManager) verifyVotesLoop(ctx context.Context) {
+	defer fpm.wg.Done()
+
+	if fpm.verifier == nil {
+		fpm.logger.Info("the vote verifier is disabled")
+		return
+	}
+
+	verifyTicker := time.NewTicker(fpm.config.VoteVerifier.Interval)
+	defer verifyTicker.Stop()
+
+	for {
+		select {
+		case <-verifyTicker.C:
+			fpm.verifyVotes(ctx)
+		case <-fpm.quit:
+			return
+		}
+	}
+}
+
+func (fpm *FinalityProviderManager) verifyVotes(ctx context.Context) {
+	finalizedBlocks, err := fpm.cc.QueryLatestFinalizedBlocks(ctx, 1)
+	if err != nil {
+		fpm.logger.Debug("failed to query the latest finalized block", zap.Error(err))
+		return
+	}
+	var finalizedHeight uint64
+	if len(finalizedBlocks) > 0 {
+		finalizedHeight = finalizedBlocks[0].Height
+	}
+
+	for _, fpi := range fpm.ListFinalityProviderInstances() {
+		if !fpi.IsRunning() {
+			continue
+		}
+		if err := fpm.verifier.Verify(ctx, fpi, finalizedHeight); err != nil {
+			fpm.logger.Debug("failed to verify the votes",
+				zap.String("pk", fpi.GetBtcPkHex()), zap.Error(err))
+		}
+	}
+}
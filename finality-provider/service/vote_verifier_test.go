@@ -0,0 +1,148 @@
+package service_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	bbntypes "github.com/babylonchain/babylon/types"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/babylonchain/finality-provider/finality-provider/config"
+	"github.com/babylonchain/finality-provider/finality-provider/proto"
+	"github.com/babylonchain/finality-provider/finality-provider/service"
+	"github.com/babylonchain/finality-provider/metrics"
+	"github.com/babylonchain/finality-provider/testutil"
+	"github.com/babylonchain/finality-provider/testutil/mocks"
+	"github.com/babylonchain/finality-provider/types"
+)
+
+// FuzzVoteVerifier tests that the included vote which is not recorded by the
+// consumer chain is marked as unconfirmed and resubmitted up to the maximum
+// number of times while its block is not finalized, and that it is resolved
+// once it is recorded or its block is finalized
+func FuzzVoteVerifier(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		randomRegiteredEpoch := uint64(r.Int63n(10) + 1)
+		randomStartingHeight := uint64(r.Int63n(100) + 1)
+		currentHeight := randomStartingHeight + uint64(r.Int63n(10)+1)
+		mockClientController := testutil.PrepareMockedClientController(t, r, randomStartingHeight, currentHeight)
+		mockClientController.EXPECT().QueryLastFinalizedEpoch(gomock.Any()).Return(randomRegiteredEpoch, nil).AnyTimes()
+
+		app, fpIns, cleanUp := startFinalityProviderAppWithRegisteredFp(t, r, mockClientController, randomStartingHeight, randomRegiteredEpoch)
+		defer cleanUp()
+
+		mockClientController.EXPECT().QueryFinalityProviderVotingPower(gomock.Any(), fpIns.GetBtcPk(), gomock.Any()).
+			Return(uint64(1), nil).AnyTimes()
+
+		block := &types.BlockInfo{
+			Height: randomStartingHeight + 1,
+			Hash:   testutil.GenRandomByteArray(r, 32),
+		}
+		var (
+			submissions  int
+			submittedSig *btcec.ModNScalar
+		)
+		mockClientController.EXPECT().
+			SubmitFinalitySig(gomock.Any(), fpIns.GetBtcPk(), block.Height, block.Hash, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ *btcec.PublicKey, _ uint64, _ []byte, sig *btcec.ModNScalar) (*types.TxResponse, error) {
+				submissions++
+				// the vote is resubmitted with the signature in the vote journal
+				if submittedSig == nil {
+					submittedSig = sig
+				}
+				require.True(t, submittedSig.Equals(sig))
+				return &types.TxResponse{TxHash: testutil.GenRandomHexStr(r, 32)}, nil
+			}).AnyTimes()
+		_, err := fpIns.SubmitFinalitySignature(context.Background(), block)
+		require.NoError(t, err)
+		require.Equal(t, 1, submissions)
+
+		// the finality provider moves past the height of the vote, which
+		// is not moved backwards by the resubmissions of the vote
+		processedHeight := block.Height + uint64(r.Int63n(10)+1)
+		fpIns.MustUpdateStateAfterFinalitySigSubmission(processedHeight)
+
+		ctl := gomock.NewController(t)
+		mockVoteQuerier := mocks.NewMockVoteQuerier(ctl)
+		recorded := false
+		var queries int
+		mockVoteQuerier.EXPECT().QueryVotesAtHeight(gomock.Any(), block.Height).
+			DoAndReturn(func(_ context.Context, _ uint64) ([]bbntypes.BIP340PubKey, error) {
+				queries++
+				if recorded {
+					return []bbntypes.BIP340PubKey{*fpIns.GetBtcPkBIP340()}, nil
+				}
+				return []bbntypes.BIP340PubKey{}, nil
+			}).AnyTimes()
+
+		maxResubmissions := uint32(r.Int31n(3) + 1)
+		cfg := config.DefaultVoteVerifierConfig()
+		cfg.MaxResubmissions = maxResubmissions
+		verifier := service.NewVoteVerifier(mockVoteQuerier, &cfg, metrics.NewFpMetrics(), zap.NewNop())
+
+		requireVoteStatus := func(status proto.VoteStatus) {
+			votes, _, err := app.GetFinalityProviderStore().GetVotes(fpIns.GetBtcPk(), block.Height, 1)
+			require.NoError(t, err)
+			require.Len(t, votes, 1)
+			require.Equal(t, status, votes[0].Status)
+			// the vote is recorded with the time of its submission
+			require.NotZero(t, votes[0].SubmittedAt)
+		}
+		requireVoteStatus(proto.VoteStatus_INCLUDED)
+
+		// the vote is marked as unconfirmed once and resubmitted up to
+		// the maximum number of times while the block is not finalized
+		finalizedHeight := block.Height - 1
+		for i := uint32(0); i < maxResubmissions; i++ {
+			err = verifier.Verify(context.Background(), fpIns, finalizedHeight)
+			require.NoError(t, err)
+			require.Equal(t, int(i)+2, submissions)
+			requireVoteStatus(proto.VoteStatus_UNCONFIRMED)
+		}
+		err = verifier.Verify(context.Background(), fpIns, finalizedHeight)
+		require.NoError(t, err)
+		require.Equal(t, int(maxResubmissions)+1, submissions)
+		requireVoteStatus(proto.VoteStatus_UNCONFIRMED)
+		unconfirmed, ok := fpMetricValue(t, "fp_total_unconfirmed_votes", fpIns.GetBtcPkHex())
+		require.True(t, ok)
+		require.Equal(t, float64(1), unconfirmed)
+		resubmitted, ok := fpMetricValue(t, "fp_total_resubmitted_votes", fpIns.GetBtcPkHex())
+		require.True(t, ok)
+		require.Equal(t, float64(maxResubmissions), resubmitted)
+		require.Equal(t, processedHeight, fpIns.GetLastProcessedHeight())
+		require.Equal(t, processedHeight, fpIns.GetLastVotedHeight())
+
+		// the resubmissions are counted from scratch once the finality provider is untracked
+		verifier.Untrack(fpIns.GetBtcPk())
+		err = verifier.Verify(context.Background(), fpIns, finalizedHeight)
+		require.NoError(t, err)
+		require.Equal(t, int(maxResubmissions)+2, submissions)
+
+		if r.Intn(2) == 0 {
+			// the vote is confirmed once it is recorded
+			recorded = true
+			err = verifier.Verify(context.Background(), fpIns, finalizedHeight)
+			require.NoError(t, err)
+			requireVoteStatus(proto.VoteStatus_CONFIRMED)
+		} else {
+			// the vote is kept unconfirmed once the block is finalized
+			err = verifier.Verify(context.Background(), fpIns, block.Height)
+			require.NoError(t, err)
+			requireVoteStatus(proto.VoteStatus_UNCONFIRMED)
+		}
+		require.Equal(t, int(maxResubmissions)+2, submissions)
+
+		// the resolved vote is no longer verified
+		numQueries := queries
+		err = verifier.Verify(context.Background(), fpIns, block.Height)
+		require.NoError(t, err)
+		require.Equal(t, numQueries, queries)
+	})
+}
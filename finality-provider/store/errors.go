@@ -11,4 +11,13 @@ var (
 
 	// ErrDuplicateFinalityProvider The finality provider we try to add already exists in db
 	ErrDuplicateFinalityProvider = errors.New("finality provider already exists")
+
+	// ErrVotedBlockNotFound The finality provider has not voted at the height
+	ErrVotedBlockNotFound = errors.New("voted block not found")
+
+	// ErrConflictingVotedBlock The finality provider has voted for a different block at the height
+	ErrConflictingVotedBlock = errors.New("a different block has been voted at the height")
+
+	// ErrVoteNotFound The vote of the finality provider at the height is not in the vote journal
+	ErrVoteNotFound = errors.New("vote not found")
 )
@@ -1,8 +1,11 @@
 package store
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
 	"math"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -14,11 +17,18 @@ import (
 	pm "google.golang.org/protobuf/proto"
 
 	"github.com/babylonchain/finality-provider/finality-provider/proto"
+	"github.com/babylonchain/finality-provider/migration"
 )
 
 var (
 	// mapping pk -> proto.FinalityProvider
 	finalityProviderBucketName = []byte("finalityProviders")
+
+	// mapping pk || height -> hash of the block voted by the finality provider
+	votedBlocksBucketName = []byte("votedBlocks")
+
+	// mapping pk || height -> proto.VoteRecord
+	voteJournalBucketName = []byte("voteJournal")
 )
 
 type FinalityProviderStore struct {
@@ -32,13 +42,38 @@ func NewFinalityProviderStore(db kvdb.Backend) (*FinalityProviderStore, error) {
 		return nil, err
 	}
 
+	// the db should be migrated before the store is used
+	if err := migration.CheckVersion(db, migrations); err != nil {
+		return nil, err
+	}
+
 	return store, nil
 }
 
+// initBuckets creates the buckets of a new db, which is at the latest schema version,
+// while the buckets of an existing db are created by the migrations
 func (s *FinalityProviderStore) initBuckets() error {
 	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		if tx.ReadWriteBucket(finalityProviderBucketName) != nil {
+			return nil
+		}
+
 		_, err := tx.CreateTopLevelBucket(finalityProviderBucketName)
-		return err
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateTopLevelBucket(votedBlocksBucketName)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateTopLevelBucket(voteJournalBucketName)
+		if err != nil {
+			return err
+		}
+
+		return migration.SetVersion(tx, migration.LatestVersion(migrations))
 	})
 }
 
@@ -108,15 +143,40 @@ func saveFinalityProvider(
 	return fpBucket.Put(fp.BtcPk, marshalled)
 }
 
+// SetFpStatus sets the status of the finality provider
+// the error reason is cleared as the status is no longer ERRORED
 func (s *FinalityProviderStore) SetFpStatus(btcPk *btcec.PublicKey, status proto.FinalityProviderStatus) error {
 	setFpStatus := func(fp *proto.FinalityProvider) error {
 		fp.Status = status
+		fp.ErrorReason = ""
 		return nil
 	}
 
 	return s.setFinalityProviderState(btcPk, setFpStatus)
 }
 
+// SetFpErrored sets the status of the finality provider to ERRORED
+// along with the reason of the critical error
+func (s *FinalityProviderStore) SetFpErrored(btcPk *btcec.PublicKey, reason string) error {
+	setFpErrored := func(fp *proto.FinalityProvider) error {
+		fp.Status = proto.FinalityProviderStatus_ERRORED
+		fp.ErrorReason = reason
+		return nil
+	}
+
+	return s.setFinalityProviderState(btcPk, setFpErrored)
+}
+
+// SetFpPaused sets whether the finality provider stops signing blocks
+func (s *FinalityProviderStore) SetFpPaused(btcPk *btcec.PublicKey, paused bool) error {
+	setFpPaused := func(fp *proto.FinalityProvider) error {
+		fp.Paused = paused
+		return nil
+	}
+
+	return s.setFinalityProviderState(btcPk, setFpPaused)
+}
+
 func (s *FinalityProviderStore) SetFpRegisteredEpoch(btcPk *btcec.PublicKey, registeredEpoch uint64) error {
 	setFpStatus := func(fp *proto.FinalityProvider) error {
 		fp.RegisteredEpoch = registeredEpoch
@@ -157,6 +217,311 @@ func (s *FinalityProviderStore) SetFpLastProcessedHeight(btcPk *btcec.PublicKey,
 	return s.setFinalityProviderState(btcPk, setFpLastProcessedHeight)
 }
 
+// RewindFpLastProcessedHeight sets the last processed height to the given height if it is
+// lower than the stored one, so that the blocks replaced by a chain reorg are processed again
+func (s *FinalityProviderStore) RewindFpLastProcessedHeight(btcPk *btcec.PublicKey, lastProcessedHeight uint64) error {
+	rewindFpLastProcessedHeight := func(fp *proto.FinalityProvider) error {
+		if fp.LastProcessedHeight > lastProcessedHeight {
+			fp.LastProcessedHeight = lastProcessedHeight
+		}
+
+		return nil
+	}
+
+	return s.setFinalityProviderState(btcPk, rewindFpLastProcessedHeight)
+}
+
+// SaveVotedBlock records the hash of the block voted by the finality provider at the given height
+// It fails with ErrConflictingVotedBlock if a different block has been voted at the height
+func (s *FinalityProviderStore) SaveVotedBlock(btcPk *btcec.PublicKey, height uint64, blockHash []byte) error {
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		votedBlocksBucket := tx.ReadWriteBucket(votedBlocksBucketName)
+		if votedBlocksBucket == nil {
+			return ErrCorruptedFinalityProviderDb
+		}
+
+		return saveVotedBlock(votedBlocksBucket, votedBlockKey(btcPk, height), blockHash)
+	})
+}
+
+func saveVotedBlock(
+	votedBlocksBucket walletdb.ReadWriteBucket,
+	key, blockHash []byte,
+) error {
+	if v := votedBlocksBucket.Get(key); v != nil {
+		if !bytes.Equal(v, blockHash) {
+			return ErrConflictingVotedBlock
+		}
+		return nil
+	}
+
+	return votedBlocksBucket.Put(key, blockHash)
+}
+
+// GetVotedBlockHash returns the hash of the block voted by the finality provider at the given height
+func (s *FinalityProviderStore) GetVotedBlockHash(btcPk *btcec.PublicKey, height uint64) ([]byte, error) {
+	var blockHash []byte
+	key := votedBlockKey(btcPk, height)
+	err := s.db.View(func(tx kvdb.RTx) error {
+		votedBlocksBucket := tx.ReadBucket(votedBlocksBucketName)
+		if votedBlocksBucket == nil {
+			return ErrCorruptedFinalityProviderDb
+		}
+
+		v := votedBlocksBucket.Get(key)
+		if v == nil {
+			return ErrVotedBlockNotFound
+		}
+		blockHash = make([]byte, len(v))
+		copy(blockHash, v)
+
+		return nil
+	}, func() {
+		blockHash = nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return blockHash, nil
+}
+
+// PruneVotedBlocks deletes the voted blocks of the finality provider below the given height
+// and returns the number of the deleted blocks
+// The voted blocks below the finalized height can be pruned as they cannot be reorged
+func (s *FinalityProviderStore) PruneVotedBlocks(btcPk *btcec.PublicKey, belowHeight uint64) (int, error) {
+	var pruned int
+	pkBytes := schnorr.SerializePubKey(btcPk)
+	endKey := votedBlockKey(btcPk, belowHeight)
+
+	err := kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		pruned = 0
+		votedBlocksBucket := tx.ReadWriteBucket(votedBlocksBucketName)
+		if votedBlocksBucket == nil {
+			return ErrCorruptedFinalityProviderDb
+		}
+
+		var keys [][]byte
+		c := votedBlocksBucket.ReadCursor()
+		for k, _ := c.Seek(pkBytes); k != nil && bytes.HasPrefix(k, pkBytes) &&
+			bytes.Compare(k, endKey) < 0; k, _ = c.Next() {
+			keys = append(keys, bytes.Clone(k))
+		}
+
+		// the keys are deleted after the iteration as
+		// the cursor is invalidated by the deletions
+		for _, k := range keys {
+			if err := votedBlocksBucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		pruned = len(keys)
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return pruned, nil
+}
+
+// SaveVote records the block voted by the finality provider at the given height along
+// with the signed vote in the vote journal, which are written in a single transaction
+// It fails with ErrConflictingVotedBlock if a different block has been voted at the height,
+// and the vote at the same height is replaced as it is signed again
+func (s *FinalityProviderStore) SaveVote(
+	btcPk *btcec.PublicKey,
+	height uint64,
+	blockHash, signature []byte,
+) error {
+	vote := &proto.VoteRecord{
+		BtcPk:     schnorr.SerializePubKey(btcPk),
+		Height:    height,
+		BlockHash: blockHash,
+		Signature: signature,
+		Status:    proto.VoteStatus_SIGNED,
+	}
+
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		votedBlocksBucket := tx.ReadWriteBucket(votedBlocksBucketName)
+		if votedBlocksBucket == nil {
+			return ErrCorruptedFinalityProviderDb
+		}
+		voteJournalBucket := tx.ReadWriteBucket(voteJournalBucketName)
+		if voteJournalBucket == nil {
+			return ErrCorruptedFinalityProviderDb
+		}
+
+		if err := saveVotedBlock(votedBlocksBucket, votedBlockKey(btcPk, height), blockHash); err != nil {
+			return err
+		}
+
+		return saveVote(voteJournalBucket, vote)
+	})
+}
+
+func saveVote(
+	voteJournalBucket walletdb.ReadWriteBucket,
+	vote *proto.VoteRecord,
+) error {
+	marshalled, err := pm.Marshal(vote)
+	if err != nil {
+		return err
+	}
+
+	return voteJournalBucket.Put(voteKey(vote.BtcPk, vote.Height), marshalled)
+}
+
+// SetVoteStatus sets the inclusion status of the vote at the given height along
+// with the hash of the transaction submitting it and the submission time, which
+// are kept if empty
+func (s *FinalityProviderStore) SetVoteStatus(
+	btcPk *btcec.PublicKey,
+	height uint64,
+	status proto.VoteStatus,
+	txHash string,
+	submittedAt time.Time,
+) error {
+	key := voteKey(schnorr.SerializePubKey(btcPk), height)
+	return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		voteJournalBucket := tx.ReadWriteBucket(voteJournalBucketName)
+		if voteJournalBucket == nil {
+			return ErrCorruptedFinalityProviderDb
+		}
+
+		v := voteJournalBucket.Get(key)
+		if v == nil {
+			return ErrVoteNotFound
+		}
+
+		var vote proto.VoteRecord
+		if err := pm.Unmarshal(v, &vote); err != nil {
+			return ErrCorruptedFinalityProviderDb
+		}
+
+		vote.Status = status
+		if txHash != "" {
+			vote.TxHash = txHash
+		}
+		if !submittedAt.IsZero() {
+			vote.SubmittedAt = submittedAt.Unix()
+		}
+
+		return saveVote(voteJournalBucket, &vote)
+	})
+}
+
+// GetVotes returns at most limit votes of the finality provider from the start height
+// in ascending order of height, along with the start height of the next page, which
+// is zero if there are no more votes
+func (s *FinalityProviderStore) GetVotes(
+	btcPk *btcec.PublicKey,
+	startHeight uint64,
+	limit uint32,
+) ([]*proto.VoteRecord, uint64, error) {
+	var (
+		votes      []*proto.VoteRecord
+		nextHeight uint64
+	)
+	pkBytes := schnorr.SerializePubKey(btcPk)
+
+	err := s.db.View(func(tx kvdb.RTx) error {
+		voteJournalBucket := tx.ReadBucket(voteJournalBucketName)
+		if voteJournalBucket == nil {
+			return ErrCorruptedFinalityProviderDb
+		}
+
+		c := voteJournalBucket.ReadCursor()
+		for k, v := c.Seek(voteKey(pkBytes, startHeight)); k != nil && bytes.HasPrefix(k, pkBytes); k, v = c.Next() {
+			var vote proto.VoteRecord
+			if err := pm.Unmarshal(v, &vote); err != nil {
+				return ErrCorruptedFinalityProviderDb
+			}
+			if uint32(len(votes)) == limit {
+				nextHeight = vote.Height
+				return nil
+			}
+			votes = append(votes, &vote)
+		}
+
+		return nil
+	}, func() {
+		votes = nil
+		nextHeight = 0
+	})
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return votes, nextHeight, nil
+}
+
+// PruneVotes deletes the votes of the finality provider which are below the
+// given height or submitted before the given time, and returns the number of
+// the deleted votes. The votes which are not submitted yet are only pruned
+// by their heights
+func (s *FinalityProviderStore) PruneVotes(
+	btcPk *btcec.PublicKey,
+	belowHeight uint64,
+	submittedBefore time.Time,
+) (int, error) {
+	var pruned int
+	pkBytes := schnorr.SerializePubKey(btcPk)
+
+	err := kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+		pruned = 0
+		voteJournalBucket := tx.ReadWriteBucket(voteJournalBucketName)
+		if voteJournalBucket == nil {
+			return ErrCorruptedFinalityProviderDb
+		}
+
+		var keys [][]byte
+		c := voteJournalBucket.ReadCursor()
+		for k, v := c.Seek(pkBytes); k != nil && bytes.HasPrefix(k, pkBytes); k, v = c.Next() {
+			var vote proto.VoteRecord
+			if err := pm.Unmarshal(v, &vote); err != nil {
+				return ErrCorruptedFinalityProviderDb
+			}
+			submittedExpired := vote.SubmittedAt != 0 && vote.SubmittedAt < submittedBefore.Unix()
+			if vote.Height < belowHeight || submittedExpired {
+				keys = append(keys, bytes.Clone(k))
+			}
+		}
+
+		// the keys are deleted after the iteration as
+		// the cursor is invalidated by the deletions
+		for _, k := range keys {
+			if err := voteJournalBucket.Delete(k); err != nil {
+				return err
+			}
+		}
+		pruned = len(keys)
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return pruned, nil
+}
+
+func voteKey(pkBytes []byte, height uint64) []byte {
+	key := make([]byte, 0, len(pkBytes)+8)
+	key = append(key, pkBytes...)
+	return binary.BigEndian.AppendUint64(key, height)
+}
+
+func votedBlockKey(btcPk *btcec.PublicKey, height uint64) []byte {
+	key := schnorr.SerializePubKey(btcPk)
+	return binary.BigEndian.AppendUint64(key, height)
+}
+
 func (s *FinalityProviderStore) setFinalityProviderState(
 	btcPk *btcec.PublicKey,
 	stateTransitionFn func(provider *proto.FinalityProvider) error,
@@ -4,12 +4,16 @@ import (
 	"math/rand"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/babylonchain/babylon/testutil/datagen"
+	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/stretchr/testify/require"
 
 	"github.com/babylonchain/finality-provider/finality-provider/config"
+	"github.com/babylonchain/finality-provider/finality-provider/proto"
 	fpstore "github.com/babylonchain/finality-provider/finality-provider/store"
+	"github.com/babylonchain/finality-provider/migration"
 	"github.com/babylonchain/finality-provider/testutil"
 )
 
@@ -78,3 +82,254 @@ func FuzzFinalityProvidersStore(f *testing.F) {
 		require.ErrorIs(t, err, fpstore.ErrFinalityProviderNotFound)
 	})
 }
+
+// FuzzVotedBlocks tests the finality provider cannot record
+// different voted blocks at the same height, and the voted
+// blocks below a height are pruned properly
+func FuzzVotedBlocks(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		homePath := t.TempDir()
+		cfg := config.DefaultDBConfigWithHomePath(homePath)
+
+		fpdb, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+		vs, err := fpstore.NewFinalityProviderStore(fpdb)
+		require.NoError(t, err)
+
+		defer func() {
+			err := fpdb.Close()
+			require.NoError(t, err)
+			err = os.RemoveAll(homePath)
+			require.NoError(t, err)
+		}()
+
+		_, btcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		height := uint64(r.Int63n(1000) + 1)
+		blockHash := datagen.GenRandomByteArray(r, 32)
+
+		_, err = vs.GetVotedBlockHash(btcPk, height)
+		require.ErrorIs(t, err, fpstore.ErrVotedBlockNotFound)
+
+		err = vs.SaveVotedBlock(btcPk, height, blockHash)
+		require.NoError(t, err)
+		// saving the same block again is allowed
+		err = vs.SaveVotedBlock(btcPk, height, blockHash)
+		require.NoError(t, err)
+
+		actualHash, err := vs.GetVotedBlockHash(btcPk, height)
+		require.NoError(t, err)
+		require.Equal(t, blockHash, actualHash)
+
+		err = vs.SaveVotedBlock(btcPk, height, datagen.GenRandomByteArray(r, 32))
+		require.ErrorIs(t, err, fpstore.ErrConflictingVotedBlock)
+
+		// the vote of a conflicting block is not recorded along with its block
+		err = vs.SaveVote(btcPk, height, datagen.GenRandomByteArray(r, 32), datagen.GenRandomByteArray(r, 64))
+		require.ErrorIs(t, err, fpstore.ErrConflictingVotedBlock)
+		votes, _, err := vs.GetVotes(btcPk, height, 1)
+		require.NoError(t, err)
+		require.Empty(t, votes)
+		err = vs.SaveVote(btcPk, height, blockHash, datagen.GenRandomByteArray(r, 64))
+		require.NoError(t, err)
+		votes, _, err = vs.GetVotes(btcPk, height, 1)
+		require.NoError(t, err)
+		require.Len(t, votes, 1)
+		require.Equal(t, blockHash, votes[0].BlockHash)
+
+		// the voted blocks are recorded per height
+		_, err = vs.GetVotedBlockHash(btcPk, height+1)
+		require.ErrorIs(t, err, fpstore.ErrVotedBlockNotFound)
+
+		// only the voted blocks of the finality provider below the height are pruned
+		_, otherBtcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		err = vs.SaveVotedBlock(otherBtcPk, height, blockHash)
+		require.NoError(t, err)
+		err = vs.SaveVotedBlock(btcPk, height+1, blockHash)
+		require.NoError(t, err)
+		pruned, err := vs.PruneVotedBlocks(btcPk, height+1)
+		require.NoError(t, err)
+		require.Equal(t, 1, pruned)
+		_, err = vs.GetVotedBlockHash(btcPk, height)
+		require.ErrorIs(t, err, fpstore.ErrVotedBlockNotFound)
+		_, err = vs.GetVotedBlockHash(btcPk, height+1)
+		require.NoError(t, err)
+		_, err = vs.GetVotedBlockHash(otherBtcPk, height)
+		require.NoError(t, err)
+	})
+}
+
+// FuzzVoteJournal tests the votes are recorded in the vote journal,
+// paged through in the order of height, and pruned properly
+func FuzzVoteJournal(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		homePath := t.TempDir()
+		cfg := config.DefaultDBConfigWithHomePath(homePath)
+
+		fpdb, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+		vs, err := fpstore.NewFinalityProviderStore(fpdb)
+		require.NoError(t, err)
+
+		defer func() {
+			err := fpdb.Close()
+			require.NoError(t, err)
+			err = os.RemoveAll(homePath)
+			require.NoError(t, err)
+		}()
+
+		_, btcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		_, otherBtcPk, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+
+		startHeight := uint64(r.Int63n(1000) + 1)
+		numVotes := uint64(r.Int63n(20) + 2)
+		for i := uint64(0); i < numVotes; i++ {
+			err = vs.SaveVote(btcPk, startHeight+i, datagen.GenRandomByteArray(r, 32),
+				datagen.GenRandomByteArray(r, 32))
+			require.NoError(t, err)
+		}
+		// the votes of the other finality provider are not returned
+		err = vs.SaveVote(otherBtcPk, startHeight, datagen.GenRandomByteArray(r, 32),
+			datagen.GenRandomByteArray(r, 32))
+		require.NoError(t, err)
+
+		// the votes but the last one are submitted one second after another
+		submittedAt := time.Now()
+		for i := uint64(0); i < numVotes-1; i++ {
+			var txHash string
+			if i == 0 {
+				txHash = "txhash"
+			}
+			err = vs.SetVoteStatus(btcPk, startHeight+i, proto.VoteStatus_INCLUDED, txHash,
+				submittedAt.Add(time.Duration(i)*time.Second))
+			require.NoError(t, err)
+		}
+		err = vs.SetVoteStatus(btcPk, startHeight+numVotes, proto.VoteStatus_INCLUDED, "txhash", submittedAt)
+		require.ErrorIs(t, err, fpstore.ErrVoteNotFound)
+
+		// page through the votes
+		limit := uint32(r.Int31n(5) + 1)
+		var votes []*proto.VoteRecord
+		height := uint64(0)
+		for {
+			page, nextHeight, err := vs.GetVotes(btcPk, height, limit)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(page), int(limit))
+			votes = append(votes, page...)
+			if nextHeight == 0 {
+				break
+			}
+			height = nextHeight
+		}
+		require.Len(t, votes, int(numVotes))
+		for i, vote := range votes {
+			require.Equal(t, startHeight+uint64(i), vote.Height)
+		}
+		require.Equal(t, "txhash", votes[0].TxHash)
+		for i, vote := range votes[:numVotes-1] {
+			require.Equal(t, proto.VoteStatus_INCLUDED, vote.Status)
+			require.Equal(t, submittedAt.Add(time.Duration(i)*time.Second).Unix(), vote.SubmittedAt)
+		}
+		// the last vote is signed but not submitted yet
+		require.Equal(t, proto.VoteStatus_SIGNED, votes[numVotes-1].Status)
+		require.Zero(t, votes[numVotes-1].SubmittedAt)
+
+		// prune the votes below a random height or submitted before a random time
+		belowHeight := startHeight + uint64(r.Int63n(int64(numVotes)))
+		pruned, err := vs.PruneVotes(btcPk, belowHeight, time.Time{})
+		require.NoError(t, err)
+		require.Equal(t, int(belowHeight-startHeight), pruned)
+		numSubmitted := r.Int63n(int64(numVotes))
+		pruned, err = vs.PruneVotes(btcPk, 0, submittedAt.Add(time.Duration(numSubmitted)*time.Second))
+		require.NoError(t, err)
+		remaining, nextHeight, err := vs.GetVotes(btcPk, 0, uint32(numVotes))
+		require.NoError(t, err)
+		require.Zero(t, nextHeight)
+		require.Len(t, remaining, int(numVotes)-int(belowHeight-startHeight)-pruned)
+		for _, vote := range remaining {
+			require.GreaterOrEqual(t, vote.Height, belowHeight)
+			require.GreaterOrEqual(t, vote.Height, startHeight+uint64(numSubmitted))
+		}
+		// the vote which is not submitted yet is not pruned by the time
+		require.Equal(t, startHeight+numVotes-1, remaining[len(remaining)-1].Height)
+
+		otherVotes, _, err := vs.GetVotes(otherBtcPk, 0, uint32(numVotes))
+		require.NoError(t, err)
+		require.Len(t, otherVotes, 1)
+	})
+}
+
+// FuzzStoreMigrations tests that a new store is created at the latest schema
+// version, and that a store created before the versioning is refused until
+// it is migrated
+func FuzzStoreMigrations(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		homePath := t.TempDir()
+		cfg := config.DefaultDBConfigWithHomePath(homePath)
+
+		fpdb, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			err := fpdb.Close()
+			require.NoError(t, err)
+			err = os.RemoveAll(homePath)
+			require.NoError(t, err)
+		}()
+
+		latest := migration.LatestVersion(fpstore.Migrations())
+		if r.Intn(2) == 0 {
+			// a new store is at the latest version
+			_, err = fpstore.NewFinalityProviderStore(fpdb)
+			require.NoError(t, err)
+			version, err := migration.GetVersion(fpdb)
+			require.NoError(t, err)
+			require.Equal(t, latest, version)
+			return
+		}
+
+		// the store created before the versioning only has the bucket of finality providers
+		err = kvdb.Update(fpdb, func(tx kvdb.RwTx) error {
+			_, err := tx.CreateTopLevelBucket([]byte("finalityProviders"))
+			return err
+		}, func() {})
+		require.NoError(t, err)
+		_, err = fpstore.NewFinalityProviderStore(fpdb)
+		require.ErrorIs(t, err, migration.ErrOutdatedSchema)
+
+		res, err := migration.Run(fpdb, fpstore.Migrations(), migration.BackupPath(cfg.DBPath, cfg.DBFileName))
+		require.NoError(t, err)
+		require.Equal(t, uint32(0), res.FromVersion)
+		require.Equal(t, latest, res.ToVersion)
+		require.Len(t, res.Backups, int(latest))
+
+		vs, err := fpstore.NewFinalityProviderStore(fpdb)
+		require.NoError(t, err)
+		fp := testutil.GenRandomFinalityProvider(r, t)
+		err = vs.CreateFinalityProvider(
+			fp.ChainPk,
+			fp.BtcPk,
+			fp.Description,
+			fp.Commission,
+			fp.MasterPubRand,
+			fp.KeyName,
+			fp.ChainID,
+			fp.Pop.ChainSig,
+			fp.Pop.BtcSig,
+		)
+		require.NoError(t, err)
+		err = vs.SaveVote(fp.BtcPk, 1, testutil.GenRandomByteArray(r, 32), testutil.GenRandomByteArray(r, 64))
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,28 @@
+package store
+
+import (
+	"github.com/lightningnetwork/lnd/kvdb"
+
+	"github.com/babylonchain/finality-provider/migration"
+)
+
+// migrations upgrade the schema of the finality provider store in the order of version,
+// which include the changes of the fields of proto.FinalityProvider
+var migrations = []migration.Migration{
+	{
+		Version:     1,
+		Description: "create the buckets of the voted blocks and the vote journal",
+		Migrate: func(tx kvdb.RwTx) error {
+			if _, err := tx.CreateTopLevelBucket(votedBlocksBucketName); err != nil {
+				return err
+			}
+			_, err := tx.CreateTopLevelBucket(voteJournalBucketName)
+			return err
+		},
+	},
+}
+
+// Migrations returns the migrations of the finality provider store
+func Migrations() []migration.Migration {
+	return migrations
+}
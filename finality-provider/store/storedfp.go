@@ -27,6 +27,8 @@ type StoredFinalityProvider struct {
 	LastVotedHeight     uint64
 	LastProcessedHeight uint64
 	Status              proto.FinalityProviderStatus
+	ErrorReason         string
+	Paused              bool
 }
 
 func protoFpToStoredFinalityProvider(fp *proto.FinalityProvider) (*StoredFinalityProvider, error) {
@@ -62,6 +64,8 @@ func protoFpToStoredFinalityProvider(fp *proto.FinalityProvider) (*StoredFinalit
 		LastVotedHeight:     fp.LastVotedHeight,
 		LastProcessedHeight: fp.LastProcessedHeight,
 		Status:              fp.Status,
+		ErrorReason:         fp.ErrorReason,
+		Paused:              fp.Paused,
 	}, nil
 }
 
@@ -90,5 +94,7 @@ func (sfp *StoredFinalityProvider) ToFinalityProviderInfo() *proto.FinalityProvi
 		Commission:      sfp.Commission.String(),
 		LastVotedHeight: sfp.LastVotedHeight,
 		Status:          sfp.Status.String(),
+		ErrorReason:     sfp.ErrorReason,
+		IsPaused:        sfp.Paused,
 	}
 }
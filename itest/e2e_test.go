@@ -4,6 +4,7 @@
 package e2etest
 
 import (
+	"context"
 	"math/rand"
 	"testing"
 	"time"
@@ -82,14 +83,19 @@ func TestDoubleSigning(t *testing.T) {
 	finalizedBlocks := tm.WaitForNFinalizedBlocks(t, 1)
 
 	// attack: manually submit a finality vote over a conflicting block
-	// to trigger the extraction of finality-provider's private key
+	// the EOTS manager should refuse to sign it
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	b := &types.BlockInfo{
 		Height: finalizedBlocks[0].Height,
 		Hash:   datagen.GenRandomByteArray(r, 32),
 	}
-	_, extractedKey, err := fpIns.TestSubmitFinalitySignatureAndExtractPrivKey(b)
-	require.NoError(t, err)
+	_, _, err = fpIns.TestSubmitFinalitySignatureAndExtractPrivKey(context.Background(), b)
+	require.ErrorContains(t, err, "double signing would leak the EOTS private key")
+
+	t.Logf("the EOTS manager refused to sign a conflicting block")
+
+	// bypass the EOTS manager to trigger the extraction of finality-provider's private key
+	extractedKey := tm.SubmitConflictingFinalitySig(t, fpIns, b)
 	require.NotNil(t, extractedKey)
 	localKey := tm.GetFpPrivKey(t, fpIns.GetBtcPkBIP340().MustMarshal())
 	require.True(t, localKey.Key.Equals(&extractedKey.Key) || localKey.Key.Negate().Equals(&extractedKey.Key))
@@ -99,7 +105,7 @@ func TestDoubleSigning(t *testing.T) {
 	tm.WaitForFpShutDown(t, fpIns.GetBtcPkBIP340())
 
 	// try to start all the finality providers and the slashed one should not be restarted
-	err = tm.Fpa.StartHandlingAll()
+	err = tm.Fpa.StartHandlingAll(context.Background())
 	require.NoError(t, err)
 	fps, err := tm.Fpa.ListAllFinalityProvidersInfo()
 	require.NoError(t, err)
@@ -181,7 +187,7 @@ func TestFastSync(t *testing.T) {
 	t.Logf("the latest finalized block is at %v", finalizedHeight)
 
 	// check if the fast sync works by checking if the gap is not more than 1
-	currentHeaderRes, err := tm.BBNClient.QueryBestBlock()
+	currentHeaderRes, err := tm.BBNClient.QueryBestBlock(context.Background())
 	currentHeight := currentHeaderRes.Height
 	t.Logf("the current block is at %v", currentHeight)
 	require.NoError(t, err)
@@ -0,0 +1,87 @@
+//go:build e2e
+// +build e2e
+
+package e2etest
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/babylonchain/babylon/testutil/datagen"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	fpcc "github.com/babylonchain/finality-provider/clientcontroller"
+	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
+)
+
+// TestOPStackL2ConsumerControllerQueryBlocks tests querying L2 blocks
+// through the OP-stack L2 client controller against a mock L2 node
+func TestOPStackL2ConsumerControllerQueryBlocks(t *testing.T) {
+	tm := StartManager(t)
+	defer tm.Stop(t)
+
+	l2Node := NewOPStackL2NodeHandler(t, 10)
+	l2Node.Start()
+	defer l2Node.Stop()
+	l2Node.FinalizeUntil(5)
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	opCfg := fpcfg.DefaultOPStackL2Config()
+	opCfg.RPCAddress = l2Node.GetRPCAddress()
+	opCfg.FinalityContractAddress = sdk.MustBech32ifyAddressBytes(tm.FpConfig.BabylonConfig.AccountPrefix, datagen.GenRandomByteArray(r, 32))
+	cc, err := fpcc.NewOPStackL2ConsumerController(&opCfg, tm.FpConfig.BabylonConfig, &tm.FpConfig.BTCNetParams, zap.NewNop())
+	require.NoError(t, err)
+	defer func() {
+		err := cc.Close()
+		require.NoError(t, err)
+	}()
+
+	// the best block is the tip of the mock L2 chain
+	bestBlock, err := cc.QueryBestBlock(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(9), bestBlock.Height)
+	require.Equal(t, l2Node.GetHeader(9).Hash().Bytes(), bestBlock.Hash)
+
+	// blocks are finalized up to the finalized height of the L2 node
+	block, err := cc.QueryBlock(context.Background(), 5)
+	require.NoError(t, err)
+	require.Equal(t, l2Node.GetHeader(5).Hash().Bytes(), block.Hash)
+	require.True(t, block.Finalized)
+	block, err = cc.QueryBlock(context.Background(), 6)
+	require.NoError(t, err)
+	require.False(t, block.Finalized)
+
+	// the range query is capped by the limit
+	blocks, err := cc.QueryBlocks(context.Background(), 2, 8, 3)
+	require.NoError(t, err)
+	require.Len(t, blocks, 3)
+	for i, b := range blocks {
+		require.Equal(t, uint64(2+i), b.Height)
+		require.Equal(t, l2Node.GetHeader(b.Height).Hash().Bytes(), b.Hash)
+	}
+
+	// the latest finalized blocks are returned in descending order
+	finalizedBlocks, err := cc.QueryLatestFinalizedBlocks(context.Background(), 2)
+	require.NoError(t, err)
+	require.Len(t, finalizedBlocks, 2)
+	require.Equal(t, uint64(5), finalizedBlocks[0].Height)
+	require.Equal(t, uint64(4), finalizedBlocks[1].Height)
+
+	// new blocks are picked up by the client controller
+	l2Node.ProduceBlocks(5)
+	l2Node.FinalizeUntil(12)
+	bestBlock, err = cc.QueryBestBlock(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(14), bestBlock.Height)
+	finalizedBlocks, err = cc.QueryLatestFinalizedBlocks(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), finalizedBlocks[0].Height)
+
+	// querying a block beyond the tip fails
+	_, err = cc.QueryBlock(context.Background(), 100)
+	require.Error(t, err)
+}
@@ -0,0 +1,187 @@
+package e2etest
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	jsonRPCVersion        = "2.0"
+	jsonRPCMethodNotFound = -32601
+	jsonRPCInvalidParams  = -32602
+)
+
+type jsonRPCRequest struct {
+	JSONRPC string            `json:"jsonrpc"`
+	ID      json.RawMessage   `json:"id"`
+	Method  string            `json:"method"`
+	Params  []json.RawMessage `json:"params"`
+}
+
+type jsonRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type jsonRPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result"`
+	Error   *jsonRPCError   `json:"error,omitempty"`
+}
+
+// OPStackL2NodeHandler runs a mock OP-stack L2 node which serves
+// the Ethereum JSON-RPC methods queried by the OP-stack L2 client controller
+type OPStackL2NodeHandler struct {
+	t      *testing.T
+	server *httptest.Server
+
+	mu              sync.Mutex
+	headers         []*ethtypes.Header
+	finalizedHeight uint64
+}
+
+// NewOPStackL2NodeHandler creates a mock L2 node with a chain of numBlocks blocks
+// starting from the genesis block, of which only the genesis block is finalized
+func NewOPStackL2NodeHandler(t *testing.T, numBlocks int) *OPStackL2NodeHandler {
+	require.Greater(t, numBlocks, 0)
+
+	h := &OPStackL2NodeHandler{t: t}
+	h.headers = append(h.headers, newL2Header(nil))
+	h.ProduceBlocks(numBlocks - 1)
+
+	return h
+}
+
+func newL2Header(parent *ethtypes.Header) *ethtypes.Header {
+	header := &ethtypes.Header{
+		Difficulty: big.NewInt(0),
+		Number:     big.NewInt(0),
+		GasLimit:   30_000_000,
+		Time:       uint64(time.Now().Unix()),
+	}
+	if parent != nil {
+		header.ParentHash = parent.Hash()
+		header.Number = new(big.Int).Add(parent.Number, big.NewInt(1))
+	}
+
+	return header
+}
+
+func (h *OPStackL2NodeHandler) Start() {
+	h.server = httptest.NewServer(http.HandlerFunc(h.handleRequest))
+}
+
+func (h *OPStackL2NodeHandler) Stop() {
+	h.server.Close()
+}
+
+func (h *OPStackL2NodeHandler) GetRPCAddress() string {
+	return h.server.URL
+}
+
+// ProduceBlocks appends n blocks to the tip of the mock L2 chain
+func (h *OPStackL2NodeHandler) ProduceBlocks(n int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for i := 0; i < n; i++ {
+		h.headers = append(h.headers, newL2Header(h.headers[len(h.headers)-1]))
+	}
+}
+
+// FinalizeUntil marks the blocks up to the given height as finalized
+func (h *OPStackL2NodeHandler) FinalizeUntil(height uint64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	require.Less(h.t, height, uint64(len(h.headers)))
+	h.finalizedHeight = height
+}
+
+// GetHeader returns the header of the mock L2 block at the given height
+func (h *OPStackL2NodeHandler) GetHeader(height uint64) *ethtypes.Header {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	require.Less(h.t, height, uint64(len(h.headers)))
+	return h.headers[height]
+}
+
+func (h *OPStackL2NodeHandler) handleRequest(w http.ResponseWriter, r *http.Request) {
+	var req jsonRPCRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res := jsonRPCResponse{
+		JSONRPC: jsonRPCVersion,
+		ID:      req.ID,
+	}
+	switch req.Method {
+	case "eth_blockNumber":
+		h.mu.Lock()
+		res.Result = hexutil.Uint64(len(h.headers) - 1)
+		h.mu.Unlock()
+	case "eth_getBlockByNumber":
+		header, err := h.getHeaderByNumberArg(req.Params)
+		if err != nil {
+			res.Error = &jsonRPCError{Code: jsonRPCInvalidParams, Message: err.Error()}
+			break
+		}
+		// a missing block is represented by a null result
+		if header != nil {
+			res.Result = header
+		}
+	default:
+		res.Error = &jsonRPCError{Code: jsonRPCMethodNotFound, Message: "the method " + req.Method + " does not exist"}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(res)
+	require.NoError(h.t, err)
+}
+
+func (h *OPStackL2NodeHandler) getHeaderByNumberArg(params []json.RawMessage) (*ethtypes.Header, error) {
+	var numberArg string
+	if len(params) > 0 {
+		if err := json.Unmarshal(params[0], &numberArg); err != nil {
+			return nil, err
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var height uint64
+	switch numberArg {
+	case "latest", "pending", "":
+		height = uint64(len(h.headers) - 1)
+	case "finalized", "safe":
+		height = h.finalizedHeight
+	case "earliest":
+		height = 0
+	default:
+		n, err := hexutil.DecodeUint64(numberArg)
+		if err != nil {
+			return nil, err
+		}
+		height = n
+	}
+
+	if height >= uint64(len(h.headers)) {
+		return nil, nil
+	}
+
+	return h.headers[height], nil
+}
@@ -1,6 +1,7 @@
 package e2etest
 
 import (
+	"context"
 	"encoding/hex"
 	"math/rand"
 	"os"
@@ -14,6 +15,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/babylonchain/babylon/btcstaking"
 	txformat "github.com/babylonchain/babylon/btctxformatter"
+	"github.com/babylonchain/babylon/crypto/eots"
 	asig "github.com/babylonchain/babylon/crypto/schnorr-adaptor-signature"
 	"github.com/babylonchain/babylon/testutil/datagen"
 	bbntypes "github.com/babylonchain/babylon/types"
@@ -21,6 +23,7 @@ import (
 	btclctypes "github.com/babylonchain/babylon/x/btclightclient/types"
 	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
 	ckpttypes "github.com/babylonchain/babylon/x/checkpointing/types"
+	ftypes "github.com/babylonchain/babylon/x/finality/types"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -29,6 +32,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdkquerytypes "github.com/cosmos/cosmos-sdk/types/query"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/gogo/protobuf/jsonpb"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
@@ -37,6 +41,7 @@ import (
 	eotsconfig "github.com/babylonchain/finality-provider/eotsmanager/config"
 	fpcfg "github.com/babylonchain/finality-provider/finality-provider/config"
 	"github.com/babylonchain/finality-provider/finality-provider/service"
+	fpkr "github.com/babylonchain/finality-provider/keyring"
 	"github.com/babylonchain/finality-provider/types"
 )
 
@@ -109,7 +114,7 @@ func StartManager(t *testing.T) *TestManager {
 	eotsCfg := eotsconfig.DefaultConfigWithHomePath(eotsHomeDir)
 	eh := NewEOTSServerHandler(t, eotsCfg, eotsHomeDir)
 	eh.Start()
-	eotsCli, err := client.NewEOTSManagerGRpcClient(cfg.EOTSManagerAddress)
+	eotsCli, err := client.NewEOTSManagerGRpcClient(cfg.EOTSManagerAddress, cfg.EOTSManagerAuth)
 	require.NoError(t, err)
 
 	// 4. prepare finality-provider
@@ -171,7 +176,7 @@ func StartManagerWithFinalityProvider(t *testing.T, n int) (*TestManager, []*ser
 		fpPk, err := bbntypes.NewBIP340PubKeyFromHex(res.FpInfo.BtcPkHex)
 		require.NoError(t, err)
 		fpPKs = append(fpPKs, fpPk)
-		resp, err := app.RegisterFinalityProvider(fpPk.MarshalHex())
+		resp, err := app.RegisterFinalityProvider(context.Background(), fpPk.MarshalHex())
 		require.NoError(t, err)
 		registeredEpoch = resp.RegisteredEpoch // last registered epoch
 	}
@@ -181,7 +186,7 @@ func StartManagerWithFinalityProvider(t *testing.T, n int) (*TestManager, []*ser
 
 	for i := 0; i < n; i++ {
 		// start
-		err := app.StartHandlingFinalityProvider(fpPKs[i], passphrase)
+		err := app.StartHandlingFinalityProvider(context.Background(), fpPKs[i], passphrase)
 		require.NoError(t, err)
 		fpIns, err := app.GetFinalityProviderInstance(fpPKs[i])
 		require.NoError(t, err)
@@ -303,7 +308,7 @@ func generateCovenantCommittee(numCovenants int, t *testing.T) ([]*btcec.Private
 func (tm *TestManager) CheckBlockFinalization(t *testing.T, height uint64, num int) {
 	// we need to ensure votes are collected at the given height
 	require.Eventually(t, func() bool {
-		votes, err := tm.BBNClient.QueryVotesAtHeight(height)
+		votes, err := tm.BBNClient.QueryVotesAtHeight(context.Background(), height)
 		if err != nil {
 			t.Logf("failed to get the votes at height %v: %s", height, err.Error())
 			return false
@@ -313,7 +318,7 @@ func (tm *TestManager) CheckBlockFinalization(t *testing.T, height uint64, num i
 
 	// as the votes have been collected, the block should be finalized
 	require.Eventually(t, func() bool {
-		b, err := tm.BBNClient.QueryBlock(height)
+		b, err := tm.BBNClient.QueryBlock(context.Background(), height)
 		if err != nil {
 			t.Logf("failed to query block at height %v: %s", height, err.Error())
 			return false
@@ -342,7 +347,7 @@ func (tm *TestManager) WaitForNFinalizedBlocks(t *testing.T, n int) []*types.Blo
 		err    error
 	)
 	require.Eventually(t, func() bool {
-		blocks, err = tm.BBNClient.QueryLatestFinalizedBlocks(uint64(n))
+		blocks, err = tm.BBNClient.QueryLatestFinalizedBlocks(context.Background(), uint64(n))
 		if err != nil {
 			t.Logf("failed to get the latest finalized block: %s", err.Error())
 			return false
@@ -365,13 +370,13 @@ func (tm *TestManager) WaitForFpShutDown(t *testing.T, pk *bbntypes.BIP340PubKey
 }
 
 func (tm *TestManager) StopAndRestartFpAfterNBlocks(t *testing.T, n int, fpIns *service.FinalityProviderInstance) {
-	blockBeforeStop, err := tm.BBNClient.QueryBestBlock()
+	blockBeforeStop, err := tm.BBNClient.QueryBestBlock(context.Background())
 	require.NoError(t, err)
 	err = fpIns.Stop()
 	require.NoError(t, err)
 
 	require.Eventually(t, func() bool {
-		headerAfterStop, err := tm.BBNClient.QueryBestBlock()
+		headerAfterStop, err := tm.BBNClient.QueryBestBlock(context.Background())
 		if err != nil {
 			return false
 		}
@@ -392,6 +397,41 @@ func (tm *TestManager) GetFpPrivKey(t *testing.T, fpPk []byte) *btcec.PrivateKey
 	return record.PrivKey
 }
 
+// SubmitConflictingFinalitySig signs a finality vote over the given block directly with the
+// finality provider's private key, bypassing the double-sign protection of the EOTS manager,
+// and returns the private key extracted from the slashing evidence
+func (tm *TestManager) SubmitConflictingFinalitySig(t *testing.T, fpIns *service.FinalityProviderInstance, b *types.BlockInfo) *btcec.PrivateKey {
+	privKey := tm.GetFpPrivKey(t, fpIns.GetBtcPkBIP340().MustMarshal())
+	msr, _, err := fpkr.GenerateMasterRandPair(privKey.Serialize(), fpIns.GetChainID())
+	require.NoError(t, err)
+	sr, _, err := msr.DeriveRandPair(uint32(b.Height))
+	require.NoError(t, err)
+
+	msg := &ftypes.MsgAddFinalitySig{
+		FpBtcPk:      fpIns.GetBtcPkBIP340(),
+		BlockHeight:  b.Height,
+		BlockAppHash: b.Hash,
+	}
+	sig, err := eots.Sign(privKey, sr, msg.MsgToSign())
+	require.NoError(t, err)
+
+	res, err := tm.BBNClient.SubmitFinalitySig(context.Background(), fpIns.GetBtcPk(), b.Height, b.Hash, sig)
+	require.NoError(t, err)
+
+	for _, ev := range res.Events {
+		if strings.Contains(ev.EventType, "EventSlashedFinalityProvider") {
+			var evidence ftypes.Evidence
+			err := jsonpb.UnmarshalString(ev.Attributes["evidence"], &evidence)
+			require.NoError(t, err)
+			extractedKey, err := evidence.ExtractBTCSK()
+			require.NoError(t, err)
+			return extractedKey
+		}
+	}
+
+	return nil
+}
+
 func (tm *TestManager) InsertCovenantSigForDelegation(t *testing.T, btcDel *bstypes.BTCDelegation) {
 	slashingTx := btcDel.SlashingTx
 	stakingTx := btcDel.StakingTx
@@ -467,6 +507,7 @@ func (tm *TestManager) InsertCovenantSigForDelegation(t *testing.T, btcDel *bsty
 	require.NoError(t, err)
 
 	_, err = tm.BBNClient.SubmitCovenantSigs(
+		context.Background(),
 		tm.CovenantPrivKeys[0].PubKey(),
 		stakingMsgTx.TxHash().String(),
 		[][]byte{covenantAdaptorStakingSlashing1.MustMarshal()},
@@ -504,6 +545,7 @@ func (tm *TestManager) InsertCovenantSigForDelegation(t *testing.T, btcDel *bsty
 
 	require.NoError(t, err)
 	_, err = tm.BBNClient.SubmitCovenantSigs(
+		context.Background(),
 		tm.CovenantPrivKeys[1].PubKey(),
 		stakingMsgTx.TxHash().String(),
 		[][]byte{covenantAdaptorStakingSlashing2.MustMarshal()},
@@ -566,7 +608,7 @@ func (tm *TestManager) InsertBTCDelegation(t *testing.T, fpPks []*btcec.PublicKe
 		headers = append(headers, *headerInfo.Header)
 		parentBlockHeaderInfo = headerInfo
 	}
-	_, err = tm.BBNClient.InsertBtcBlockHeaders(headers)
+	_, err = tm.BBNClient.InsertBtcBlockHeaders(context.Background(), headers)
 	require.NoError(t, err)
 	btcHeader := blockWithStakingTx.HeaderBytes
 	serializedStakingTx, err := bbntypes.SerializeBTCTx(testStakingInfo.StakingTx)
@@ -622,6 +664,7 @@ func (tm *TestManager) InsertBTCDelegation(t *testing.T, fpPks []*btcec.PublicKe
 
 	// submit the BTC delegation to Babylon
 	_, err = tm.BBNClient.CreateBTCDelegation(
+		context.Background(),
 		delBabylonPubKey.(*secp256k1.PubKey),
 		bbntypes.NewBIP340PubKeyFromBTCPK(delBtcPubKey),
 		fpPks,
@@ -781,7 +824,7 @@ func (tm *TestManager) InsertWBTCHeaders(t *testing.T, r *rand.Rand) {
 		Height: btcTipResp.Height,
 		Work:   &btcTipResp.Work,
 	}, uint32(params.FinalizationTimeoutBlocks))
-	_, err = tm.BBNClient.InsertBtcBlockHeaders(kHeaders.ChainToBytes())
+	_, err = tm.BBNClient.InsertBtcBlockHeaders(context.Background(), kHeaders.ChainToBytes())
 	require.NoError(t, err)
 }
 
@@ -841,13 +884,13 @@ func (tm *TestManager) FinalizeUntilEpoch(t *testing.T, epoch uint64) {
 		opReturn2 := datagen.CreateBlockWithTransaction(r, opReturn1.HeaderBytes.ToBlockHeader(), tx2)
 
 		// insert headers and proofs
-		_, err = tm.BBNClient.InsertBtcBlockHeaders([]bbntypes.BTCHeaderBytes{
+		_, err = tm.BBNClient.InsertBtcBlockHeaders(context.Background(), []bbntypes.BTCHeaderBytes{
 			opReturn1.HeaderBytes,
 			opReturn2.HeaderBytes,
 		})
 		require.NoError(t, err)
 
-		_, err = tm.BBNClient.InsertSpvProofs(submitter.String(), []*btcctypes.BTCSpvProof{
+		_, err = tm.BBNClient.InsertSpvProofs(context.Background(), submitter.String(), []*btcctypes.BTCSpvProof{
 			opReturn1.SpvProof,
 			opReturn2.SpvProof,
 		})
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"strings"
 
+	bbntypes "github.com/babylonchain/babylon/types"
 	bstypes "github.com/babylonchain/babylon/x/btcstaking/types"
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdksecp256k1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -130,6 +132,36 @@ func (kc *ChainKeyringController) CreatePop(btcPrivKey *btcec.PrivateKey, passph
 	return bstypes.NewPoP(bbnPrivKey, btcPrivKey)
 }
 
+// CreatePopWithSigner creates proof-of-possession of Babylon and BTC public keys,
+// where the BTC signature over the Babylon signature is produced by signBtc,
+// e.g., by the EOTS manager, so that the BTC private key is not needed
+func (kc *ChainKeyringController) CreatePopWithSigner(
+	btcPk *bbntypes.BIP340PubKey,
+	passphrase string,
+	signBtc func(babylonSig []byte) (*schnorr.Signature, error),
+) (*bstypes.ProofOfPossession, error) {
+	bbnPrivKey, err := kc.GetChainPrivKey(passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	babylonSig, err := bbnPrivKey.Sign(btcPk.MustMarshal())
+	if err != nil {
+		return nil, err
+	}
+
+	btcSig, err := signBtc(babylonSig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bstypes.ProofOfPossession{
+		BtcSigType: bstypes.BTCSigType_BIP340,
+		BabylonSig: babylonSig,
+		BtcSig:     bbntypes.NewBIP340SignatureFromBTCSig(btcSig).MustMarshal(),
+	}, nil
+}
+
 func (kc *ChainKeyringController) GetChainPrivKey(passphrase string) (*sdksecp256k1.PrivKey, error) {
 	kc.input.Reset(passphrase)
 	k, err := kc.kr.Key(kc.fpName)
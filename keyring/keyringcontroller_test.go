@@ -9,6 +9,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/babylonchain/babylon/types"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -64,5 +65,14 @@ func FuzzCreatePoP(f *testing.F) {
 		require.NoError(t, err)
 		err = pop.Verify(bbnPk, btcPk, &chaincfg.SimNetParams)
 		require.NoError(t, err)
+
+		// the PoP signed by the EOTS manager is the same
+		popFromEOTSManager, err := kc.CreatePopWithSigner(btcPk, passphrase, func(babylonSig []byte) (*schnorr.Signature, error) {
+			return em.SignPop(btcPk.MustMarshal(), babylonSig, passphrase)
+		})
+		require.NoError(t, err)
+		err = popFromEOTSManager.Verify(bbnPk, btcPk, &chaincfg.SimNetParams)
+		require.NoError(t, err)
+		require.Equal(t, pop, popFromEOTSManager)
 	})
 }
@@ -5,11 +5,25 @@ import (
 	"crypto/sha256"
 
 	"github.com/babylonchain/babylon/crypto/eots"
+
+	eotstypes "github.com/babylonchain/finality-provider/eotsmanager/types"
 )
 
 // GenerateMasterRandPair generates pair of master secret/public randomness
 // The result is deterministic with each given input
 func GenerateMasterRandPair(key []byte, chainID []byte) (*eots.MasterSecretRand, *eots.MasterPublicRand, error) {
+	// convert the hash into private random
+	return eots.NewMasterRandPairFromSeed(masterRandSeed(key, chainID))
+}
+
+// GenerateMasterRandPairV2 generates pair of v2 master secret/public randomness,
+// which derives the randomness at 64-bit heights
+// The result is deterministic with each given input
+func GenerateMasterRandPairV2(key []byte, chainID []byte) (*eotstypes.MasterSecretRandV2, *eotstypes.MasterPublicRandV2, error) {
+	return eotstypes.NewMasterRandPairV2FromSeed(masterRandSeed(key, chainID))
+}
+
+func masterRandSeed(key []byte, chainID []byte) [32]byte {
 	// calculate the random hash of the key concatenated with chainID and height
 	hasher := hmac.New(sha256.New, key)
 	hasher.Write(chainID)
@@ -19,6 +33,5 @@ func GenerateMasterRandPair(key []byte, chainID []byte) (*eots.MasterSecretRand,
 	var seed [32]byte
 	copy(seed[:], seedSlice[:32])
 
-	// convert the hash into private random
-	return eots.NewMasterRandPairFromSeed(seed)
+	return seed
 }
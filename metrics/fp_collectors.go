@@ -17,6 +17,15 @@ type FpMetrics struct {
 	babylonTipHeight     prometheus.Gauge
 	lastPolledHeight     prometheus.Gauge
 	pollerStartingHeight prometheus.Gauge
+	chainReorgs          prometheus.Counter
+	// account metrics
+	signerBalance prometheus.Gauge
+	gasPrice      prometheus.Gauge
+	// submission aggregator metrics
+	finalitySigBatchSize prometheus.Histogram
+	// retry metrics
+	retries       *prometheus.CounterVec
+	failedRetries *prometheus.CounterVec
 	// single finality provider metrics
 	fpStatus                        *prometheus.GaugeVec
 	fpSecondsSinceLastVote          *prometheus.GaugeVec
@@ -29,6 +38,11 @@ type FpMetrics struct {
 	fpTotalCommittedRandomness      *prometheus.GaugeVec
 	fpTotalFailedVotes              *prometheus.CounterVec
 	fpTotalFailedRandomness         *prometheus.CounterVec
+	fpTotalConflictingBlocks        *prometheus.CounterVec
+	fpUptime                        *prometheus.GaugeVec
+	fpMissedHeights                 *prometheus.GaugeVec
+	fpTotalUnconfirmedVotes         *prometheus.CounterVec
+	fpTotalResubmittedVotes         *prometheus.CounterVec
 	// time keeper
 	mu                     sync.Mutex
 	previousVoteByFp       map[string]*time.Time
@@ -65,6 +79,37 @@ func NewFpMetrics() *FpMetrics {
 				Name: "poller_starting_height",
 				Help: "The initial block height when the poller started operation",
 			}),
+			chainReorgs: prometheus.NewCounter(prometheus.CounterOpts{
+				Name: "total_chain_reorgs",
+				Help: "The total number of chain reorganisations detected by the poller",
+			}),
+			signerBalance: prometheus.NewGauge(prometheus.GaugeOpts{
+				Name: "signer_balance",
+				Help: "The balance of the account paying for the transactions in the fee denom",
+			}),
+			gasPrice: prometheus.NewGauge(prometheus.GaugeOpts{
+				Name: "gas_price",
+				Help: "The gas price of the transactions in the fee denom",
+			}),
+			finalitySigBatchSize: prometheus.NewHistogram(prometheus.HistogramOpts{
+				Name:    "finality_sig_batch_size",
+				Help:    "The number of finality signatures submitted in a single transaction by the submission aggregator",
+				Buckets: prometheus.ExponentialBuckets(1, 2, 8),
+			}),
+			retries: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Name: "total_retries",
+					Help: "The total number of requests retried after a failed attempt, by the retry policy",
+				},
+				[]string{"policy"},
+			),
+			failedRetries: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Name: "total_failed_retries",
+					Help: "The total number of requests failing after all the attempts of the retry policy",
+				},
+				[]string{"policy"},
+			),
 			fpSecondsSinceLastVote: prometheus.NewGaugeVec(
 				prometheus.GaugeOpts{
 					Name: "fp_seconds_since_last_vote",
@@ -135,6 +180,41 @@ func NewFpMetrics() *FpMetrics {
 				},
 				[]string{"fp_btc_pk_hex"},
 			),
+			fpTotalConflictingBlocks: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Name: "fp_total_conflicting_blocks",
+					Help: "The total number of blocks not signed by a finality provider as a different block has been signed at the height.",
+				},
+				[]string{"fp_btc_pk_hex"},
+			),
+			fpUptime: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Name: "fp_uptime_ratio",
+					Help: "The ratio of the heights with a recorded vote of a finality provider to the heights where it has voting power within the liveness window.",
+				},
+				[]string{"fp_btc_pk_hex"},
+			),
+			fpMissedHeights: prometheus.NewGaugeVec(
+				prometheus.GaugeOpts{
+					Name: "fp_missed_heights",
+					Help: "The number of heights where a finality provider has voting power but no recorded vote within the liveness window.",
+				},
+				[]string{"fp_btc_pk_hex"},
+			),
+			fpTotalUnconfirmedVotes: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Name: "fp_total_unconfirmed_votes",
+					Help: "The total number of included votes of a finality provider which are not recorded by the consumer chain.",
+				},
+				[]string{"fp_btc_pk_hex"},
+			),
+			fpTotalResubmittedVotes: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Name: "fp_total_resubmitted_votes",
+					Help: "The total number of unconfirmed votes resubmitted by a finality provider.",
+				},
+				[]string{"fp_btc_pk_hex"},
+			),
 			mu: sync.Mutex{},
 		}
 
@@ -144,6 +224,12 @@ func NewFpMetrics() *FpMetrics {
 		prometheus.MustRegister(fpMetricsInstance.babylonTipHeight)
 		prometheus.MustRegister(fpMetricsInstance.lastPolledHeight)
 		prometheus.MustRegister(fpMetricsInstance.pollerStartingHeight)
+		prometheus.MustRegister(fpMetricsInstance.chainReorgs)
+		prometheus.MustRegister(fpMetricsInstance.signerBalance)
+		prometheus.MustRegister(fpMetricsInstance.gasPrice)
+		prometheus.MustRegister(fpMetricsInstance.finalitySigBatchSize)
+		prometheus.MustRegister(fpMetricsInstance.retries)
+		prometheus.MustRegister(fpMetricsInstance.failedRetries)
 		prometheus.MustRegister(fpMetricsInstance.fpSecondsSinceLastVote)
 		prometheus.MustRegister(fpMetricsInstance.fpSecondsSinceLastRandomness)
 		prometheus.MustRegister(fpMetricsInstance.fpLastVotedHeight)
@@ -154,6 +240,11 @@ func NewFpMetrics() *FpMetrics {
 		prometheus.MustRegister(fpMetricsInstance.fpLastCommittedRandomnessHeight)
 		prometheus.MustRegister(fpMetricsInstance.fpTotalFailedVotes)
 		prometheus.MustRegister(fpMetricsInstance.fpTotalFailedRandomness)
+		prometheus.MustRegister(fpMetricsInstance.fpTotalConflictingBlocks)
+		prometheus.MustRegister(fpMetricsInstance.fpUptime)
+		prometheus.MustRegister(fpMetricsInstance.fpMissedHeights)
+		prometheus.MustRegister(fpMetricsInstance.fpTotalUnconfirmedVotes)
+		prometheus.MustRegister(fpMetricsInstance.fpTotalResubmittedVotes)
 	})
 	return fpMetricsInstance
 }
@@ -188,6 +279,36 @@ func (fm *FpMetrics) RecordPollerStartingHeight(height uint64) {
 	fm.pollerStartingHeight.Set(float64(height))
 }
 
+// IncrementChainReorgs increments the number of chain reorganisations detected by the poller
+func (fm *FpMetrics) IncrementChainReorgs() {
+	fm.chainReorgs.Inc()
+}
+
+// RecordSignerBalance records the balance of the account paying for the transactions
+func (fm *FpMetrics) RecordSignerBalance(balance float64) {
+	fm.signerBalance.Set(balance)
+}
+
+// RecordGasPrice records the gas price of the transactions
+func (fm *FpMetrics) RecordGasPrice(price float64) {
+	fm.gasPrice.Set(price)
+}
+
+// RecordFinalitySigBatchSize records the number of finality signatures submitted in a single transaction
+func (fm *FpMetrics) RecordFinalitySigBatchSize(size int) {
+	fm.finalitySigBatchSize.Observe(float64(size))
+}
+
+// IncrementRetries increments the number of requests retried under the retry policy
+func (fm *FpMetrics) IncrementRetries(policy string) {
+	fm.retries.WithLabelValues(policy).Inc()
+}
+
+// IncrementFailedRetries increments the number of requests failing after all the attempts of the retry policy
+func (fm *FpMetrics) IncrementFailedRetries(policy string) {
+	fm.failedRetries.WithLabelValues(policy).Inc()
+}
+
 // RecordFpSecondsSinceLastVote records the seconds since the last finality sig vote by a finality provider
 func (fm *FpMetrics) RecordFpSecondsSinceLastVote(fpBtcPkHex string, seconds float64) {
 	fm.fpSecondsSinceLastVote.WithLabelValues(fpBtcPkHex).Set(seconds)
@@ -208,6 +329,32 @@ func (fm *FpMetrics) RecordFpLastProcessedHeight(fpBtcPkHex string, height uint6
 	fm.fpLastProcessedHeight.WithLabelValues(fpBtcPkHex).Set(float64(height))
 }
 
+// RecordFpLiveness records the uptime ratio and the number of missed heights
+// of a finality provider within the liveness window
+func (fm *FpMetrics) RecordFpLiveness(fpBtcPkHex string, uptime float64, missedHeights int) {
+	fm.fpUptime.WithLabelValues(fpBtcPkHex).Set(uptime)
+	fm.fpMissedHeights.WithLabelValues(fpBtcPkHex).Set(float64(missedHeights))
+}
+
+// RemoveFpLiveness removes the liveness metrics of a finality provider
+// which is no longer tracked
+func (fm *FpMetrics) RemoveFpLiveness(fpBtcPkHex string) {
+	fm.fpUptime.DeleteLabelValues(fpBtcPkHex)
+	fm.fpMissedHeights.DeleteLabelValues(fpBtcPkHex)
+}
+
+// IncrementFpTotalUnconfirmedVotes increments the total number of
+// included votes of a finality provider which are not recorded
+func (fm *FpMetrics) IncrementFpTotalUnconfirmedVotes(fpBtcPkHex string) {
+	fm.fpTotalUnconfirmedVotes.WithLabelValues(fpBtcPkHex).Inc()
+}
+
+// IncrementFpTotalResubmittedVotes increments the total number of
+// unconfirmed votes resubmitted by a finality provider
+func (fm *FpMetrics) IncrementFpTotalResubmittedVotes(fpBtcPkHex string) {
+	fm.fpTotalResubmittedVotes.WithLabelValues(fpBtcPkHex).Inc()
+}
+
 // RecordFpLastCommittedRandomnessHeight record the last height at which a finality provider committed randomness
 func (fm *FpMetrics) RecordFpLastCommittedRandomnessHeight(fpBtcPkHex string, height uint64) {
 	fm.fpLastCommittedRandomnessHeight.WithLabelValues(fpBtcPkHex).Set(float64(height))
@@ -243,6 +390,12 @@ func (fm *FpMetrics) IncrementFpTotalFailedRandomness(fpBtcPkHex string) {
 	fm.fpTotalFailedRandomness.WithLabelValues(fpBtcPkHex).Inc()
 }
 
+// IncrementFpTotalConflictingBlocks increments the number of blocks not signed by a finality
+// provider as a different block has been signed at the height
+func (fm *FpMetrics) IncrementFpTotalConflictingBlocks(fpBtcPkHex string) {
+	fm.fpTotalConflictingBlocks.WithLabelValues(fpBtcPkHex).Inc()
+}
+
 // RecordFpVoteTime records the time of a finality sig vote by a finality provider
 func (fm *FpMetrics) RecordFpVoteTime(fpBtcPkHex string) {
 	fm.mu.Lock()
@@ -0,0 +1,272 @@
+package migration
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// mapping key -> value of the metadata of the store
+	metadataBucketName = []byte("metadata")
+
+	// schemaVersionKey is the key of the schema version in the metadata bucket
+	schemaVersionKey = []byte("schemaVersion")
+)
+
+var (
+	// ErrOutdatedSchema The schema of the store is older than the latest version
+	ErrOutdatedSchema = errors.New("the schema of the db is outdated")
+
+	// ErrUnknownSchema The schema of the store is newer than the latest version,
+	// e.g., the db has been migrated by a newer release
+	ErrUnknownSchema = errors.New("the schema of the db is unknown")
+
+	// errDryRun rolls back the transaction of a dry run
+	errDryRun = errors.New("dry run")
+)
+
+// Migration upgrades the schema of a store from Version-1 to Version
+// a migration should be appended to the migrations of the store once the
+// layout of its stored data is changed, e.g., a bucket is added or the key
+// or the value of a bucket is changed
+type Migration struct {
+	Version     uint32
+	Description string
+	Migrate     func(tx kvdb.RwTx) error
+}
+
+// Result is the result of running the pending migrations of a store
+type Result struct {
+	FromVersion uint32   `json:"from_version"`
+	ToVersion   uint32   `json:"to_version"`
+	Applied     []string `json:"applied"`
+	// Backups are the paths of the copies of the db taken before each migration
+	Backups []string `json:"backups"`
+	DryRun  bool     `json:"dry_run"`
+}
+
+// BackupPath returns the function naming the copies of the db file taken before each
+// migration, which are put next to the db file. The backups are named after the version
+// being migrated from and the time of the migration, so that the backups of a db which
+// is migrated again, e.g., after it is restored from a backup, are not overwritten
+func BackupPath(dbPath, dbFileName string) func(version uint32) string {
+	return func(version uint32) string {
+		fileName := fmt.Sprintf("%s.v%d.%s.backup", dbFileName, version, time.Now().UTC().Format("20060102T150405Z"))
+		return filepath.Join(dbPath, fileName)
+	}
+}
+
+// LatestVersion returns the schema version after all the given migrations,
+// which should be ordered by version starting from 1
+func LatestVersion(migrations []Migration) uint32 {
+	return uint32(len(migrations))
+}
+
+// GetVersion returns the schema version of the db, which is
+// 0 if the db has been created before the versioning
+func GetVersion(db kvdb.Backend) (uint32, error) {
+	var version uint32
+	err := kvdb.View(db, func(tx kvdb.RTx) error {
+		v, err := getVersion(tx)
+		if err != nil {
+			return err
+		}
+		version = v
+		return nil
+	}, func() {
+		version = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func getVersion(tx kvdb.RTx) (uint32, error) {
+	metadataBucket := tx.ReadBucket(metadataBucketName)
+	if metadataBucket == nil {
+		return 0, nil
+	}
+
+	v := metadataBucket.Get(schemaVersionKey)
+	if v == nil {
+		return 0, nil
+	}
+	if len(v) != 4 {
+		return 0, fmt.Errorf("invalid schema version %x", v)
+	}
+
+	return binary.BigEndian.Uint32(v), nil
+}
+
+// SetVersion sets the schema version of the db within the transaction,
+// e.g., to the latest version once the buckets of a new db are created
+func SetVersion(tx kvdb.RwTx, version uint32) error {
+	metadataBucket, err := tx.CreateTopLevelBucket(metadataBucketName)
+	if err != nil {
+		return err
+	}
+
+	return metadataBucket.Put(schemaVersionKey, binary.BigEndian.AppendUint32(nil, version))
+}
+
+// CheckVersion returns ErrOutdatedSchema if the db needs to be migrated,
+// or ErrUnknownSchema if the db has been migrated beyond the given migrations
+func CheckVersion(db kvdb.Backend, migrations []Migration) error {
+	version, err := GetVersion(db)
+	if err != nil {
+		return err
+	}
+
+	latest := LatestVersion(migrations)
+	switch {
+	case version < latest:
+		return fmt.Errorf("%w: version %d, latest version %d", ErrOutdatedSchema, version, latest)
+	case version > latest:
+		return fmt.Errorf("%w: version %d, latest version %d", ErrUnknownSchema, version, latest)
+	default:
+		return nil
+	}
+}
+
+// Run applies the pending migrations in order. Each migration is applied in its own
+// transaction along with the update of the schema version, so that a failed migration
+// leaves the db at the previous version. Before each migration, the db is copied to the
+// file returned by backupPath for the version being migrated from, unless the db has
+// no buckets, e.g., it is just created, in which case there is nothing to back up
+func Run(db kvdb.Backend, migrations []Migration, backupPath func(version uint32) string) (*Result, error) {
+	result, pending, err := plan(db, migrations)
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) == 0 {
+		return result, nil
+	}
+
+	empty, err := isEmpty(db)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range pending {
+		if !empty {
+			path := backupPath(m.Version - 1)
+			if err := backup(db, path); err != nil {
+				return result, fmt.Errorf("failed to back up the db before migration %d: %w", m.Version, err)
+			}
+			result.Backups = append(result.Backups, path)
+		}
+
+		if err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+			return migrate(tx, m)
+		}, func() {}); err != nil {
+			return result, err
+		}
+		result.ToVersion = m.Version
+		result.Applied = append(result.Applied, m.Description)
+	}
+
+	return result, nil
+}
+
+// DryRun applies the pending migrations in a transaction which is rolled back,
+// so that the failing migrations are found without changing the db
+func DryRun(db kvdb.Backend, migrations []Migration) (*Result, error) {
+	result, pending, err := plan(db, migrations)
+	if err != nil {
+		return nil, err
+	}
+	result.DryRun = true
+
+	err = kvdb.Update(db, func(tx kvdb.RwTx) error {
+		for _, m := range pending {
+			if err := migrate(tx, m); err != nil {
+				return err
+			}
+		}
+		return errDryRun
+	}, func() {})
+	if !errors.Is(err, errDryRun) {
+		return result, err
+	}
+
+	for _, m := range pending {
+		result.ToVersion = m.Version
+		result.Applied = append(result.Applied, m.Description)
+	}
+
+	return result, nil
+}
+
+// plan returns the migrations pending on the db
+func plan(db kvdb.Backend, migrations []Migration) (*Result, []Migration, error) {
+	for i, m := range migrations {
+		if m.Version != uint32(i+1) {
+			return nil, nil, fmt.Errorf("invalid migration %d at position %d, the migrations should be ordered by version from 1",
+				m.Version, i)
+		}
+	}
+
+	version, err := GetVersion(db)
+	if err != nil {
+		return nil, nil, err
+	}
+	if version > LatestVersion(migrations) {
+		return nil, nil, fmt.Errorf("%w: version %d, latest version %d", ErrUnknownSchema, version, LatestVersion(migrations))
+	}
+
+	return &Result{
+		FromVersion: version,
+		ToVersion:   version,
+		Applied:     []string{},
+		Backups:     []string{},
+	}, migrations[version:], nil
+}
+
+func migrate(tx kvdb.RwTx, m Migration) error {
+	if err := m.Migrate(tx); err != nil {
+		return fmt.Errorf("failed to apply migration %d (%s): %w", m.Version, m.Description, err)
+	}
+
+	return SetVersion(tx, m.Version)
+}
+
+// isEmpty returns whether the db has no buckets
+func isEmpty(db kvdb.Backend) (bool, error) {
+	empty := true
+	err := kvdb.View(db, func(tx kvdb.RTx) error {
+		return tx.ForEachBucket(func(_ []byte) error {
+			empty = false
+			return nil
+		})
+	}, func() {
+		empty = true
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return empty, nil
+}
+
+// backup writes a copy of the db to the given path, which should not exist
+func backup(db kvdb.Backend, path string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+
+	if err := db.Copy(f); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
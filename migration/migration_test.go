@@ -0,0 +1,126 @@
+package migration_test
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonchain/finality-provider/finality-provider/config"
+	"github.com/babylonchain/finality-provider/migration"
+	"github.com/babylonchain/finality-provider/testutil"
+)
+
+// FuzzMigrations tests that the pending migrations of a db are applied in order
+// with a backup before each of them unless the db is empty, that a dry run leaves
+// the db unchanged, and that a db migrated beyond the known migrations is refused
+func FuzzMigrations(f *testing.F) {
+	testutil.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		homePath := t.TempDir()
+		cfg := config.DefaultDBConfigWithHomePath(homePath)
+		db, err := cfg.GetDbBackend()
+		require.NoError(t, err)
+		defer func() {
+			err := db.Close()
+			require.NoError(t, err)
+			err = os.RemoveAll(homePath)
+			require.NoError(t, err)
+		}()
+
+		// each migration creates a bucket named after its version
+		numMigrations := r.Intn(5) + 1
+		migrations := make([]migration.Migration, numMigrations)
+		for i := range migrations {
+			version := uint32(i + 1)
+			migrations[i] = migration.Migration{
+				Version:     version,
+				Description: fmt.Sprintf("migration %d", version),
+				Migrate: func(tx kvdb.RwTx) error {
+					_, err := tx.CreateTopLevelBucket(bucketName(version))
+					return err
+				},
+			}
+		}
+		requireBuckets := func(upTo uint32) {
+			err := kvdb.View(db, func(tx kvdb.RTx) error {
+				for v := uint32(1); v <= uint32(numMigrations); v++ {
+					require.Equal(t, v <= upTo, tx.ReadBucket(bucketName(v)) != nil)
+				}
+				return nil
+			}, func() {})
+			require.NoError(t, err)
+		}
+
+		// the db starts at a random version
+		fromVersion := uint32(r.Intn(numMigrations))
+		_, err = migration.Run(db, migrations[:fromVersion], migration.BackupPath(cfg.DBPath, cfg.DBFileName))
+		require.NoError(t, err)
+		err = migration.CheckVersion(db, migrations)
+		if fromVersion < uint32(numMigrations) {
+			require.ErrorIs(t, err, migration.ErrOutdatedSchema)
+		}
+
+		// the dry run applies the pending migrations without saving them
+		res, err := migration.DryRun(db, migrations)
+		require.NoError(t, err)
+		require.True(t, res.DryRun)
+		require.Equal(t, fromVersion, res.FromVersion)
+		require.Equal(t, uint32(numMigrations), res.ToVersion)
+		require.Len(t, res.Applied, numMigrations-int(fromVersion))
+		require.Empty(t, res.Backups)
+		version, err := migration.GetVersion(db)
+		require.NoError(t, err)
+		require.Equal(t, fromVersion, version)
+		requireBuckets(fromVersion)
+
+		// a failed migration leaves the db at the previous version
+		failing := append([]migration.Migration{}, migrations...)
+		failing = append(failing, migration.Migration{
+			Version:     uint32(numMigrations + 1),
+			Description: "failing migration",
+			Migrate: func(tx kvdb.RwTx) error {
+				return fmt.Errorf("failed")
+			},
+		})
+		res, err = migration.Run(db, failing, migration.BackupPath(cfg.DBPath, cfg.DBFileName))
+		require.Error(t, err)
+		require.Equal(t, uint32(numMigrations), res.ToVersion)
+		if fromVersion == 0 {
+			// the empty db is not backed up
+			require.Empty(t, res.Backups)
+		} else {
+			require.Len(t, res.Backups, numMigrations+1-int(fromVersion))
+		}
+		for _, path := range res.Backups {
+			_, err := os.Stat(path)
+			require.NoError(t, err)
+			require.Equal(t, cfg.DBPath, filepath.Dir(path))
+		}
+		err = migration.CheckVersion(db, migrations)
+		require.NoError(t, err)
+		requireBuckets(uint32(numMigrations))
+
+		// running the migrations again is a no-op
+		res, err = migration.Run(db, migrations, migration.BackupPath(cfg.DBPath, cfg.DBFileName))
+		require.NoError(t, err)
+		require.Empty(t, res.Applied)
+		require.Empty(t, res.Backups)
+
+		// the db is refused by the older migrations
+		err = migration.CheckVersion(db, migrations[:numMigrations-1])
+		require.ErrorIs(t, err, migration.ErrUnknownSchema)
+		_, err = migration.Run(db, migrations[:numMigrations-1], migration.BackupPath(cfg.DBPath, cfg.DBFileName))
+		require.ErrorIs(t, err, migration.ErrUnknownSchema)
+	})
+}
+
+func bucketName(version uint32) []byte {
+	return []byte(fmt.Sprintf("bucket-%d", version))
+}
@@ -0,0 +1,92 @@
+package rpcauth
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// DialOptions returns the gRPC dial options following the given config,
+// i.e., the TLS credentials and the bearer token sent with every request.
+// The connection is insecure if the config is nil
+func DialOptions(cfg *ClientConfig) ([]grpc.DialOption, error) {
+	insecureOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if cfg == nil {
+		return insecureOpts, nil
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if !cfg.TLSEnabled() {
+		return insecureOpts, nil
+	}
+
+	pool, err := loadCertPool(cfg.TLSCACertPath)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsCfg := &tls.Config{
+		RootCAs:    pool,
+		ServerName: cfg.TLSServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if cfg.TLSCertPath != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.TLSCertPath, cfg.TLSKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the TLS client certificate: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg))}
+
+	if cfg.AuthTokenPath != "" {
+		token, err := readToken(cfg.AuthTokenPath)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(tokenCredentials{token: token}))
+	}
+
+	return opts, nil
+}
+
+// IsLoopbackAddress returns whether the host of the given address is a loopback
+// address, i.e., the connection to it does not leave the machine
+func IsLoopbackAddress(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
+
+// tokenCredentials attaches the bearer token to every request
+type tokenCredentials struct {
+	token string
+}
+
+func (c tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{
+		authorizationHeader: bearerPrefix + c.token,
+	}, nil
+}
+
+// RequireTransportSecurity ensures the token is never sent in plaintext
+func (c tokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
@@ -0,0 +1,72 @@
+package rpcauth
+
+import (
+	"fmt"
+)
+
+// ServerConfig is the config of the transport security and authentication of a gRPC server
+type ServerConfig struct {
+	TLSCertPath   string `long:"tlscertpath" description:"Path to the TLS certificate of the RPC server; TLS is disabled if empty"`
+	TLSKeyPath    string `long:"tlskeypath" description:"Path to the TLS private key of the RPC server"`
+	ClientCAPath  string `long:"clientcapath" description:"Path to the CA certificate verifying the client certificates; mutual TLS is enabled if set"`
+	AuthTokenPath string `long:"authtokenpath" description:"Path to the file containing the bearer token required from the clients; requires TLS"`
+}
+
+// ClientConfig is the config of the transport security and authentication of a gRPC client
+type ClientConfig struct {
+	TLSCACertPath string `long:"tlscacertpath" description:"Path to the CA certificate verifying the RPC server certificate; TLS is disabled if empty"`
+	TLSServerName string `long:"tlsservername" description:"The server name expected in the RPC server certificate; the host of the server address is used if empty"`
+	TLSCertPath   string `long:"tlscertpath" description:"Path to the TLS client certificate for mutual TLS"`
+	TLSKeyPath    string `long:"tlskeypath" description:"Path to the TLS client private key for mutual TLS"`
+	AuthTokenPath string `long:"authtokenpath" description:"Path to the file containing the bearer token sent to the RPC server; requires TLS"`
+}
+
+func DefaultServerConfig() *ServerConfig {
+	return &ServerConfig{}
+}
+
+func DefaultClientConfig() *ClientConfig {
+	return &ClientConfig{}
+}
+
+// TLSEnabled returns whether the server serves over TLS
+func (cfg *ServerConfig) TLSEnabled() bool {
+	return cfg.TLSCertPath != ""
+}
+
+func (cfg *ServerConfig) Validate() error {
+	if (cfg.TLSCertPath == "") != (cfg.TLSKeyPath == "") {
+		return fmt.Errorf("the TLS certificate and key of the server should be both set or both empty")
+	}
+
+	if cfg.ClientCAPath != "" && !cfg.TLSEnabled() {
+		return fmt.Errorf("verifying client certificates requires TLS")
+	}
+
+	if cfg.AuthTokenPath != "" && !cfg.TLSEnabled() {
+		return fmt.Errorf("the bearer token authentication requires TLS")
+	}
+
+	return nil
+}
+
+// TLSEnabled returns whether the client connects over TLS
+func (cfg *ClientConfig) TLSEnabled() bool {
+	return cfg.TLSCACertPath != ""
+}
+
+func (cfg *ClientConfig) Validate() error {
+	if (cfg.TLSCertPath == "") != (cfg.TLSKeyPath == "") {
+		return fmt.Errorf("the TLS certificate and key of the client should be both set or both empty")
+	}
+
+	if cfg.TLSCertPath != "" && !cfg.TLSEnabled() {
+		return fmt.Errorf("the TLS client certificate requires the CA certificate of the server")
+	}
+
+	if cfg.AuthTokenPath != "" && !cfg.TLSEnabled() {
+		return fmt.Errorf("the bearer token authentication requires TLS")
+	}
+
+	return nil
+}
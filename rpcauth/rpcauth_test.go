@@ -0,0 +1,175 @@
+package rpcauth_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/babylonchain/finality-provider/rpcauth"
+)
+
+// TestTLSAndTokenAuth tests the TLS, mutual TLS, and bearer token
+// authentication between a gRPC server and its clients
+func TestTLSAndTokenAuth(t *testing.T) {
+	dir := t.TempDir()
+	caCert, caKey := genCA(t, dir, "ca")
+	serverCertPath, serverKeyPath := genCert(t, dir, "server", caCert, caKey)
+	clientCertPath, clientKeyPath := genCert(t, dir, "client", caCert, caKey)
+	caCertPath := filepath.Join(dir, "ca.crt")
+	tokenPath := filepath.Join(dir, "token")
+	err := os.WriteFile(tokenPath, []byte("secret-token\n"), 0600)
+	require.NoError(t, err)
+	wrongTokenPath := filepath.Join(dir, "wrong-token")
+	err = os.WriteFile(wrongTokenPath, []byte("wrong-token"), 0600)
+	require.NoError(t, err)
+
+	addr := startServer(t, &rpcauth.ServerConfig{
+		TLSCertPath:   serverCertPath,
+		TLSKeyPath:    serverKeyPath,
+		ClientCAPath:  caCertPath,
+		AuthTokenPath: tokenPath,
+	})
+
+	clientCfg := &rpcauth.ClientConfig{
+		TLSCACertPath: caCertPath,
+		TLSServerName: "localhost",
+		TLSCertPath:   clientCertPath,
+		TLSKeyPath:    clientKeyPath,
+		AuthTokenPath: tokenPath,
+	}
+	require.NoError(t, check(t, addr, clientCfg))
+
+	// a wrong token is rejected
+	wrongTokenCfg := *clientCfg
+	wrongTokenCfg.AuthTokenPath = wrongTokenPath
+	require.Equal(t, codes.Unauthenticated, status.Code(check(t, addr, &wrongTokenCfg)))
+
+	// a missing token is rejected
+	noTokenCfg := *clientCfg
+	noTokenCfg.AuthTokenPath = ""
+	require.Equal(t, codes.Unauthenticated, status.Code(check(t, addr, &noTokenCfg)))
+
+	// a client without certificate is rejected by mutual TLS
+	noCertCfg := *clientCfg
+	noCertCfg.TLSCertPath = ""
+	noCertCfg.TLSKeyPath = ""
+	require.Error(t, check(t, addr, &noCertCfg))
+
+	// an insecure client is rejected
+	require.Error(t, check(t, addr, nil))
+
+	// a token without TLS is refused by the config validation
+	_, err = rpcauth.ServerOptions(&rpcauth.ServerConfig{AuthTokenPath: tokenPath})
+	require.Error(t, err)
+	_, err = rpcauth.DialOptions(&rpcauth.ClientConfig{AuthTokenPath: tokenPath})
+	require.Error(t, err)
+
+	// the default configs keep the connection insecure
+	insecureAddr := startServer(t, rpcauth.DefaultServerConfig())
+	require.NoError(t, check(t, insecureAddr, rpcauth.DefaultClientConfig()))
+}
+
+func startServer(t *testing.T, cfg *rpcauth.ServerConfig) string {
+	opts, err := rpcauth.ServerOptions(cfg)
+	require.NoError(t, err)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer(opts...)
+	grpc_health_v1.RegisterHealthServer(grpcServer, health.NewServer())
+	go func() {
+		_ = grpcServer.Serve(lis)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	return lis.Addr().String()
+}
+
+func check(t *testing.T, addr string, cfg *rpcauth.ClientConfig) error {
+	opts, err := rpcauth.DialOptions(cfg)
+	require.NoError(t, err)
+
+	conn, err := grpc.Dial(addr, opts...)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err = grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	return err
+}
+
+func genCA(t *testing.T, dir, name string) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	writePEM(t, filepath.Join(dir, name+".crt"), "CERTIFICATE", der)
+
+	return cert, key
+}
+
+func genCert(t *testing.T, dir, name string, caCert *x509.Certificate, caKey *ecdsa.PrivateKey) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, caCert, &key.PublicKey, caKey)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPath := filepath.Join(dir, name+".crt")
+	keyPath := filepath.Join(dir, name+".key")
+	writePEM(t, certPath, "CERTIFICATE", der)
+	writePEM(t, keyPath, "EC PRIVATE KEY", keyDer)
+
+	return certPath, keyPath
+}
+
+func writePEM(t *testing.T, path, blockType string, der []byte) {
+	bz := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	err := os.WriteFile(path, bz, 0600)
+	require.NoError(t, err)
+}
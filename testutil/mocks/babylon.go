@@ -5,11 +5,14 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	math "cosmossdk.io/math"
-	types "github.com/babylonchain/finality-provider/types"
-	btcec "github.com/btcsuite/btcd/btcec/v2"
+	types "github.com/babylonchain/babylon/types"
+	types0 "github.com/babylonchain/finality-provider/types"
+	v2 "github.com/btcsuite/btcd/btcec/v2"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -51,167 +54,334 @@ func (mr *MockClientControllerMockRecorder) Close() *gomock.Call {
 }
 
 // QueryActivatedHeight mocks base method.
-func (m *MockClientController) QueryActivatedHeight() (uint64, error) {
+func (m *MockClientController) QueryActivatedHeight(ctx context.Context) (uint64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "QueryActivatedHeight")
+	ret := m.ctrl.Call(m, "QueryActivatedHeight", ctx)
 	ret0, _ := ret[0].(uint64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // QueryActivatedHeight indicates an expected call of QueryActivatedHeight.
-func (mr *MockClientControllerMockRecorder) QueryActivatedHeight() *gomock.Call {
+func (mr *MockClientControllerMockRecorder) QueryActivatedHeight(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryActivatedHeight", reflect.TypeOf((*MockClientController)(nil).QueryActivatedHeight))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryActivatedHeight", reflect.TypeOf((*MockClientController)(nil).QueryActivatedHeight), ctx)
+}
+
+// QueryBalance mocks base method.
+func (m *MockClientController) QueryBalance(ctx context.Context) (*types1.Coin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryBalance", ctx)
+	ret0, _ := ret[0].(*types1.Coin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryBalance indicates an expected call of QueryBalance.
+func (mr *MockClientControllerMockRecorder) QueryBalance(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryBalance", reflect.TypeOf((*MockClientController)(nil).QueryBalance), ctx)
 }
 
 // QueryBestBlock mocks base method.
-func (m *MockClientController) QueryBestBlock() (*types.BlockInfo, error) {
+func (m *MockClientController) QueryBestBlock(ctx context.Context) (*types0.BlockInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "QueryBestBlock")
-	ret0, _ := ret[0].(*types.BlockInfo)
+	ret := m.ctrl.Call(m, "QueryBestBlock", ctx)
+	ret0, _ := ret[0].(*types0.BlockInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // QueryBestBlock indicates an expected call of QueryBestBlock.
-func (mr *MockClientControllerMockRecorder) QueryBestBlock() *gomock.Call {
+func (mr *MockClientControllerMockRecorder) QueryBestBlock(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryBestBlock", reflect.TypeOf((*MockClientController)(nil).QueryBestBlock))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryBestBlock", reflect.TypeOf((*MockClientController)(nil).QueryBestBlock), ctx)
 }
 
 // QueryBlock mocks base method.
-func (m *MockClientController) QueryBlock(height uint64) (*types.BlockInfo, error) {
+func (m *MockClientController) QueryBlock(ctx context.Context, height uint64) (*types0.BlockInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "QueryBlock", height)
-	ret0, _ := ret[0].(*types.BlockInfo)
+	ret := m.ctrl.Call(m, "QueryBlock", ctx, height)
+	ret0, _ := ret[0].(*types0.BlockInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // QueryBlock indicates an expected call of QueryBlock.
-func (mr *MockClientControllerMockRecorder) QueryBlock(height interface{}) *gomock.Call {
+func (mr *MockClientControllerMockRecorder) QueryBlock(ctx, height interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryBlock", reflect.TypeOf((*MockClientController)(nil).QueryBlock), height)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryBlock", reflect.TypeOf((*MockClientController)(nil).QueryBlock), ctx, height)
 }
 
 // QueryBlocks mocks base method.
-func (m *MockClientController) QueryBlocks(startHeight, endHeight, limit uint64) ([]*types.BlockInfo, error) {
+func (m *MockClientController) QueryBlocks(ctx context.Context, startHeight, endHeight, limit uint64) ([]*types0.BlockInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "QueryBlocks", startHeight, endHeight, limit)
-	ret0, _ := ret[0].([]*types.BlockInfo)
+	ret := m.ctrl.Call(m, "QueryBlocks", ctx, startHeight, endHeight, limit)
+	ret0, _ := ret[0].([]*types0.BlockInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // QueryBlocks indicates an expected call of QueryBlocks.
-func (mr *MockClientControllerMockRecorder) QueryBlocks(startHeight, endHeight, limit interface{}) *gomock.Call {
+func (mr *MockClientControllerMockRecorder) QueryBlocks(ctx, startHeight, endHeight, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryBlocks", reflect.TypeOf((*MockClientController)(nil).QueryBlocks), startHeight, endHeight, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryBlocks", reflect.TypeOf((*MockClientController)(nil).QueryBlocks), ctx, startHeight, endHeight, limit)
 }
 
 // QueryFinalityProviderSlashed mocks base method.
-func (m *MockClientController) QueryFinalityProviderSlashed(fpPk *btcec.PublicKey) (bool, error) {
+func (m *MockClientController) QueryFinalityProviderSlashed(ctx context.Context, fpPk *v2.PublicKey) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "QueryFinalityProviderSlashed", fpPk)
+	ret := m.ctrl.Call(m, "QueryFinalityProviderSlashed", ctx, fpPk)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // QueryFinalityProviderSlashed indicates an expected call of QueryFinalityProviderSlashed.
-func (mr *MockClientControllerMockRecorder) QueryFinalityProviderSlashed(fpPk interface{}) *gomock.Call {
+func (mr *MockClientControllerMockRecorder) QueryFinalityProviderSlashed(ctx, fpPk interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryFinalityProviderSlashed", reflect.TypeOf((*MockClientController)(nil).QueryFinalityProviderSlashed), fpPk)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryFinalityProviderSlashed", reflect.TypeOf((*MockClientController)(nil).QueryFinalityProviderSlashed), ctx, fpPk)
 }
 
 // QueryFinalityProviderVotingPower mocks base method.
-func (m *MockClientController) QueryFinalityProviderVotingPower(fpPk *btcec.PublicKey, blockHeight uint64) (uint64, error) {
+func (m *MockClientController) QueryFinalityProviderVotingPower(ctx context.Context, fpPk *v2.PublicKey, blockHeight uint64) (uint64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "QueryFinalityProviderVotingPower", fpPk, blockHeight)
+	ret := m.ctrl.Call(m, "QueryFinalityProviderVotingPower", ctx, fpPk, blockHeight)
 	ret0, _ := ret[0].(uint64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // QueryFinalityProviderVotingPower indicates an expected call of QueryFinalityProviderVotingPower.
-func (mr *MockClientControllerMockRecorder) QueryFinalityProviderVotingPower(fpPk, blockHeight interface{}) *gomock.Call {
+func (mr *MockClientControllerMockRecorder) QueryFinalityProviderVotingPower(ctx, fpPk, blockHeight interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryFinalityProviderVotingPower", reflect.TypeOf((*MockClientController)(nil).QueryFinalityProviderVotingPower), fpPk, blockHeight)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryFinalityProviderVotingPower", reflect.TypeOf((*MockClientController)(nil).QueryFinalityProviderVotingPower), ctx, fpPk, blockHeight)
 }
 
 // QueryLastFinalizedEpoch mocks base method.
-func (m *MockClientController) QueryLastFinalizedEpoch() (uint64, error) {
+func (m *MockClientController) QueryLastFinalizedEpoch(ctx context.Context) (uint64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "QueryLastFinalizedEpoch")
+	ret := m.ctrl.Call(m, "QueryLastFinalizedEpoch", ctx)
 	ret0, _ := ret[0].(uint64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // QueryLastFinalizedEpoch indicates an expected call of QueryLastFinalizedEpoch.
-func (mr *MockClientControllerMockRecorder) QueryLastFinalizedEpoch() *gomock.Call {
+func (mr *MockClientControllerMockRecorder) QueryLastFinalizedEpoch(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryLastFinalizedEpoch", reflect.TypeOf((*MockClientController)(nil).QueryLastFinalizedEpoch))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryLastFinalizedEpoch", reflect.TypeOf((*MockClientController)(nil).QueryLastFinalizedEpoch), ctx)
 }
 
 // QueryLatestFinalizedBlocks mocks base method.
-func (m *MockClientController) QueryLatestFinalizedBlocks(count uint64) ([]*types.BlockInfo, error) {
+func (m *MockClientController) QueryLatestFinalizedBlocks(ctx context.Context, count uint64) ([]*types0.BlockInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "QueryLatestFinalizedBlocks", count)
-	ret0, _ := ret[0].([]*types.BlockInfo)
+	ret := m.ctrl.Call(m, "QueryLatestFinalizedBlocks", ctx, count)
+	ret0, _ := ret[0].([]*types0.BlockInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // QueryLatestFinalizedBlocks indicates an expected call of QueryLatestFinalizedBlocks.
-func (mr *MockClientControllerMockRecorder) QueryLatestFinalizedBlocks(count interface{}) *gomock.Call {
+func (mr *MockClientControllerMockRecorder) QueryLatestFinalizedBlocks(ctx, count interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryLatestFinalizedBlocks", reflect.TypeOf((*MockClientController)(nil).QueryLatestFinalizedBlocks), count)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryLatestFinalizedBlocks", reflect.TypeOf((*MockClientController)(nil).QueryLatestFinalizedBlocks), ctx, count)
 }
 
 // RegisterFinalityProvider mocks base method.
-func (m *MockClientController) RegisterFinalityProvider(chainPk []byte, fpPk *btcec.PublicKey, pop []byte, commission *math.LegacyDec, description []byte, masterPubRand string) (*types.TxResponse, uint64, error) {
+func (m *MockClientController) RegisterFinalityProvider(ctx context.Context, chainPk []byte, fpPk *v2.PublicKey, pop []byte, commission *math.LegacyDec, description []byte, masterPubRand string) (*types0.TxResponse, uint64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RegisterFinalityProvider", chainPk, fpPk, pop, commission, description, masterPubRand)
-	ret0, _ := ret[0].(*types.TxResponse)
+	ret := m.ctrl.Call(m, "RegisterFinalityProvider", ctx, chainPk, fpPk, pop, commission, description, masterPubRand)
+	ret0, _ := ret[0].(*types0.TxResponse)
 	ret1, _ := ret[1].(uint64)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
 // RegisterFinalityProvider indicates an expected call of RegisterFinalityProvider.
-func (mr *MockClientControllerMockRecorder) RegisterFinalityProvider(chainPk, fpPk, pop, commission, description, masterPubRand interface{}) *gomock.Call {
+func (mr *MockClientControllerMockRecorder) RegisterFinalityProvider(ctx, chainPk, fpPk, pop, commission, description, masterPubRand interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterFinalityProvider", reflect.TypeOf((*MockClientController)(nil).RegisterFinalityProvider), chainPk, fpPk, pop, commission, description, masterPubRand)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterFinalityProvider", reflect.TypeOf((*MockClientController)(nil).RegisterFinalityProvider), ctx, chainPk, fpPk, pop, commission, description, masterPubRand)
 }
 
 // SubmitBatchFinalitySigs mocks base method.
-func (m *MockClientController) SubmitBatchFinalitySigs(fpPk *btcec.PublicKey, blocks []*types.BlockInfo, sigs []*btcec.ModNScalar) (*types.TxResponse, error) {
+func (m *MockClientController) SubmitBatchFinalitySigs(ctx context.Context, fpPk *v2.PublicKey, blocks []*types0.BlockInfo, sigs []*v2.ModNScalar) (*types0.TxResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SubmitBatchFinalitySigs", fpPk, blocks, sigs)
-	ret0, _ := ret[0].(*types.TxResponse)
+	ret := m.ctrl.Call(m, "SubmitBatchFinalitySigs", ctx, fpPk, blocks, sigs)
+	ret0, _ := ret[0].(*types0.TxResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SubmitBatchFinalitySigs indicates an expected call of SubmitBatchFinalitySigs.
-func (mr *MockClientControllerMockRecorder) SubmitBatchFinalitySigs(fpPk, blocks, sigs interface{}) *gomock.Call {
+func (mr *MockClientControllerMockRecorder) SubmitBatchFinalitySigs(ctx, fpPk, blocks, sigs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitBatchFinalitySigs", reflect.TypeOf((*MockClientController)(nil).SubmitBatchFinalitySigs), fpPk, blocks, sigs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitBatchFinalitySigs", reflect.TypeOf((*MockClientController)(nil).SubmitBatchFinalitySigs), ctx, fpPk, blocks, sigs)
 }
 
 // SubmitFinalitySig mocks base method.
-func (m *MockClientController) SubmitFinalitySig(fpPk *btcec.PublicKey, blockHeight uint64, blockHash []byte, sig *btcec.ModNScalar) (*types.TxResponse, error) {
+func (m *MockClientController) SubmitFinalitySig(ctx context.Context, fpPk *v2.PublicKey, blockHeight uint64, blockHash []byte, sig *v2.ModNScalar) (*types0.TxResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SubmitFinalitySig", fpPk, blockHeight, blockHash, sig)
-	ret0, _ := ret[0].(*types.TxResponse)
+	ret := m.ctrl.Call(m, "SubmitFinalitySig", ctx, fpPk, blockHeight, blockHash, sig)
+	ret0, _ := ret[0].(*types0.TxResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SubmitFinalitySig indicates an expected call of SubmitFinalitySig.
-func (mr *MockClientControllerMockRecorder) SubmitFinalitySig(fpPk, blockHeight, blockHash, sig interface{}) *gomock.Call {
+func (mr *MockClientControllerMockRecorder) SubmitFinalitySig(ctx, fpPk, blockHeight, blockHash, sig interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitFinalitySig", reflect.TypeOf((*MockClientController)(nil).SubmitFinalitySig), ctx, fpPk, blockHeight, blockHash, sig)
+}
+
+// MockBlockSubscriber is a mock of BlockSubscriber interface.
+type MockBlockSubscriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockSubscriberMockRecorder
+}
+
+// MockBlockSubscriberMockRecorder is the mock recorder for MockBlockSubscriber.
+type MockBlockSubscriberMockRecorder struct {
+	mock *MockBlockSubscriber
+}
+
+// NewMockBlockSubscriber creates a new mock instance.
+func NewMockBlockSubscriber(ctrl *gomock.Controller) *MockBlockSubscriber {
+	mock := &MockBlockSubscriber{ctrl: ctrl}
+	mock.recorder = &MockBlockSubscriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlockSubscriber) EXPECT() *MockBlockSubscriberMockRecorder {
+	return m.recorder
+}
+
+// SubscribeNewBlocks mocks base method.
+func (m *MockBlockSubscriber) SubscribeNewBlocks(ctx context.Context) (<-chan *types0.BlockInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeNewBlocks", ctx)
+	ret0, _ := ret[0].(<-chan *types0.BlockInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeNewBlocks indicates an expected call of SubscribeNewBlocks.
+func (mr *MockBlockSubscriberMockRecorder) SubscribeNewBlocks(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeNewBlocks", reflect.TypeOf((*MockBlockSubscriber)(nil).SubscribeNewBlocks), ctx)
+}
+
+// MockGasPriceUpdater is a mock of GasPriceUpdater interface.
+type MockGasPriceUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockGasPriceUpdaterMockRecorder
+}
+
+// MockGasPriceUpdaterMockRecorder is the mock recorder for MockGasPriceUpdater.
+type MockGasPriceUpdaterMockRecorder struct {
+	mock *MockGasPriceUpdater
+}
+
+// NewMockGasPriceUpdater creates a new mock instance.
+func NewMockGasPriceUpdater(ctrl *gomock.Controller) *MockGasPriceUpdater {
+	mock := &MockGasPriceUpdater{ctrl: ctrl}
+	mock.recorder = &MockGasPriceUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGasPriceUpdater) EXPECT() *MockGasPriceUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateGasPrices mocks base method.
+func (m *MockGasPriceUpdater) UpdateGasPrices(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGasPrices", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGasPrices indicates an expected call of UpdateGasPrices.
+func (mr *MockGasPriceUpdaterMockRecorder) UpdateGasPrices(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGasPrices", reflect.TypeOf((*MockGasPriceUpdater)(nil).UpdateGasPrices), ctx)
+}
+
+// MockFinalitySigsSubmitter is a mock of FinalitySigsSubmitter interface.
+type MockFinalitySigsSubmitter struct {
+	ctrl     *gomock.Controller
+	recorder *MockFinalitySigsSubmitterMockRecorder
+}
+
+// MockFinalitySigsSubmitterMockRecorder is the mock recorder for MockFinalitySigsSubmitter.
+type MockFinalitySigsSubmitterMockRecorder struct {
+	mock *MockFinalitySigsSubmitter
+}
+
+// NewMockFinalitySigsSubmitter creates a new mock instance.
+func NewMockFinalitySigsSubmitter(ctrl *gomock.Controller) *MockFinalitySigsSubmitter {
+	mock := &MockFinalitySigsSubmitter{ctrl: ctrl}
+	mock.recorder = &MockFinalitySigsSubmitterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFinalitySigsSubmitter) EXPECT() *MockFinalitySigsSubmitterMockRecorder {
+	return m.recorder
+}
+
+// SubmitFinalitySigs mocks base method.
+func (m *MockFinalitySigsSubmitter) SubmitFinalitySigs(ctx context.Context, sigs []*types0.FinalitySig) (*types0.TxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitFinalitySigs", ctx, sigs)
+	ret0, _ := ret[0].(*types0.TxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitFinalitySigs indicates an expected call of SubmitFinalitySigs.
+func (mr *MockFinalitySigsSubmitterMockRecorder) SubmitFinalitySigs(ctx, sigs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitFinalitySigs", reflect.TypeOf((*MockFinalitySigsSubmitter)(nil).SubmitFinalitySigs), ctx, sigs)
+}
+
+// MockVoteQuerier is a mock of VoteQuerier interface.
+type MockVoteQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockVoteQuerierMockRecorder
+}
+
+// MockVoteQuerierMockRecorder is the mock recorder for MockVoteQuerier.
+type MockVoteQuerierMockRecorder struct {
+	mock *MockVoteQuerier
+}
+
+// NewMockVoteQuerier creates a new mock instance.
+func NewMockVoteQuerier(ctrl *gomock.Controller) *MockVoteQuerier {
+	mock := &MockVoteQuerier{ctrl: ctrl}
+	mock.recorder = &MockVoteQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVoteQuerier) EXPECT() *MockVoteQuerierMockRecorder {
+	return m.recorder
+}
+
+// QueryVotesAtHeight mocks base method.
+func (m *MockVoteQuerier) QueryVotesAtHeight(ctx context.Context, height uint64) ([]types.BIP340PubKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryVotesAtHeight", ctx, height)
+	ret0, _ := ret[0].([]types.BIP340PubKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryVotesAtHeight indicates an expected call of QueryVotesAtHeight.
+func (mr *MockVoteQuerierMockRecorder) QueryVotesAtHeight(ctx, height interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitFinalitySig", reflect.TypeOf((*MockClientController)(nil).SubmitFinalitySig), fpPk, blockHeight, blockHash, sig)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryVotesAtHeight", reflect.TypeOf((*MockVoteQuerier)(nil).QueryVotesAtHeight), ctx, height)
 }
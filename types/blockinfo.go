@@ -1,7 +1,10 @@
 package types
 
 type BlockInfo struct {
-	Height    uint64
-	Hash      []byte
-	Finalized bool
+	Height uint64
+	Hash   []byte
+	// ParentHash is the hash of the previous block, which is only set by
+	// the consumer chains without instant finality to detect reorgs
+	ParentHash []byte
+	Finalized  bool
 }
@@ -0,0 +1,12 @@
+package types
+
+import (
+	"github.com/btcsuite/btcd/btcec/v2"
+)
+
+// FinalitySig is the finality signature of a finality provider over a block
+type FinalitySig struct {
+	FpPk  *btcec.PublicKey
+	Block *BlockInfo
+	Sig   *btcec.ModNScalar
+}